@@ -13,28 +13,41 @@ import (
 	"route256/checkout/internal/clients/products"
 	"route256/checkout/internal/config"
 	"route256/checkout/internal/domain"
+	"route256/checkout/internal/jobs"
+	"route256/checkout/internal/model"
+	"route256/checkout/internal/pkg/grpcclient"
 	"route256/checkout/internal/pkg/logger"
 	"route256/checkout/internal/pkg/metrics"
 	"route256/checkout/internal/pkg/ratelimit"
 	"route256/checkout/internal/pkg/tracer"
 	"route256/checkout/internal/repository/postgres"
+	"route256/checkout/internal/sender"
 	"route256/checkout/pkg/cart_v1"
+	"route256/libs/apperr"
+	"route256/libs/auth"
+	"route256/libs/kafka"
+	"route256/libs/money"
+	"route256/libs/tlsconfig"
 	"syscall"
+	"time"
 
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
 const (
-	grpcPort        = 50051
-	httpPort        = 8090
-	productRPSLimit = 10
-	maxConcurrency  = 5
-	serviceName     = "checkout"
+	grpcPort            = 50051
+	httpPort            = 8090
+	serviceName         = "checkout"
+	abandonedCartsTopic = "abandoned_carts"
+	cartEventsTopic     = "cart_events"
+	defaultCurrency     = "RUB"
+
+	defaultCartEventsRelayInterval = time.Second
 )
 
 // Service start point
@@ -70,23 +83,135 @@ func run(ctx context.Context) error {
 		return fmt.Errorf("failed to listen: %v", err)
 	}
 
+	verifier, err := auth.NewVerifier(auth.Options{
+		HMACSecret: cfg.Auth.HMACSecret,
+		JWKSFile:   cfg.Auth.JWKSFile,
+		Issuer:     cfg.Auth.Issuer,
+		Audience:   cfg.Auth.Audience,
+	})
+	if err != nil {
+		return fmt.Errorf("create token verifier: %w", err)
+	}
+
+	tlsOptions := tlsconfig.Options{
+		CertFile:          cfg.TLS.CertFile,
+		KeyFile:           cfg.TLS.KeyFile,
+		CAFile:            cfg.TLS.CAFile,
+		RequireClientCert: cfg.TLS.RequireClientCert,
+		ReloadInterval:    cfg.TLS.ReloadInterval,
+		Logger:            tlsconfig.Logger{Info: logger.Info, Error: logger.Error},
+	}
+	serverCreds, err := tlsconfig.ServerCredentials(tlsOptions)
+	if err != nil {
+		return fmt.Errorf("create server credentials: %w", err)
+	}
+	clientCreds, err := tlsconfig.ClientCredentials(tlsOptions)
+	if err != nil {
+		return fmt.Errorf("create client credentials: %w", err)
+	}
+	if (cfg.Clients.Loms.TLS || cfg.Clients.Products.TLS) && cfg.TLS.CAFile == "" {
+		return fmt.Errorf("clients with tls need tls.ca_file")
+	}
+
 	// Create new gRPC server
 	s := grpc.NewServer(
+		grpc.Creds(serverCreds),
 		grpc.ChainUnaryInterceptor(
 			logger.MiddlewareGRPC,
 			tracer.MiddlewareGRPC,
 			metrics.MiddlewareGRPC,
+			apperr.UnaryServerInterceptor("checkout"),
+			auth.UnaryServerInterceptor(verifier, api.AuthPolicy),
 		),
 	)
 	reflection.Register(s)
 
+	if cfg.RateLimits.Products.RPS <= 0 {
+		return fmt.Errorf("rate limit for product service must be positive, got %v", cfg.RateLimits.Products.RPS)
+	}
+	productLimiter := ratelimit.New("products", cfg.RateLimits.Products.RPS, cfg.RateLimits.Products.Burst)
+
+	mergePolicy := model.MergePolicy(cfg.Cart.MergePolicy)
+	if mergePolicy == "" {
+		mergePolicy = model.MergePolicySum
+	}
+	if !mergePolicy.Valid() {
+		return fmt.Errorf("unknown cart merge policy: %q", mergePolicy)
+	}
+
+	currency := money.Currency(cfg.Cart.Currency)
+	if currency == "" {
+		currency = defaultCurrency
+	}
+	if len(currency) != 3 {
+		return fmt.Errorf("cart currency must be an ISO 4217 code, got %q", currency)
+	}
+
+	cartPolicy := model.CartPolicy{
+		MaxSKUQuantity: cfg.CartPolicy.MaxSKUQuantity,
+		MaxLines:       cfg.CartPolicy.MaxLines,
+		MaxCartValue:   money.New(cfg.CartPolicy.MaxCartValue, currency),
+	}
+	for _, c := range cfg.CartPolicy.PurchaseCaps {
+		if c.Limit == 0 || c.Period <= 0 {
+			return fmt.Errorf("purchase cap for sku %d must have positive limit and period", c.SKU)
+		}
+		cartPolicy.PurchaseCaps = append(cartPolicy.PurchaseCaps, model.PurchaseCap{SKU: c.SKU, Limit: c.Limit, Period: c.Period})
+	}
+
+	if cfg.AbandonedCarts.CheckInterval <= 0 || cfg.AbandonedCarts.IdleAfter <= 0 {
+		return fmt.Errorf("abandoned carts check interval and idle period must be positive")
+	}
+
+	cartEventsRelayInterval := cfg.CartEvents.RelayInterval
+	if cartEventsRelayInterval <= 0 {
+		cartEventsRelayInterval = defaultCartEventsRelayInterval
+	}
+
+	// Connections to other services live as long as the service
+	lomsConn, err := grpcclient.Dial("loms", cfg.Services.Loms, clientOptions(cfg.Clients.Loms, loms.IdempotentMethods, clientCreds))
+	if err != nil {
+		return err
+	}
+	defer lomsConn.Close()
+
+	productsConn, err := grpcclient.Dial("products", cfg.Services.Products, clientOptions(cfg.Clients.Products, products.IdempotentMethods, clientCreds))
+	if err != nil {
+		return err
+	}
+	defer productsConn.Close()
+
+	// Create new kafka producer
+	producer, err := kafka.NewProducer(cfg.Brokers)
+	if err != nil {
+		return fmt.Errorf("fail connect to kafka broker: %w", err)
+	}
+	defer producer.Close()
+
 	d := domain.New(
-		loms.New(cfg.Services.Loms),
-		products.New(cfg.Services.Products, cfg.Token, *ratelimit.New(productRPSLimit, maxConcurrency)),
-		postgres.New(pool),
+		loms.New(lomsConn),
+		products.New(productsConn, cfg.Token, currency, productLimiter),
+		postgres.New(pool, postgres.WithCartEvents()),
+		postgres.NewPromotionRepository(pool),
+		postgres.NewAddressRepository(pool),
+		domain.WithMergePolicy(mergePolicy),
+		domain.WithAbandonedCartNotifier(sender.NewKafkaSender(producer, abandonedCartsTopic)),
+		domain.WithCartPolicy(cartPolicy),
+		domain.WithCartEvents(postgres.NewOutboxRepository(pool), sender.NewCartEventSender(producer, cartEventsTopic)),
 	)
 	cart_v1.RegisterCartServer(s, api.New(d))
 
+	// Remind about abandoned carts and purge stale ones in background
+	go jobs.NewAbandonedCarts(
+		d,
+		cfg.AbandonedCarts.CheckInterval,
+		cfg.AbandonedCarts.IdleAfter,
+		cfg.AbandonedCarts.Retention,
+	).Run(ctx)
+
+	// Publish cart events from the outbox in background
+	go jobs.NewCartEventsRelay(d, cartEventsRelayInterval).Run(ctx)
+
 	// Start and listen gRPC server
 	log.Printf("server listening at %v", lis.Addr())
 	go func() {
@@ -95,18 +220,18 @@ func run(ctx context.Context) error {
 		}
 	}()
 
-	// Create connection to gRPC-gateway
+	// Create connection to gRPC-gateway, the server certificate is issued for localhost
 	conn, err := grpc.DialContext(
 		context.Background(),
-		lis.Addr().String(),
+		fmt.Sprintf("localhost:%d", grpcPort),
 		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(clientCreds),
 	)
 	if err != nil {
 		return fmt.Errorf("Failed to dial server: %w", err)
 	}
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(apperr.HTTPErrorHandler))
 	err = cart_v1.RegisterCartHandler(context.Background(), mux, conn)
 	if err != nil {
 		return fmt.Errorf("Failed to register gateway: %w", err)
@@ -131,3 +256,27 @@ func run(ctx context.Context) error {
 
 	return nil
 }
+
+// Convert client config to connection options, TLS clients use the service credentials
+func clientOptions(cfg config.Client, idempotentMethods []string, tlsCreds credentials.TransportCredentials) grpcclient.Options {
+	opts := grpcclient.Options{
+		Timeout:           cfg.Timeout,
+		MethodTimeouts:    cfg.MethodTimeouts,
+		IdempotentMethods: idempotentMethods,
+		Retry: grpcclient.RetryPolicy{
+			MaxAttempts:    cfg.Retry.MaxAttempts,
+			InitialBackoff: cfg.Retry.InitialBackoff,
+			MaxBackoff:     cfg.Retry.MaxBackoff,
+		},
+		Breaker: grpcclient.BreakerPolicy{
+			FailureThreshold: cfg.Breaker.FailureThreshold,
+			OpenTimeout:      cfg.Breaker.OpenTimeout,
+		},
+		Token:         cfg.Token,
+		InsecureToken: cfg.InsecureToken,
+	}
+	if cfg.TLS {
+		opts.Credentials = tlsCreds
+	}
+	return opts
+}
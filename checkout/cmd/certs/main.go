@@ -0,0 +1,155 @@
+// Generate a local CA and service certificates for running the stack over mutual TLS
+package main
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"flag"
+	"log"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Write ca.pem and <service>.pem with <service>-key.pem for every service, e.g.
+//
+//	go run ./cmd/certs -out ../deployments/certs
+//
+// Service certificates are valid for the service name and localhost,
+// both as server and as client certificates. Rerun to rotate them, running services reload the files
+func main() {
+	out := flag.String("out", "../deployments/certs", "directory for the certificates")
+	services := flag.String("services", "checkout,loms,notifications", "comma separated service names")
+	validFor := flag.Duration("valid-for", 365*24*time.Hour, "lifetime of the certificates")
+	flag.Parse()
+
+	err := os.MkdirAll(*out, 0o755)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	ca, caKey, err := newCA(*validFor)
+	if err != nil {
+		log.Fatal(err)
+	}
+	err = writePEM(filepath.Join(*out, "ca.pem"), "CERTIFICATE", ca.Raw, 0o644)
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	for _, name := range strings.Split(*services, ",") {
+		name = strings.TrimSpace(name)
+		if name == "" {
+			continue
+		}
+
+		certDER, key, err := newServiceCert(name, ca, caKey, *validFor)
+		if err != nil {
+			log.Fatal(err)
+		}
+		keyDER, err := x509.MarshalECPrivateKey(key)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		err = writePEM(filepath.Join(*out, name+".pem"), "CERTIFICATE", certDER, 0o644)
+		if err != nil {
+			log.Fatal(err)
+		}
+		err = writePEM(filepath.Join(*out, name+"-key.pem"), "EC PRIVATE KEY", keyDER, 0o600)
+		if err != nil {
+			log.Fatal(err)
+		}
+		log.Printf("certificate for %s is written", name)
+	}
+}
+
+// Create a self-signed CA, its key is kept in memory only
+func newCA(validFor time.Duration) (*x509.Certificate, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "generate ca key")
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber:          serial,
+		Subject:               pkix.Name{CommonName: "route256 local CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(validFor),
+		KeyUsage:              x509.KeyUsageCertSign | x509.KeyUsageCRLSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "create ca certificate")
+	}
+	ca, err := x509.ParseCertificate(der)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "parse ca certificate")
+	}
+
+	return ca, key, nil
+}
+
+// Create a certificate of the service signed by the CA
+func newServiceCert(name string, ca *x509.Certificate, caKey *ecdsa.PrivateKey, validFor time.Duration) ([]byte, *ecdsa.PrivateKey, error) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "generate %s key", name)
+	}
+
+	serial, err := serialNumber()
+	if err != nil {
+		return nil, nil, err
+	}
+	template := &x509.Certificate{
+		SerialNumber: serial,
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{name, "localhost"},
+		IPAddresses:  []net.IP{net.IPv4(127, 0, 0, 1), net.IPv6loopback},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(validFor),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	if err != nil {
+		return nil, nil, errors.Wrapf(err, "create %s certificate", name)
+	}
+
+	return der, key, nil
+}
+
+func serialNumber() (*big.Int, error) {
+	serial, err := rand.Int(rand.Reader, new(big.Int).Lsh(big.NewInt(1), 128))
+	if err != nil {
+		return nil, errors.Wrap(err, "generate serial number")
+	}
+	return serial, nil
+}
+
+// Write the block to a temporary file and rename it, so services never read a half-written file
+func writePEM(path string, blockType string, der []byte, perm os.FileMode) error {
+	tmp := path + ".tmp"
+	err := os.WriteFile(tmp, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), perm)
+	if err != nil {
+		return errors.Wrap(err, "write pem file")
+	}
+	return errors.Wrap(os.Rename(tmp, path), "replace pem file")
+}
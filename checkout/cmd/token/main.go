@@ -0,0 +1,47 @@
+// Mint HS256 tokens for tests and local runs
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"route256/libs/auth"
+	"strings"
+	"time"
+)
+
+// Print a token signed with the shared secret, e.g.
+//
+//	go run ./cmd/token -secret dev-secret -user 42
+//	go run ./cmd/token -secret dev-secret -roles service -ttl 8760h
+func main() {
+	secret := flag.String("secret", "", "shared secret from auth.hmac_secret")
+	user := flag.Int64("user", 0, "user id, zero mints a token without a user")
+	roles := flag.String("roles", "", "comma separated roles: admin, service")
+	issuer := flag.String("issuer", "", "issuer from auth.issuer")
+	audience := flag.String("audience", "", "audience from auth.audience")
+	ttl := flag.Duration("ttl", time.Hour, "token lifetime")
+	flag.Parse()
+
+	if *secret == "" {
+		log.Fatal("secret is required")
+	}
+
+	params := auth.TokenParams{
+		UserID:   *user,
+		Issuer:   *issuer,
+		Audience: *audience,
+		TTL:      *ttl,
+	}
+	for _, role := range strings.Split(*roles, ",") {
+		if role = strings.TrimSpace(role); role != "" {
+			params.Roles = append(params.Roles, auth.Role(role))
+		}
+	}
+
+	token, err := auth.MintHMAC(*secret, params)
+	if err != nil {
+		log.Fatal(err)
+	}
+	fmt.Println(token)
+}
@@ -3,10 +3,9 @@ package cart
 
 import (
 	"context"
+	"route256/checkout/internal/converter/server"
 	"route256/checkout/pkg/cart_v1"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -18,12 +17,12 @@ func (s *Server) AddToCart(ctx context.Context, req *cart_v1.AddToCartRequest) (
 	}
 	err = s.service.AddToCart(
 		ctx,
-		req.GetUser(),
+		server.CartOwnerFromReq(req),
 		req.GetSku(),
 		uint16(req.GetCount()),
 	)
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
@@ -0,0 +1,24 @@
+// ApplyPromoCode
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/internal/model"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ApplyPromoCode controller
+func (s *Server) ApplyPromoCode(ctx context.Context, req *cart_v1.ApplyPromoCodeRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.ApplyPromoCode(ctx, server.CartOwnerFromReq(req), model.PromoCode(req.GetPromoCode()))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
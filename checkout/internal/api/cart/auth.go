@@ -0,0 +1,13 @@
+// Authorization rules of the cart API
+package cart
+
+import "route256/libs/auth"
+
+// Users act only for their own carts and addresses, admins for anyone.
+// Guest carts are reached by their token without authentication
+var AuthPolicy = auth.Policy{
+	Default: auth.Rule{
+		Roles:      []auth.Role{auth.RoleAdmin},
+		AllowGuest: true,
+	},
+}
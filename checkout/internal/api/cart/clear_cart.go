@@ -0,0 +1,23 @@
+// ClearCart
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// ClearCart controller
+func (s *Server) ClearCart(ctx context.Context, req *cart_v1.ClearCartRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.ClearCart(ctx, server.CartOwnerFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,21 @@
+// CreateAddress
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/pkg/cart_v1"
+)
+
+// CreateAddress controller
+func (s *Server) CreateAddress(ctx context.Context, req *cart_v1.CreateAddressRequest) (*cart_v1.CreateAddressResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	id, err := s.service.CreateAddress(ctx, server.DeliveryAddressFromReq(req.GetUser(), req.GetAddress()))
+	if err != nil {
+		return nil, err
+	}
+	return &cart_v1.CreateAddressResponse{AddressID: int64(id)}, nil
+}
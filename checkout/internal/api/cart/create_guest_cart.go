@@ -0,0 +1,18 @@
+// CreateGuestCart
+package cart
+
+import (
+	"context"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// CreateGuestCart controller
+func (s *Server) CreateGuestCart(ctx context.Context, _ *emptypb.Empty) (*cart_v1.CreateGuestCartResponse, error) {
+	token, err := s.service.CreateGuestCart(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return &cart_v1.CreateGuestCartResponse{CartToken: string(token)}, nil
+}
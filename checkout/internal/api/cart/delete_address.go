@@ -0,0 +1,23 @@
+// DeleteAddress
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// DeleteAddress controller
+func (s *Server) DeleteAddress(ctx context.Context, req *cart_v1.DeleteAddressRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.DeleteAddress(ctx, model.UserID(req.GetUser()), model.AddressID(req.GetAddressID()))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,22 @@
+// ListAddresses
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/internal/model"
+	"route256/checkout/pkg/cart_v1"
+)
+
+// ListAddresses controller
+func (s *Server) ListAddresses(ctx context.Context, req *cart_v1.ListAddressesRequest) (*cart_v1.ListAddressesResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	addresses, err := s.service.ListAddresses(ctx, model.UserID(req.GetUser()))
+	if err != nil {
+		return nil, err
+	}
+	return server.ListAddressesToRe(addresses), nil
+}
@@ -4,11 +4,7 @@ package cart
 import (
 	"context"
 	"route256/checkout/internal/converter/server"
-	"route256/checkout/internal/model"
 	"route256/checkout/pkg/cart_v1"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // ListToCart controller
@@ -17,9 +13,9 @@ func (s *Server) ListCart(ctx context.Context, req *cart_v1.ListCartRequest) (*c
 	if err != nil {
 		return nil, err
 	}
-	cart, err := s.service.ListCart(ctx, model.UserID(req.GetUser()))
+	cart, err := s.service.ListCart(ctx, server.CartOwnerFromReq(req))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, err
 	}
 
 	res := server.ListCartToRe(cart)
@@ -0,0 +1,22 @@
+// ListSaved
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/pkg/cart_v1"
+)
+
+// ListSaved controller
+func (s *Server) ListSaved(ctx context.Context, req *cart_v1.ListSavedRequest) (*cart_v1.ListSavedResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	saved, err := s.service.ListSaved(ctx, server.CartOwnerFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+
+	return server.ListSavedToRe(saved), nil
+}
@@ -0,0 +1,29 @@
+// MergeCarts
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/internal/model"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// MergeCarts controller
+func (s *Server) MergeCarts(ctx context.Context, req *cart_v1.MergeCartsRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.MergeCarts(
+		ctx,
+		model.UserID(req.GetUser()),
+		model.CartToken(req.GetCartToken()),
+		server.MergePolicyFromReq(req.GetPolicy()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
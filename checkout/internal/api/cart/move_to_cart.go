@@ -0,0 +1,24 @@
+// MoveToCart
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/internal/model"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// MoveToCart controller
+func (s *Server) MoveToCart(ctx context.Context, req *cart_v1.MoveToCartRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.MoveToCart(ctx, server.CartOwnerFromReq(req), model.SKU(req.GetSku()))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
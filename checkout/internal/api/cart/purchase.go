@@ -3,11 +3,9 @@ package cart
 
 import (
 	"context"
+	"route256/checkout/internal/converter/server"
 	"route256/checkout/internal/model"
 	"route256/checkout/pkg/cart_v1"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // Purchase controller
@@ -16,9 +14,9 @@ func (s *Server) Purchase(ctx context.Context, req *cart_v1.PurchaseRequest) (*c
 	if err != nil {
 		return nil, err
 	}
-	orderId, err := s.service.Purchase(ctx, model.UserID(req.User))
+	orderId, err := s.service.Purchase(ctx, model.UserID(req.User), model.AddressID(req.GetAddressID()), server.ShippingMethodFromReq(req.GetShippingMethod()))
 	if err != nil {
-		return &cart_v1.PurchaseResponse{}, status.Errorf(codes.Internal, err.Error())
+		return &cart_v1.PurchaseResponse{}, err
 	}
 	return &cart_v1.PurchaseResponse{OrderID: int64(orderId)}, nil
 }
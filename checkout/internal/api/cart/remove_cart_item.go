@@ -0,0 +1,24 @@
+// RemoveCartItem
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/internal/model"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RemoveCartItem controller
+func (s *Server) RemoveCartItem(ctx context.Context, req *cart_v1.RemoveCartItemRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.RemoveCartItem(ctx, server.CartOwnerFromReq(req), model.SKU(req.GetSku()))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
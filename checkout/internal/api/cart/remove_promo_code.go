@@ -0,0 +1,23 @@
+// RemovePromoCode
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// RemovePromoCode controller
+func (s *Server) RemovePromoCode(ctx context.Context, req *cart_v1.RemovePromoCodeRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.RemovePromoCode(ctx, server.CartOwnerFromReq(req))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
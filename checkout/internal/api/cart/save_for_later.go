@@ -0,0 +1,24 @@
+// SaveForLater
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/internal/model"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SaveForLater controller
+func (s *Server) SaveForLater(ctx context.Context, req *cart_v1.SaveForLaterRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.SaveForLater(ctx, server.CartOwnerFromReq(req), model.SKU(req.GetSku()))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -0,0 +1,24 @@
+// SetCartItemCount
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/internal/model"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// SetCartItemCount controller
+func (s *Server) SetCartItemCount(ctx context.Context, req *cart_v1.SetCartItemCountRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	err = s.service.SetCartItemCount(ctx, server.CartOwnerFromReq(req), model.SKU(req.GetSku()), uint16(req.GetCount()))
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
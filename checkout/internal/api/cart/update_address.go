@@ -0,0 +1,26 @@
+// UpdateAddress
+package cart
+
+import (
+	"context"
+	"route256/checkout/internal/converter/server"
+	"route256/checkout/internal/model"
+	"route256/checkout/pkg/cart_v1"
+
+	"google.golang.org/protobuf/types/known/emptypb"
+)
+
+// UpdateAddress controller
+func (s *Server) UpdateAddress(ctx context.Context, req *cart_v1.UpdateAddressRequest) (*emptypb.Empty, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	address := server.DeliveryAddressFromReq(req.GetUser(), req.GetAddress())
+	address.ID = model.AddressID(req.GetAddressID())
+	err = s.service.UpdateAddress(ctx, address)
+	if err != nil {
+		return nil, err
+	}
+	return &emptypb.Empty{}, nil
+}
@@ -3,24 +3,36 @@ package loms
 
 import (
 	"context"
+	"time"
 
 	"route256/checkout/internal/model"
 	"route256/checkout/internal/pkg/tracer"
 	"route256/checkout/pkg/loms_v1"
+	"route256/libs/money"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
+// Reason of the errors LOMS reports for broken business limits
+const lomsPolicyViolation = "POLICY_VIOLATION"
+
+// Methods that are safe to retry
+var IdempotentMethods = []string{"Stocks", "UserPurchases"}
+
 // Implement interaction with the loms service
 type Client struct {
-	lomsAddress string
+	client loms_v1.LomsClient
 }
 
-// Creates a new client instance
-func New(clientAddress string) *Client {
-	return &Client{lomsAddress: clientAddress}
+// Creates a new client instance over a connection shared by all requests
+func New(conn grpc.ClientConnInterface) *Client {
+	return &Client{client: loms_v1.NewLomsClient(conn)}
 }
 
 // Get the quantity of goods from all warehouses from the service loms
@@ -32,18 +44,8 @@ func (c *Client) GetStocksBySKU(ctx context.Context, sku uint32) ([]model.Stock,
 		Sku: sku,
 	}
 
-	// Connect to loams service
-	con, err := grpc.Dial(c.lomsAddress, grpc.WithInsecure())
-	if err != nil {
-		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "can not connect to server loms"))
-	}
-	defer con.Close()
-
-	// Create client for loms service
-	lomsClient := loms_v1.NewLomsClient(con)
-
 	// Do request
-	resp, err := lomsClient.Stocks(ctx, requestStocks)
+	resp, err := c.client.Stocks(ctx, requestStocks)
 	if err != nil {
 		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "send request error"))
 	}
@@ -59,8 +61,9 @@ func (c *Client) GetStocksBySKU(ctx context.Context, sku uint32) ([]model.Stock,
 	return items, nil
 }
 
-// Create user order
-func (c *Client) CreateOrder(ctx context.Context, user model.UserID, userGoods []model.CartItem) (model.OrderID, error) {
+// Create user order with a snapshot of item prices and the delivery address, an empty promotion is not sent.
+// LOMS checks the purchase caps when it creates the order, a reached cap is returned as model.ErrPurchaseCapReached
+func (c *Client) CreateOrder(ctx context.Context, user model.UserID, userGoods []model.Good, promotion model.OrderPromotion, delivery model.Delivery, caps []model.OrderCap) (model.OrderID, error) {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "clients/loms/create_order")
 	defer span.Finish()
 
@@ -69,28 +72,115 @@ func (c *Client) CreateOrder(ctx context.Context, user model.UserID, userGoods [
 		items = append(items, &loms_v1.OrderItem{
 			Sku:   v.SKU,
 			Count: uint32(v.Count),
+			Price: moneyToReq(v.Price),
+		})
+	}
+	purchaseCaps := make([]*loms_v1.PurchaseCap, 0, len(caps))
+	for _, c := range caps {
+		purchaseCaps = append(purchaseCaps, &loms_v1.PurchaseCap{
+			Sku:   c.SKU,
+			Limit: c.Limit,
+			Since: timestamppb.New(c.Since),
 		})
 	}
 	requestPurchase := &loms_v1.CreateOrderRequest{
-		User:  int64(user),
-		Items: items,
+		User:         int64(user),
+		Items:        items,
+		Shipping:     shippingToReq(delivery),
+		PurchaseCaps: purchaseCaps,
+	}
+	if promotion.Code != "" {
+		requestPurchase.Promotion = &loms_v1.OrderPromotion{
+			Code:     string(promotion.Code),
+			Discount: moneyToReq(promotion.Discount),
+		}
 	}
 
-	// Connect to loams service
-	con, err := grpc.Dial(c.lomsAddress, grpc.WithInsecure())
+	// Do request
+	resp, err := c.client.CreateOrder(ctx, requestPurchase)
 	if err != nil {
-		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "can not connect to server loms"))
+		if details, ok := policyViolation(err); ok {
+			return 0, tracer.MarkSpanWithError(ctx, model.ErrPurchaseCapReached.WithDetails(details))
+		}
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "send request error"))
 	}
-	defer con.Close()
 
-	// Create client for loms service
-	lomsClient := loms_v1.NewLomsClient(con)
+	return model.OrderID(resp.GetOrderID()), nil
+}
+
+// Get units of the items the user has ordered since the moment
+func (c *Client) GetUserPurchases(ctx context.Context, user model.UserID, since time.Time, skus []uint32) (map[uint32]uint64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "clients/loms/get_user_purchases")
+	defer span.Finish()
+
+	request := &loms_v1.UserPurchasesRequest{
+		User:  int64(user),
+		Since: timestamppb.New(since),
+		Skus:  skus,
+	}
 
 	// Do request
-	resp, err := lomsClient.CreateOrder(ctx, requestPurchase)
+	resp, err := c.client.UserPurchases(ctx, request)
 	if err != nil {
-		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "send request error"))
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "send request error"))
 	}
 
-	return model.OrderID(resp.GetOrderID()), nil
+	result := make(map[uint32]uint64, len(resp.GetItems()))
+	for _, item := range resp.GetItems() {
+		result[item.GetSku()] = item.GetCount()
+	}
+
+	return result, nil
+}
+
+// Get the details of a policy violation reported by LOMS
+func policyViolation(err error) (map[string]string, bool) {
+	st, ok := status.FromError(err)
+	if !ok || st.Code() != codes.FailedPrecondition {
+		return nil, false
+	}
+	for _, detail := range st.Details() {
+		if info, ok := detail.(*errdetails.ErrorInfo); ok && info.GetReason() == lomsPolicyViolation {
+			return info.GetMetadata(), true
+		}
+	}
+	return nil, false
+}
+
+// Convert amount of money to request object
+func moneyToReq(amount money.Money) *loms_v1.Money {
+	return &loms_v1.Money{
+		Amount:   amount.Amount,
+		Currency: string(amount.Currency),
+	}
+}
+
+// Convert delivery to request object
+func shippingToReq(delivery model.Delivery) *loms_v1.Shipping {
+	var method loms_v1.ShippingMethod
+	switch delivery.Method {
+	case model.ShippingCourier:
+		method = loms_v1.ShippingMethod_SHIPPING_METHOD_COURIER
+	case model.ShippingPickupPoint:
+		method = loms_v1.ShippingMethod_SHIPPING_METHOD_PICKUP_POINT
+	case model.ShippingWarehousePickup:
+		method = loms_v1.ShippingMethod_SHIPPING_METHOD_WAREHOUSE_PICKUP
+	}
+
+	return &loms_v1.Shipping{
+		Method:  method,
+		Address: deliveryAddressToReq(delivery.Address),
+	}
+}
+
+// Convert delivery address to request object
+func deliveryAddressToReq(address model.DeliveryAddress) *loms_v1.DeliveryAddress {
+	return &loms_v1.DeliveryAddress{
+		City:       address.City,
+		Street:     address.Street,
+		House:      address.House,
+		Apartment:  address.Apartment,
+		PostalCode: address.PostalCode,
+		Comment:    address.Comment,
+	}
 }
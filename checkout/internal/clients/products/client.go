@@ -5,11 +5,10 @@ import (
 	"context"
 	"log"
 	"route256/checkout/internal/model"
-	"route256/checkout/internal/pkg/ratelimit"
 	"route256/checkout/internal/pkg/tracer"
 	"route256/checkout/internal/pkg/workerpool"
 	"route256/checkout/pkg/product_v1"
-	"sync"
+	"route256/libs/money"
 
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
@@ -21,16 +20,27 @@ const (
 	workerCount  = 10
 )
 
+// Describe a limiter for requests to the product service
+type RateLimiter interface {
+	Wait(ctx context.Context) error
+	Observe(err error)
+}
+
+// Methods that are safe to retry
+var IdempotentMethods = []string{"GetProduct"}
+
 // Implement interaction with the product service
 type Client struct {
-	productAddress string
-	token          string
-	limiter        ratelimit.RateLimiter
+	client   product_v1.ProductServiceClient
+	token    string
+	currency money.Currency
+	limiter  RateLimiter
 }
 
-// Creates a new client instance
-func New(address string, token string, limiter ratelimit.RateLimiter) *Client {
-	return &Client{productAddress: address, token: token, limiter: limiter}
+// Creates a new client instance over a connection shared by all requests,
+// the product service gives prices in minor units of the currency without a currency code
+func New(conn grpc.ClientConnInterface, token string, currency money.Currency, limiter RateLimiter) *Client {
+	return &Client{client: product_v1.NewProductServiceClient(conn), token: token, currency: currency, limiter: limiter}
 }
 
 // Get info about list of products from the user's cart
@@ -38,63 +48,47 @@ func (c *Client) GetProducts(ctx context.Context, goods []model.CartItem) ([]mod
 	span, ctx := opentracing.StartSpanFromContext(ctx, "clients/products/get_products")
 	defer span.Finish()
 
-	// Connect to loams service
-	con, err := grpc.Dial(c.productAddress, grpc.WithInsecure())
-	if err != nil {
-		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "can not connect to server loms"))
+	tasks := make([]ClientWithData, len(goods))
+	for i, good := range goods {
+		tasks[i] = ClientWithData{
+			Good:     good,
+			Token:    c.token,
+			Currency: c.currency,
+			Con:      c.client,
+		}
 	}
-	defer con.Close()
 
-	result := make([]model.Good, 0, len(goods))
-	// Create client for loms service
-	productClient := product_v1.NewProductServiceClient(con)
-
-	pool := workerpool.NewPool[ClientWithData, model.Good](ctx, workerCount)
-
-	var wg sync.WaitGroup
-	wg.Add(len(goods))
-	for i := 0; i < len(goods); i++ {
+	goodsInfo := workerpool.Map(ctx, workerCount, tasks, func(ctx context.Context, request ClientWithData) (model.Good, error) {
 		// Wait if the limit of requests per second has already been reached
-		err := c.limiter.Acquire(ctx)
-		if err != nil {
-			return nil, tracer.MarkSpanWithError(ctx, err)
+		if err := c.limiter.Wait(ctx); err != nil {
+			return model.Good{}, err
 		}
 
-		// Add task to pool
-		taskData := ClientWithData{
-			Good:  goods[i],
-			Token: c.token,
-			Con:   productClient,
+		good, err := getProduct(ctx, request)
+		c.limiter.Observe(err)
+		return good, err
+	})
+	if err := ctx.Err(); err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, err)
+	}
+
+	result := make([]model.Good, 0, len(goods))
+	for _, good := range goodsInfo {
+		if good.Err != nil {
+			log.Printf("ERROR: can not get product info: %v", good.Err)
+			continue
 		}
-		p := pool.Exec(taskData, getProduct)
-
-		go func(index int) {
-			defer wg.Done()
-			defer c.limiter.Release()
-
-			good := <-p.Out
-			if good.Err != nil {
-				log.Printf("ERROR: can not get product info: %v", good.Err)
-				return
-			}
-			result = append(result, model.Good{
-				SKU:   goods[index].SKU,
-				Count: goods[index].Count,
-				Name:  good.Value.Name,
-				Price: good.Value.Price,
-			})
-		}(i)
+		result = append(result, *good.Value)
 	}
-	wg.Wait()
-	c.limiter.Close()
 
 	return result, nil
 }
 
 type ClientWithData struct {
-	Good  model.CartItem
-	Token string
-	Con   product_v1.ProductServiceClient
+	Good     model.CartItem
+	Token    string
+	Currency money.Currency
+	Con      product_v1.ProductServiceClient
 }
 
 // Get info for one product
@@ -111,7 +105,7 @@ func getProduct(ctx context.Context, request ClientWithData) (model.Good, error)
 	}
 	return model.Good{
 		Name:  resp.Name,
-		Price: resp.Price,
+		Price: money.New(int64(resp.Price), request.Currency),
 		SKU:   request.Good.SKU,
 		Count: request.Good.Count,
 	}, nil
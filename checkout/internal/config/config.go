@@ -3,6 +3,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -17,6 +18,12 @@ type Config struct {
 		Loms     string `yaml:"loms"`
 		Products string `yaml:"products"`
 	} `yaml:"services"`
+	Clients struct {
+		Loms     Client `yaml:"loms"`
+		Products Client `yaml:"products"`
+	} `yaml:"clients"`
+	Auth     Auth `yaml:"auth"`
+	TLS      TLS  `yaml:"tls"`
 	Postgres struct {
 		ConnectionString       string `yaml:"connection_string"`
 		TestDBConnectionString string `yaml:"test_db_connection_string"`
@@ -25,6 +32,82 @@ type Config struct {
 		Host string `yaml:"host"`
 		Port string `yaml:"port"`
 	} `yaml:"jaeger"`
+	Brokers []string `yaml:"brokers"`
+	Cart    struct {
+		MergePolicy string `yaml:"merge_policy"`
+		Currency    string `yaml:"currency"`
+	} `yaml:"cart"`
+	CartPolicy struct {
+		MaxSKUQuantity uint16 `yaml:"max_sku_quantity"`
+		MaxLines       int    `yaml:"max_lines"`
+		// In minor units of the cart currency
+		MaxCartValue int64         `yaml:"max_cart_value"`
+		PurchaseCaps []PurchaseCap `yaml:"purchase_caps"`
+	} `yaml:"cart_policy"`
+	AbandonedCarts struct {
+		CheckInterval time.Duration `yaml:"check_interval"`
+		IdleAfter     time.Duration `yaml:"idle_after"`
+		Retention     time.Duration `yaml:"retention"`
+	} `yaml:"abandoned_carts"`
+	CartEvents struct {
+		RelayInterval time.Duration `yaml:"relay_interval"`
+	} `yaml:"cart_events"`
+	RateLimits struct {
+		Products RateLimit `yaml:"products"`
+	} `yaml:"rate_limits"`
+}
+
+// Deadlines, retries and circuit breaking of a client of another service
+type Client struct {
+	// Deadline of a call if the method has no own timeout
+	Timeout time.Duration `yaml:"timeout"`
+	// Deadlines by method name, e.g. CreateOrder
+	MethodTimeouts map[string]time.Duration `yaml:"method_timeouts"`
+	Retry          struct {
+		MaxAttempts    int           `yaml:"max_attempts"`
+		InitialBackoff time.Duration `yaml:"initial_backoff"`
+		MaxBackoff     time.Duration `yaml:"max_backoff"`
+	} `yaml:"retry"`
+	Breaker struct {
+		FailureThreshold int           `yaml:"failure_threshold"`
+		OpenTimeout      time.Duration `yaml:"open_timeout"`
+	} `yaml:"breaker"`
+	// Bearer token sent with every call, empty sends none
+	Token string `yaml:"token"`
+	// Send the token without TLS, only for local development
+	InsecureToken bool `yaml:"insecure_token"`
+	// Connect with the service certificate and CA from the tls section
+	TLS bool `yaml:"tls"`
+}
+
+// Keys and expected claims of bearer tokens, one of the keys is set
+type Auth struct {
+	HMACSecret string `yaml:"hmac_secret"`
+	JWKSFile   string `yaml:"jwks_file"`
+	Issuer     string `yaml:"issuer"`
+	Audience   string `yaml:"audience"`
+}
+
+// Certificate files of the service, TLS is off when they are empty
+type TLS struct {
+	CertFile          string        `yaml:"cert_file"`
+	KeyFile           string        `yaml:"key_file"`
+	CAFile            string        `yaml:"ca_file"`
+	RequireClientCert bool          `yaml:"require_client_cert"`
+	ReloadInterval    time.Duration `yaml:"reload_interval"`
+}
+
+// Token bucket limits for requests to an external service
+type RateLimit struct {
+	RPS   float64 `yaml:"rps"`
+	Burst int     `yaml:"burst"`
+}
+
+// Limit on units of an item a user can buy within a period
+type PurchaseCap struct {
+	SKU    uint32        `yaml:"sku"`
+	Limit  uint64        `yaml:"limit"`
+	Period time.Duration `yaml:"period"`
 }
 
 // Create a new instance of the config
@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"encoding/json"
 	"route256/checkout/internal/model"
 	"route256/checkout/internal/repository/schema"
 )
@@ -21,3 +22,109 @@ func ToCartItems(cartItems []schema.CartItem) []model.CartItem {
 	}
 	return result
 }
+
+// Convert db cart to abandoned cart model without items
+func ToAbandonedCart(cart schema.Cart) model.AbandonedCart {
+	result := model.AbandonedCart{
+		ID:        model.UserCartID(cart.ID),
+		UpdatedAt: cart.UpdatedAt,
+	}
+	if cart.UserID != nil {
+		result.UserID = model.UserID(*cart.UserID)
+	}
+	return result
+}
+
+// Convert db carts to abandoned cart models
+func ToAbandonedCarts(carts []schema.Cart) []model.AbandonedCart {
+	result := make([]model.AbandonedCart, len(carts))
+	for i, cart := range carts {
+		result[i] = ToAbandonedCart(cart)
+	}
+	return result
+}
+
+// Convert db promotion to domain model object
+func ToPromotion(promotion schema.Promotion) model.Promotion {
+	result := model.Promotion{
+		ID:           promotion.ID,
+		Code:         model.PromoCode(promotion.Code),
+		Kind:         model.PromotionKind(promotion.Kind),
+		Value:        promotion.Value,
+		SKU:          promotion.SKU,
+		BuyCount:     promotion.BuyCount,
+		PayCount:     promotion.PayCount,
+		MinCartTotal: promotion.MinCartTotal,
+		UsageLimit:   promotion.UsageLimit,
+	}
+	if promotion.ValidFrom != nil {
+		result.ValidFrom = *promotion.ValidFrom
+	}
+	if promotion.ValidTo != nil {
+		result.ValidTo = *promotion.ValidTo
+	}
+	return result
+}
+
+// Convert db delivery address to domain model object
+func ToDeliveryAddress(address schema.DeliveryAddress) model.DeliveryAddress {
+	return model.DeliveryAddress{
+		ID:         model.AddressID(address.ID),
+		UserID:     model.UserID(address.UserID),
+		City:       address.City,
+		Street:     address.Street,
+		House:      address.House,
+		Apartment:  address.Apartment,
+		PostalCode: address.PostalCode,
+		Comment:    address.Comment,
+	}
+}
+
+// Convert db delivery addresses to domain model objects
+func ToDeliveryAddresses(addresses []schema.DeliveryAddress) []model.DeliveryAddress {
+	result := make([]model.DeliveryAddress, len(addresses))
+	for i, address := range addresses {
+		result[i] = ToDeliveryAddress(address)
+	}
+	return result
+}
+
+// Convert saved items from db to domain model objects
+func SavedToCartItems(savedItems []schema.SavedItem) []model.CartItem {
+	result := make([]model.CartItem, len(savedItems))
+	for i, savedItem := range savedItems {
+		result[i] = model.CartItem{
+			SKU:   savedItem.SKU,
+			Count: savedItem.Count,
+		}
+	}
+	return result
+}
+
+// Convert db cart event to domain model object
+func ToCartEvent(event schema.CartEvent) model.CartEvent {
+	result := model.CartEvent{
+		ID:        event.ID,
+		Type:      model.CartEventType(event.EventType),
+		UserID:    model.UserID(event.UserID),
+		CartID:    model.UserCartID(event.CartID),
+		SKU:       event.SKU,
+		Count:     event.Count,
+		OrderID:   model.OrderID(event.OrderID),
+		CreatedAt: event.CreatedAt,
+	}
+	// A broken trace context only detaches the event from its trace
+	if len(event.TraceContext) > 0 && json.Unmarshal(event.TraceContext, &result.TraceContext) != nil {
+		result.TraceContext = nil
+	}
+	return result
+}
+
+// Convert db cart events to domain model objects
+func ToCartEvents(events []schema.CartEvent) []model.CartEvent {
+	result := make([]model.CartEvent, len(events))
+	for i, event := range events {
+		result[i] = ToCartEvent(event)
+	}
+	return result
+}
@@ -4,15 +4,25 @@ package server
 import (
 	"route256/checkout/internal/model"
 	"route256/checkout/pkg/cart_v1"
+	"route256/libs/money"
 )
 
 // Convert Good to response object
 func CartItemToRe(item model.Good) *cart_v1.CartGoodInfo {
 	return &cart_v1.CartGoodInfo{
-		Sku:   item.SKU,
-		Count: uint32(item.Count),
-		Name:  item.Name,
-		Price: item.Price,
+		Sku:      item.SKU,
+		Count:    uint32(item.Count),
+		Name:     item.Name,
+		Price:    MoneyToRe(item.Price),
+		Discount: MoneyToRe(item.Discount),
+	}
+}
+
+// Convert Money to response object
+func MoneyToRe(amount money.Money) *cart_v1.Money {
+	return &cart_v1.Money{
+		Amount:   amount.Amount,
+		Currency: string(amount.Currency),
 	}
 }
 
@@ -23,7 +33,101 @@ func ListCartToRe(req model.UserCartWithTotal) *cart_v1.ListCartResponse {
 		items = append(items, CartItemToRe(item))
 	}
 	return &cart_v1.ListCartResponse{
-		Items:      items,
-		TotalPrice: req.TotalPrice,
+		Items:         items,
+		TotalPrice:    MoneyToRe(req.TotalPrice),
+		PromoCode:     string(req.PromoCode),
+		PromoApplied:  req.PromoApplied,
+		CartDiscount:  MoneyToRe(req.CartDiscount),
+		TotalDiscount: MoneyToRe(req.TotalDiscount),
+	}
+}
+
+// Convert saved items to response object
+func ListSavedToRe(saved []model.SavedItem) *cart_v1.ListSavedResponse {
+	items := make([]*cart_v1.SavedItem, 0, len(saved))
+	for _, item := range saved {
+		items = append(items, &cart_v1.SavedItem{
+			Item:      CartItemToRe(item.Good),
+			InStock:   item.InStock,
+			Available: item.Available,
+		})
+	}
+	return &cart_v1.ListSavedResponse{Items: items}
+}
+
+// Describe a request that identifies the cart by a user or a guest cart token
+type CartOwnerRequest interface {
+	GetUser() int64
+	GetCartToken() string
+}
+
+// Convert cart owner from request
+func CartOwnerFromReq(req CartOwnerRequest) model.CartOwner {
+	return model.CartOwner{
+		UserID: model.UserID(req.GetUser()),
+		Token:  model.CartToken(req.GetCartToken()),
+	}
+}
+
+// Convert merge policy from request, unspecified policy becomes empty
+func MergePolicyFromReq(policy cart_v1.MergePolicy) model.MergePolicy {
+	switch policy {
+	case cart_v1.MergePolicy_MERGE_POLICY_SUM:
+		return model.MergePolicySum
+	case cart_v1.MergePolicy_MERGE_POLICY_MAX:
+		return model.MergePolicyMax
+	case cart_v1.MergePolicy_MERGE_POLICY_PREFER_GUEST:
+		return model.MergePolicyPreferGuest
+	default:
+		return ""
+	}
+}
+
+// Convert delivery address from request
+func DeliveryAddressFromReq(user int64, address *cart_v1.DeliveryAddress) model.DeliveryAddress {
+	return model.DeliveryAddress{
+		UserID:     model.UserID(user),
+		City:       address.GetCity(),
+		Street:     address.GetStreet(),
+		House:      address.GetHouse(),
+		Apartment:  address.GetApartment(),
+		PostalCode: address.GetPostalCode(),
+		Comment:    address.GetComment(),
+	}
+}
+
+// Convert DeliveryAddress to response object
+func DeliveryAddressToRe(address model.DeliveryAddress) *cart_v1.DeliveryAddress {
+	return &cart_v1.DeliveryAddress{
+		Id:         int64(address.ID),
+		City:       address.City,
+		Street:     address.Street,
+		House:      address.House,
+		Apartment:  address.Apartment,
+		PostalCode: address.PostalCode,
+		Comment:    address.Comment,
+	}
+}
+
+// Convert delivery addresses to response object
+func ListAddressesToRe(addresses []model.DeliveryAddress) *cart_v1.ListAddressesResponse {
+	items := make([]*cart_v1.DeliveryAddress, 0, len(addresses))
+	for _, address := range addresses {
+		items = append(items, DeliveryAddressToRe(address))
+	}
+	return &cart_v1.ListAddressesResponse{Addresses: items}
+}
+
+// Convert shipping method from request, unspecified method becomes empty
+func ShippingMethodFromReq(method cart_v1.ShippingMethod) model.ShippingMethod {
+	switch method {
+	case cart_v1.ShippingMethod_SHIPPING_METHOD_COURIER:
+		return model.ShippingCourier
+	case cart_v1.ShippingMethod_SHIPPING_METHOD_PICKUP_POINT:
+		return model.ShippingPickupPoint
+	case cart_v1.ShippingMethod_SHIPPING_METHOD_WAREHOUSE_PICKUP:
+		return model.ShippingWarehousePickup
+	default:
+		return ""
 	}
 }
@@ -0,0 +1,63 @@
+// Reminding users about abandoned carts and purging stale carts
+package domain
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Max number of carts handled by one reminder run
+const abandonedCartsBatchSize = 100
+
+var (
+	ErrNoAbandonedCartNotifier = errors.New("abandoned cart notifier is not set")
+)
+
+// Send a reminder for every user cart that has not been touched for idleFor,
+// each cart is reminded about once until it changes again.
+// Return the number of sent reminders
+func (s *Service) RemindAbandonedCarts(ctx context.Context, idleFor time.Duration) (int, error) {
+	if s.abandoned == nil {
+		return 0, ErrNoAbandonedCartNotifier
+	}
+
+	carts, err := s.cart.ListAbandonedCarts(ctx, time.Now().Add(-idleFor), abandonedCartsBatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "can not get abandoned carts")
+	}
+
+	sent := 0
+	for _, cart := range carts {
+		cart.Items, err = s.cart.ListCart(ctx, cart.ID)
+		if err != nil {
+			return sent, errors.Wrap(err, "can not get cart items")
+		}
+
+		err = s.abandoned.SendAbandonedCart(cart)
+		if err != nil {
+			return sent, errors.Wrap(err, "can not send abandoned cart reminder")
+		}
+
+		// A cart changed since it was listed is not marked and is reminded about again after idleFor
+		err = s.cart.MarkCartReminded(ctx, cart.ID, cart.UpdatedAt)
+		if err != nil {
+			return sent, errors.Wrap(err, "can not mark cart as reminded")
+		}
+		sent++
+	}
+
+	return sent, nil
+}
+
+// Delete carts that have not been touched for the retention period,
+// return the number of deleted carts
+func (s *Service) PurgeExpiredCarts(ctx context.Context, retention time.Duration) (int64, error) {
+	purged, err := s.cart.PurgeCarts(ctx, time.Now().Add(-retention))
+	if err != nil {
+		return 0, errors.Wrap(err, "can not purge carts")
+	}
+
+	return purged, nil
+}
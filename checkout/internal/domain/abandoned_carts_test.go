@@ -0,0 +1,107 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"route256/checkout/internal/domain/mocks"
+	"route256/checkout/internal/model"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_RemindAbandonedCarts(t *testing.T) {
+	t.Parallel()
+
+	idleFor := 24 * time.Hour
+	updatedAt := time.Date(2023, 8, 1, 12, 0, 0, 0, time.UTC)
+	items := []model.CartItem{{SKU: 1, Count: 2}}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		cartRepository := mocks.NewCartRepository(t)
+		notifier := mocks.NewAbandonedCartNotifier(t)
+
+		before := time.Now()
+		cartRepository.On("ListAbandonedCarts", mock.Anything, mock.MatchedBy(func(idleSince time.Time) bool {
+			return !idleSince.Before(before.Add(-idleFor)) && idleSince.Before(before.Add(-idleFor).Add(time.Minute))
+		}), uint64(abandonedCartsBatchSize)).Return([]model.AbandonedCart{
+			{ID: 10, UserID: 1, UpdatedAt: updatedAt},
+			{ID: 20, UserID: 2, UpdatedAt: updatedAt},
+		}, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, model.UserCartID(10)).Return(items, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, model.UserCartID(20)).Return(items, nil).Once()
+		notifier.On("SendAbandonedCart", model.AbandonedCart{ID: 10, UserID: 1, UpdatedAt: updatedAt, Items: items}).Return(nil).Once()
+		notifier.On("SendAbandonedCart", model.AbandonedCart{ID: 20, UserID: 2, UpdatedAt: updatedAt, Items: items}).Return(nil).Once()
+		cartRepository.On("MarkCartReminded", mock.Anything, model.UserCartID(10), updatedAt).Return(nil).Once()
+		cartRepository.On("MarkCartReminded", mock.Anything, model.UserCartID(20), updatedAt).Return(nil).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t), WithAbandonedCartNotifier(notifier))
+
+		// Act
+		sent, err := service.RemindAbandonedCarts(context.Background(), idleFor)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, 2, sent)
+	})
+
+	t.Run("cart is not marked when sending fails", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		errStub := errors.New("stub")
+		cartRepository := mocks.NewCartRepository(t)
+		notifier := mocks.NewAbandonedCartNotifier(t)
+
+		cartRepository.On("ListAbandonedCarts", mock.Anything, mock.Anything, mock.Anything).Return([]model.AbandonedCart{
+			{ID: 10, UserID: 1, UpdatedAt: updatedAt},
+		}, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, model.UserCartID(10)).Return(items, nil).Once()
+		notifier.On("SendAbandonedCart", mock.Anything).Return(errStub).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t), WithAbandonedCartNotifier(notifier))
+
+		// Act
+		sent, err := service.RemindAbandonedCarts(context.Background(), idleFor)
+
+		// Assert
+		require.ErrorIs(t, err, errStub)
+		require.Zero(t, sent)
+	})
+
+	t.Run("error notifier is not set", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		_, err := service.RemindAbandonedCarts(context.Background(), idleFor)
+
+		// Assert
+		require.ErrorIs(t, err, ErrNoAbandonedCartNotifier)
+	})
+}
+
+func Test_PurgeExpiredCarts(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	retention := 30 * 24 * time.Hour
+	cartRepository := mocks.NewCartRepository(t)
+
+	before := time.Now()
+	cartRepository.On("PurgeCarts", mock.Anything, mock.MatchedBy(func(threshold time.Time) bool {
+		return !threshold.After(before.Add(-retention).Add(time.Minute)) && !threshold.Before(before.Add(-retention))
+	})).Return(int64(3), nil).Once()
+
+	service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+	// Act
+	purged, err := service.PurgeExpiredCarts(context.Background(), retention)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, int64(3), purged)
+}
@@ -5,37 +5,70 @@ import (
 	"context"
 	"github.com/pkg/errors"
 	"route256/checkout/internal/model"
+	"route256/libs/apperr"
+	"strconv"
 )
 
 var (
-	ErrStockInsufficient = errors.New("stock insufficient")
+	ErrStockInsufficient = apperr.New(apperr.InsufficientStock, "stock insufficient")
 )
 
 // Add items to the user's cart if they are available
-func (s *Service) AddToCart(ctx context.Context, user int64, sku uint32, count uint16) error {
+func (s *Service) AddToCart(ctx context.Context, owner model.CartOwner, sku uint32, count uint16) error {
+	err := s.checkStocks(ctx, sku, uint64(count))
+	if err != nil {
+		return err
+	}
+
+	cart, err := s.getOrCreateCart(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkItemChange(ctx, owner, cart, sku, func(current uint16) uint64 {
+		return uint64(current) + uint64(count)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.cart.UpdateOrAddToCart(ctx, cart, model.SKU(sku), count)
+	if err != nil {
+		return errors.Wrap(err, "could not add item to cart")
+	}
+
+	return nil
+}
+
+// Check that the required count of the item is available in warehouses
+func (s *Service) checkStocks(ctx context.Context, sku uint32, count uint64) error {
+	available, err := s.availableCount(ctx, sku)
+	if err != nil {
+		return err
+	}
+
+	if available < count {
+		return ErrStockInsufficient.WithDetails(map[string]string{
+			"sku":       strconv.FormatUint(uint64(sku), 10),
+			"requested": strconv.FormatUint(count, 10),
+			"available": strconv.FormatUint(available, 10),
+		})
+	}
+
+	return nil
+}
+
+// Get the count of the item available in all warehouses
+func (s *Service) availableCount(ctx context.Context, sku uint32) (uint64, error) {
 	stocks, err := s.lomsChecker.GetStocksBySKU(ctx, sku)
 	if err != nil {
-		return errors.Wrap(err, "get stocks")
+		return 0, errors.Wrap(err, "get stocks")
 	}
-	counter := int64(count)
+
+	var available uint64
 	for _, stock := range stocks {
-		counter -= int64(stock.Count)
-		if counter <= 0 {
-			// Get user's cart
-			cart, err := s.cart.GetCartByUserID(ctx, model.UserID(user))
-			if err != nil {
-				cart, err = s.cart.CreateCart(ctx, model.UserID(user))
-				if err != nil {
-					return errors.Wrap(err, "create cart")
-				}
-			}
-			err = s.cart.UpdateOrAddToCart(ctx, cart, model.SKU(sku), count)
-			if err != nil {
-				return errors.Wrap(err, "could not add item to cart")
-			}
-			return nil
-		}
-	}
-
-	return ErrStockInsufficient
+		available += stock.Count
+	}
+
+	return available, nil
 }
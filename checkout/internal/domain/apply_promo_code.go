@@ -0,0 +1,40 @@
+// Attaching a promo code to the cart
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Check the promo code against the current cart and attach it to the cart,
+// a previously attached code is replaced
+func (s *Service) ApplyPromoCode(ctx context.Context, owner model.CartOwner, code model.PromoCode) error {
+	cart, err := s.getCart(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	items, err := s.cart.ListCart(ctx, cart)
+	if err != nil {
+		return errors.Wrap(err, "can not get cart info")
+	}
+
+	goods, err := s.getGoods(ctx, items)
+	if err != nil {
+		return err
+	}
+
+	_, err = s.getValidPromotion(ctx, owner, code, goods)
+	if err != nil {
+		return err
+	}
+
+	err = s.cart.SetCartPromoCode(ctx, cart, code)
+	if err != nil {
+		return errors.Wrap(err, "can not attach promo code")
+	}
+
+	return nil
+}
@@ -0,0 +1,48 @@
+// Resolving the cart of a user or a guest
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Get the cart of a registered user or a guest
+func (s *Service) getCart(ctx context.Context, owner model.CartOwner) (model.UserCartID, error) {
+	if owner.IsGuest() {
+		cart, err := s.cart.GetCartByToken(ctx, owner.Token)
+		if err != nil {
+			return 0, errors.Wrap(err, "can not get guest cart")
+		}
+		return cart, nil
+	}
+
+	cart, err := s.cart.GetCartByUserID(ctx, owner.UserID)
+	if err != nil {
+		return 0, errors.Wrap(err, "can not get user cart")
+	}
+	return cart, nil
+}
+
+// Get the owner's cart or create it if a registered user does not have one yet,
+// guest carts are only created by CreateGuestCart
+func (s *Service) getOrCreateCart(ctx context.Context, owner model.CartOwner) (model.UserCartID, error) {
+	if owner.IsGuest() {
+		return s.getCart(ctx, owner)
+	}
+
+	cart, err := s.cart.GetCartByUserID(ctx, owner.UserID)
+	if errors.Is(err, model.ErrCartNotFound) {
+		cart, err = s.cart.CreateCart(ctx, owner.UserID)
+		if err != nil {
+			return 0, errors.Wrap(err, "create cart")
+		}
+		return cart, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "can not get user cart")
+	}
+
+	return cart, nil
+}
@@ -0,0 +1,49 @@
+// Publishing cart events recorded by the cart repository
+package domain
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+)
+
+// Max number of events published in one batch
+const cartEventsBatchSize = 100
+
+var (
+	ErrNoCartEventPublisher = errors.New("cart event publisher is not set")
+)
+
+// Publish a batch of events from the outbox and delete them after publishing.
+// Events are delivered at least once, consumers deduplicate them by id.
+// Return the number of published events
+func (s *Service) PublishCartEvents(ctx context.Context) (int, error) {
+	if s.outbox == nil || s.events == nil {
+		return 0, ErrNoCartEventPublisher
+	}
+
+	events, err := s.outbox.ListCartEvents(ctx, cartEventsBatchSize)
+	if err != nil {
+		return 0, errors.Wrap(err, "can not get cart events")
+	}
+	if len(events) == 0 {
+		return 0, nil
+	}
+
+	err = s.events.SendCartEvents(events)
+	if err != nil {
+		return 0, errors.Wrap(err, "can not send cart events")
+	}
+
+	ids := make([]int64, len(events))
+	for i, event := range events {
+		ids[i] = event.ID
+	}
+
+	err = s.outbox.DeleteCartEvents(ctx, ids)
+	if err != nil {
+		return len(events), errors.Wrap(err, "can not delete published cart events")
+	}
+
+	return len(events), nil
+}
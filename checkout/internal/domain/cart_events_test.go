@@ -0,0 +1,102 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"route256/checkout/internal/domain/mocks"
+	"route256/checkout/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CartEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("cart changes are left to the repository", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(2)
+
+		loms.On("GetStocksBySKU", mock.Anything, uint32(10)).Return([]model.Stock{{WarehouseID: 1, Count: 10}}, nil).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("SetCartItemCount", mock.Anything, userCartID, model.SKU(10), uint16(2)).Return(nil).Once()
+
+		service := New(loms, mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+			WithCartEvents(mocks.NewCartEventOutbox(t), mocks.NewCartEventPublisher(t)))
+
+		// Act
+		err := service.SetCartItemCount(context.Background(), model.CartOwner{UserID: userID}, 10, 2)
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+func Test_PublishCartEvents(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		outbox := mocks.NewCartEventOutbox(t)
+		publisher := mocks.NewCartEventPublisher(t)
+		events := []model.CartEvent{
+			{ID: 1, Type: model.CartItemAdded, UserID: 1, CartID: 2, SKU: 10, Count: 1},
+			{ID: 2, Type: model.CartCleared, UserID: 1, CartID: 2},
+		}
+
+		outbox.On("ListCartEvents", mock.Anything, uint64(cartEventsBatchSize)).Return(events, nil).Once()
+		publisher.On("SendCartEvents", events).Return(nil).Once()
+		outbox.On("DeleteCartEvents", mock.Anything, []int64{1, 2}).Return(nil).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+			WithCartEvents(outbox, publisher))
+
+		// Act
+		published, err := service.PublishCartEvents(context.Background())
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, 2, published)
+	})
+
+	t.Run("error send keeps events in outbox", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		errStub := errors.New("stub")
+		outbox := mocks.NewCartEventOutbox(t)
+		publisher := mocks.NewCartEventPublisher(t)
+		events := []model.CartEvent{{ID: 1, Type: model.CartCleared, UserID: 1, CartID: 2}}
+
+		outbox.On("ListCartEvents", mock.Anything, uint64(cartEventsBatchSize)).Return(events, nil).Once()
+		publisher.On("SendCartEvents", events).Return(errStub).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+			WithCartEvents(outbox, publisher))
+
+		// Act
+		published, err := service.PublishCartEvents(context.Background())
+
+		// Assert
+		require.ErrorIs(t, err, errStub)
+		require.Zero(t, published)
+	})
+
+	t.Run("error events are not enabled", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		_, err := service.PublishCartEvents(context.Background())
+
+		// Assert
+		require.ErrorIs(t, err, ErrNoCartEventPublisher)
+	})
+}
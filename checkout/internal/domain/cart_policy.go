@@ -0,0 +1,269 @@
+// Cart policy checks
+package domain
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"route256/checkout/internal/model"
+	"route256/libs/apperr"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrCartPolicyViolation = apperr.New(apperr.PolicyViolation, "cart policy violation")
+	// The request asks for too much by itself, e.g. more units of an item than a line may have
+	ErrCartPolicyRequestInvalid = apperr.New(apperr.InvalidArgument, "cart policy violation")
+)
+
+// Describe a rule of the cart policy
+type PolicyRule string
+
+const (
+	PolicyMaxSKUQuantity PolicyRule = "max_sku_quantity"
+	PolicyMaxLines       PolicyRule = "max_lines"
+	PolicyMaxCartValue   PolicyRule = "max_cart_value"
+	PolicyPurchaseCap    PolicyRule = "purchase_cap"
+)
+
+// Describe a violated rule of the cart policy
+type PolicyViolationError struct {
+	Rule PolicyRule
+	// Item the violation is about, zero for rules on the whole cart
+	SKU    uint32
+	Limit  int64
+	Actual int64
+	// Period of a purchase cap
+	Period time.Duration
+}
+
+// Describe the violation
+func (e *PolicyViolationError) Error() string {
+	subject := "cart"
+	if e.SKU != 0 {
+		subject = fmt.Sprintf("sku %d", e.SKU)
+	}
+	if e.Rule == PolicyPurchaseCap {
+		return fmt.Sprintf("%s: %s: %d units within %s, limit is %d", ErrCartPolicyViolation, subject, e.Actual, e.Period, e.Limit)
+	}
+	return fmt.Sprintf("%s: %s: %s is %d, limit is %d", ErrCartPolicyViolation, subject, e.Rule, e.Actual, e.Limit)
+}
+
+// Match ErrCartPolicyViolation whatever the kind of the violation
+func (e *PolicyViolationError) Is(target error) bool {
+	return target == ErrCartPolicyViolation
+}
+
+// Get the application error of the violation with the rule and the limit in details
+func (e *PolicyViolationError) Unwrap() error {
+	details := map[string]string{
+		"rule":   string(e.Rule),
+		"limit":  strconv.FormatInt(e.Limit, 10),
+		"actual": strconv.FormatInt(e.Actual, 10),
+	}
+	if e.SKU != 0 {
+		details["sku"] = strconv.FormatUint(uint64(e.SKU), 10)
+	}
+	if e.Period > 0 {
+		details["period"] = e.Period.String()
+	}
+
+	if e.IsRequestInvalid() {
+		return ErrCartPolicyRequestInvalid.WithDetails(details)
+	}
+	return ErrCartPolicyViolation.WithDetails(details)
+}
+
+// Check if the request asks for too much by itself, other violations depend on the cart and purchase history
+func (e *PolicyViolationError) IsRequestInvalid() bool {
+	return e.Rule == PolicyMaxSKUQuantity
+}
+
+// Check the cart content against the policy, goods are the priced items if the caller already has them.
+// Purchase caps are checked for registered users only
+func (s *Service) checkCartPolicy(ctx context.Context, owner model.CartOwner, items []model.CartItem, goods []model.Good) error {
+	policy := s.policy
+	if policy.IsZero() {
+		return nil
+	}
+
+	if policy.MaxSKUQuantity > 0 {
+		for _, item := range items {
+			if item.Count > policy.MaxSKUQuantity {
+				return &PolicyViolationError{Rule: PolicyMaxSKUQuantity, SKU: item.SKU, Limit: int64(policy.MaxSKUQuantity), Actual: int64(item.Count)}
+			}
+		}
+	}
+
+	if policy.MaxLines > 0 && len(items) > policy.MaxLines {
+		return &PolicyViolationError{Rule: PolicyMaxLines, Limit: int64(policy.MaxLines), Actual: int64(len(items))}
+	}
+
+	if !owner.IsGuest() && len(policy.PurchaseCaps) > 0 {
+		err := s.checkPurchaseCaps(ctx, owner.UserID, items)
+		if err != nil {
+			return err
+		}
+	}
+
+	if !policy.MaxCartValue.IsZero() && len(items) > 0 {
+		if goods == nil {
+			var err error
+			goods, err = s.getGoods(ctx, items)
+			if err != nil {
+				return err
+			}
+		}
+
+		total, err := subtotal(goods)
+		if err != nil {
+			return errors.Wrap(err, "calculate cart value")
+		}
+		cmp, err := total.Cmp(policy.MaxCartValue)
+		if err != nil {
+			return errors.Wrap(err, "compare cart value")
+		}
+		if cmp > 0 {
+			return &PolicyViolationError{Rule: PolicyMaxCartValue, Limit: policy.MaxCartValue.Amount, Actual: total.Amount}
+		}
+	}
+
+	return nil
+}
+
+// Check that units bought within each cap period plus units in the cart do not exceed the caps
+func (s *Service) checkPurchaseCaps(ctx context.Context, user model.UserID, items []model.CartItem) error {
+	// Ask LOMS once per period for all items with a cap of that period
+	skusByPeriod := make(map[time.Duration][]uint32)
+	var periods []time.Duration
+	for _, item := range items {
+		for _, c := range s.policy.CapsFor(item.SKU) {
+			if _, ok := skusByPeriod[c.Period]; !ok {
+				periods = append(periods, c.Period)
+			}
+			skusByPeriod[c.Period] = append(skusByPeriod[c.Period], item.SKU)
+		}
+	}
+
+	now := time.Now()
+	for _, period := range periods {
+		purchased, err := s.lomsChecker.GetUserPurchases(ctx, user, now.Add(-period), skusByPeriod[period])
+		if err != nil {
+			return errors.Wrap(err, "get user purchases")
+		}
+
+		for _, item := range items {
+			for _, c := range s.policy.CapsFor(item.SKU) {
+				if c.Period != period {
+					continue
+				}
+				total := purchased[item.SKU] + uint64(item.Count)
+				if total > c.Limit {
+					return &PolicyViolationError{Rule: PolicyPurchaseCap, SKU: item.SKU, Limit: int64(c.Limit), Actual: int64(total), Period: c.Period}
+				}
+			}
+		}
+	}
+
+	return nil
+}
+
+// Get the purchase caps of the order items, LOMS checks them atomically with creating the order
+// so that concurrent purchases can not exceed a cap
+func (s *Service) orderCaps(goods []model.Good) []model.OrderCap {
+	now := time.Now()
+	var caps []model.OrderCap
+	for _, good := range goods {
+		for _, c := range s.policy.CapsFor(good.SKU) {
+			caps = append(caps, model.OrderCap{SKU: good.SKU, Limit: c.Limit, Since: now.Add(-c.Period)})
+		}
+	}
+	return caps
+}
+
+// Convert a purchase cap LOMS reports as reached to a policy violation, other errors are kept
+func (s *Service) purchaseCapViolation(err error) error {
+	e, ok := apperr.As(err)
+	if !ok || !errors.Is(err, model.ErrPurchaseCapReached) {
+		return err
+	}
+
+	details := e.Details()
+	sku, _ := strconv.ParseUint(details["sku"], 10, 32)
+	limit, _ := strconv.ParseInt(details["limit"], 10, 64)
+	actual, _ := strconv.ParseInt(details["actual"], 10, 64)
+	violation := &PolicyViolationError{Rule: PolicyPurchaseCap, SKU: uint32(sku), Limit: limit, Actual: actual}
+	for _, c := range s.policy.CapsFor(uint32(sku)) {
+		if int64(c.Limit) == limit {
+			violation.Period = c.Period
+			break
+		}
+	}
+	return violation
+}
+
+// Check the policy for the cart content after the count of the item changes,
+// the new count is calculated from the current one
+func (s *Service) checkItemChange(ctx context.Context, owner model.CartOwner, cart model.UserCartID, sku uint32, count func(current uint16) uint64) error {
+	if s.policy.IsZero() {
+		return nil
+	}
+
+	items, err := s.cart.ListCart(ctx, cart)
+	if err != nil {
+		return errors.Wrap(err, "can not get cart info")
+	}
+
+	newCount := count(itemCount(items, sku))
+	err = s.checkCountFits(sku, newCount)
+	if err != nil {
+		return err
+	}
+
+	return s.checkCartPolicy(ctx, owner, withItemCount(items, sku, uint16(newCount)), nil)
+}
+
+// Check that the count fits a line of the cart, the configured limit is reported if there is one
+func (s *Service) checkCountFits(sku uint32, count uint64) error {
+	if count <= math.MaxUint16 {
+		return nil
+	}
+
+	limit := int64(math.MaxUint16)
+	if s.policy.MaxSKUQuantity > 0 {
+		limit = int64(s.policy.MaxSKUQuantity)
+	}
+	return &PolicyViolationError{Rule: PolicyMaxSKUQuantity, SKU: sku, Limit: limit, Actual: int64(count)}
+}
+
+// Get the cart content after the count of the item is set, zero count removes the item
+func withItemCount(items []model.CartItem, sku uint32, count uint16) []model.CartItem {
+	result := make([]model.CartItem, 0, len(items)+1)
+	found := false
+	for _, item := range items {
+		if item.SKU == sku {
+			found = true
+			item.Count = count
+		}
+		if item.Count > 0 {
+			result = append(result, item)
+		}
+	}
+	if !found && count > 0 {
+		result = append(result, model.CartItem{SKU: sku, Count: count})
+	}
+	return result
+}
+
+// Get the count of the item in the cart
+func itemCount(items []model.CartItem, sku uint32) uint16 {
+	for _, item := range items {
+		if item.SKU == sku {
+			return item.Count
+		}
+	}
+	return 0
+}
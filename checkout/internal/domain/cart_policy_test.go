@@ -0,0 +1,270 @@
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/domain/mocks"
+	"route256/checkout/internal/model"
+	"route256/libs/apperr"
+	"route256/libs/money"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_CheckCartPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("zero policy allows anything", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.checkCartPolicy(context.Background(), model.CartOwner{UserID: 1}, []model.CartItem{{SKU: 1, Count: 1000}}, nil)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("error max sku quantity", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+			WithCartPolicy(model.CartPolicy{MaxSKUQuantity: 5}))
+
+		// Act
+		err := service.checkCartPolicy(context.Background(), model.CartOwner{UserID: 1}, []model.CartItem{{SKU: 1, Count: 5}, {SKU: 2, Count: 6}}, nil)
+
+		// Assert
+		require.ErrorIs(t, err, ErrCartPolicyViolation)
+		var violation *PolicyViolationError
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, PolicyMaxSKUQuantity, violation.Rule)
+		require.Equal(t, uint32(2), violation.SKU)
+		require.True(t, violation.IsRequestInvalid())
+	})
+
+	t.Run("error max lines", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+			WithCartPolicy(model.CartPolicy{MaxLines: 2}))
+
+		// Act
+		err := service.checkCartPolicy(context.Background(), model.CartOwner{UserID: 1}, []model.CartItem{{SKU: 1, Count: 1}, {SKU: 2, Count: 1}, {SKU: 3, Count: 1}}, nil)
+
+		// Assert
+		var violation *PolicyViolationError
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, PolicyMaxLines, violation.Rule)
+		require.Equal(t, int64(3), violation.Actual)
+		require.False(t, violation.IsRequestInvalid())
+	})
+
+	t.Run("error max cart value", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+			WithCartPolicy(model.CartPolicy{MaxCartValue: money.New(1000, "RUB")}))
+		goods := []model.Good{
+			{SKU: 1, Count: 2, Price: money.New(300, "RUB")},
+			{SKU: 2, Count: 1, Price: money.New(500, "RUB")},
+		}
+
+		// Act
+		err := service.checkCartPolicy(context.Background(), model.CartOwner{UserID: 1}, []model.CartItem{{SKU: 1, Count: 2}, {SKU: 2, Count: 1}}, goods)
+
+		// Assert
+		var violation *PolicyViolationError
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, PolicyMaxCartValue, violation.Rule)
+		require.Equal(t, int64(1100), violation.Actual)
+	})
+
+	t.Run("error purchase cap", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		userID := model.UserID(1)
+
+		loms.On("GetUserPurchases", mock.Anything, userID, mock.AnythingOfType("time.Time"), []uint32{1, 2}).
+			Return(map[uint32]uint64{1: 2, 2: 8}, nil).Once()
+
+		service := New(loms, mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+			WithCartPolicy(model.CartPolicy{PurchaseCaps: []model.PurchaseCap{{Limit: 10, Period: 24 * time.Hour}}}))
+
+		// Act
+		err := service.checkCartPolicy(context.Background(), model.CartOwner{UserID: userID}, []model.CartItem{{SKU: 1, Count: 3}, {SKU: 2, Count: 3}}, nil)
+
+		// Assert
+		var violation *PolicyViolationError
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, PolicyPurchaseCap, violation.Rule)
+		require.Equal(t, uint32(2), violation.SKU)
+		require.Equal(t, int64(11), violation.Actual)
+		require.Equal(t, 24*time.Hour, violation.Period)
+	})
+
+	t.Run("guest skips purchase caps", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+			WithCartPolicy(model.CartPolicy{PurchaseCaps: []model.PurchaseCap{{Limit: 1, Period: time.Hour}}}))
+
+		// Act
+		err := service.checkCartPolicy(context.Background(), model.CartOwner{Token: "guest"}, []model.CartItem{{SKU: 1, Count: 5}}, nil)
+
+		// Assert
+		require.NoError(t, err)
+	})
+}
+
+func Test_SetCartItemCountPolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error max sku quantity", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+
+		loms.On("GetStocksBySKU", mock.Anything, uint32(10)).Return([]model.Stock{
+			{WarehouseID: 1, Count: 100},
+		}, nil).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 10, Count: 1}}, nil).Once()
+
+		service := New(loms, mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+			WithCartPolicy(model.CartPolicy{MaxSKUQuantity: 10}))
+
+		// Act
+		err := service.SetCartItemCount(context.Background(), model.CartOwner{UserID: userID}, 10, 11)
+
+		// Assert
+		require.ErrorIs(t, err, ErrCartPolicyViolation)
+	})
+}
+
+func Test_PurchasePolicy(t *testing.T) {
+	t.Parallel()
+
+	t.Run("error purchase cap reached by a concurrent order", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+		addressRepository := mocks.NewAddressRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+		address := model.DeliveryAddress{ID: 3, UserID: userID, City: "Moscow", Street: "Tverskaya", House: "1"}
+		items := []model.CartItem{{SKU: 1, Count: 3}}
+		goods := []model.Good{{SKU: 1, Count: 3, Name: "item", Price: money.New(100, "RUB")}}
+		capReached := model.ErrPurchaseCapReached.WithDetails(map[string]string{"sku": "1", "limit": "5", "actual": "6"})
+
+		addressRepository.On("GetAddress", mock.Anything, userID, address.ID).Return(address, nil).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, userCartID).Return(items, nil).Once()
+		cartRepository.On("GetCartPromoCode", mock.Anything, userCartID).Return(model.PromoCode(""), nil).Once()
+		product.On("GetProducts", mock.Anything, items).Return(goods, nil).Once()
+		// Another order of the user is placed after the caps are checked in checkout
+		loms.On("GetUserPurchases", mock.Anything, userID, mock.AnythingOfType("time.Time"), []uint32{1}).Return(map[uint32]uint64{1: 2}, nil).Once()
+		loms.On("CreateOrder", mock.Anything, userID, goods, model.OrderPromotion{}, mock.Anything,
+			mock.MatchedBy(func(caps []model.OrderCap) bool {
+				return len(caps) == 1 && caps[0].SKU == 1 && caps[0].Limit == 5
+			})).
+			Return(model.OrderID(0), capReached).Once()
+
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), addressRepository,
+			WithCartPolicy(model.CartPolicy{PurchaseCaps: []model.PurchaseCap{{Limit: 5, Period: 24 * time.Hour}}}))
+
+		// Act
+		_, err := service.Purchase(context.Background(), userID, address.ID, model.ShippingCourier)
+
+		// Assert
+		var violation *PolicyViolationError
+		require.ErrorAs(t, err, &violation)
+		require.Equal(t, PolicyPurchaseCap, violation.Rule)
+		require.Equal(t, uint32(1), violation.SKU)
+		require.Equal(t, int64(5), violation.Limit)
+		require.Equal(t, int64(6), violation.Actual)
+		require.Equal(t, 24*time.Hour, violation.Period)
+	})
+}
+
+func Test_SetCartItemCountPolicy_Overflow(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	cartRepository := mocks.NewCartRepository(t)
+	userID := model.UserID(1)
+	userCartID := model.UserCartID(1)
+
+	cartRepository.On("ListCart", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 10, Count: 65000}}, nil).Once()
+
+	service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t),
+		WithCartPolicy(model.CartPolicy{MaxSKUQuantity: 100}))
+
+	// Act
+	err := service.checkItemChange(context.Background(), model.CartOwner{UserID: userID}, userCartID, 10, func(current uint16) uint64 {
+		return uint64(current) + 1000
+	})
+
+	// Assert
+	var violation *PolicyViolationError
+	require.ErrorAs(t, err, &violation)
+	require.Equal(t, int64(100), violation.Limit)
+	require.Equal(t, int64(66000), violation.Actual)
+}
+
+func Test_PolicyViolationError_Kind(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name      string
+		violation *PolicyViolationError
+		kind      apperr.Kind
+		details   map[string]string
+	}{
+		{
+			name:      "too many units of an item",
+			violation: &PolicyViolationError{Rule: PolicyMaxSKUQuantity, SKU: 10, Limit: 5, Actual: 6},
+			kind:      apperr.InvalidArgument,
+			details:   map[string]string{"rule": "max_sku_quantity", "sku": "10", "limit": "5", "actual": "6"},
+		},
+		{
+			name:      "too many lines",
+			violation: &PolicyViolationError{Rule: PolicyMaxLines, Limit: 2, Actual: 3},
+			kind:      apperr.PolicyViolation,
+			details:   map[string]string{"rule": "max_lines", "limit": "2", "actual": "3"},
+		},
+		{
+			name:      "purchase cap",
+			violation: &PolicyViolationError{Rule: PolicyPurchaseCap, SKU: 10, Limit: 2, Actual: 3, Period: 24 * time.Hour},
+			kind:      apperr.PolicyViolation,
+			details:   map[string]string{"rule": "purchase_cap", "sku": "10", "limit": "2", "actual": "3", "period": "24h0m0s"},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			err := errors.Wrap(tc.violation, "add to cart")
+
+			// Assert
+			require.ErrorIs(t, err, ErrCartPolicyViolation)
+			e, ok := apperr.As(err)
+			require.True(t, ok)
+			require.Equal(t, tc.kind, e.Kind())
+			require.Equal(t, tc.details, e.Details())
+		})
+	}
+}
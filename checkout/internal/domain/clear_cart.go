@@ -0,0 +1,24 @@
+// Clearing a user's cart
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Remove all items from the user's cart
+func (s *Service) ClearCart(ctx context.Context, owner model.CartOwner) error {
+	cart, err := s.getCart(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	err = s.cart.ClearCart(ctx, cart)
+	if err != nil {
+		return errors.Wrap(err, "can not clear cart")
+	}
+
+	return nil
+}
@@ -0,0 +1,19 @@
+// Saving a delivery address
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Save a new delivery address of the user
+func (s *Service) CreateAddress(ctx context.Context, address model.DeliveryAddress) (model.AddressID, error) {
+	id, err := s.address.CreateAddress(ctx, address)
+	if err != nil {
+		return 0, errors.Wrap(err, "can not save address")
+	}
+
+	return id, nil
+}
@@ -0,0 +1,38 @@
+// Creating a cart for an anonymous shopper
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+const cartTokenSize = 24
+
+// Create an empty guest cart and return its opaque token
+func (s *Service) CreateGuestCart(ctx context.Context) (model.CartToken, error) {
+	token, err := newCartToken()
+	if err != nil {
+		return "", errors.Wrap(err, "generate cart token")
+	}
+
+	_, err = s.cart.CreateGuestCart(ctx, token)
+	if err != nil {
+		return "", errors.Wrap(err, "create guest cart")
+	}
+
+	return token, nil
+}
+
+// Generate a random URL-safe token
+func newCartToken() (model.CartToken, error) {
+	buf := make([]byte, cartTokenSize)
+	if _, err := rand.Read(buf); err != nil {
+		return "", err
+	}
+
+	return model.CartToken(base64.RawURLEncoding.EncodeToString(buf)), nil
+}
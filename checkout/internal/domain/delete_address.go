@@ -0,0 +1,19 @@
+// Deleting a delivery address
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Delete the saved delivery address of the user
+func (s *Service) DeleteAddress(ctx context.Context, user model.UserID, id model.AddressID) error {
+	err := s.address.DeleteAddress(ctx, user, id)
+	if err != nil {
+		return errors.Wrap(err, "can not delete address")
+	}
+
+	return nil
+}
@@ -9,10 +9,10 @@ import (
 )
 
 // Delete items from the user's cart
-func (s *Service) DeleteFromCart(ctx context.Context, user model.UserID, sku model.SKU, count uint16) error {
-	cartID, err := s.cart.GetCartByUserID(ctx, user)
+func (s *Service) DeleteFromCart(ctx context.Context, owner model.CartOwner, sku model.SKU, count uint16) error {
+	cartID, err := s.getCart(ctx, owner)
 	if err != nil {
-		return errors.Wrap(err, "can not get user cart")
+		return err
 	}
 
 	err = s.cart.DeleteFromCart(ctx, cartID, sku, count)
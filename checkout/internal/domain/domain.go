@@ -1,17 +1,24 @@
 //go:generate mockery --output ./mocks --filename loms_checker_mock.go --name LomsChecker
 //go:generate mockery --output ./mocks --filename product_checker_mock.go --name ProductChecker
 //go:generate mockery --output ./mocks --filename cart_repository_mock.go --name CartRepository
+//go:generate mockery --output ./mocks --filename abandoned_cart_notifier_mock.go --name AbandonedCartNotifier
+//go:generate mockery --output ./mocks --filename promotion_repository_mock.go --name PromotionRepository
+//go:generate mockery --output ./mocks --filename address_repository_mock.go --name AddressRepository
+//go:generate mockery --output ./mocks --filename cart_event_outbox_mock.go --name CartEventOutbox
+//go:generate mockery --output ./mocks --filename cart_event_publisher_mock.go --name CartEventPublisher
 package domain
 
 import (
 	"context"
 	"route256/checkout/internal/model"
+	"time"
 )
 
 // Describe methods to check the availability of goods in stock
 type LomsChecker interface {
 	GetStocksBySKU(ctx context.Context, sku uint32) ([]model.Stock, error)
-	CreateOrder(ctx context.Context, user model.UserID, userGoods []model.CartItem) (model.OrderID, error)
+	GetUserPurchases(ctx context.Context, user model.UserID, since time.Time, skus []uint32) (map[uint32]uint64, error)
+	CreateOrder(ctx context.Context, user model.UserID, goods []model.Good, promotion model.OrderPromotion, delivery model.Delivery, caps []model.OrderCap) (model.OrderID, error)
 }
 
 // Describes the method of retrieving product information
@@ -22,9 +29,59 @@ type ProductChecker interface {
 type CartRepository interface {
 	CreateCart(ctx context.Context, user model.UserID) (model.UserCartID, error)
 	GetCartByUserID(ctx context.Context, userID model.UserID) (model.UserCartID, error)
+	CreateGuestCart(ctx context.Context, token model.CartToken) (model.UserCartID, error)
+	GetCartByToken(ctx context.Context, token model.CartToken) (model.UserCartID, error)
+	// The merge function gets the locked counts of the target cart and returns the lines to set in it
+	MergeCart(ctx context.Context, from model.UserCartID, to model.UserCartID, merge func(current map[uint32]uint16) []model.CartItem) error
 	UpdateOrAddToCart(ctx context.Context, cart model.UserCartID, sku model.SKU, count uint16) error
 	DeleteFromCart(ctx context.Context, user model.UserCartID, sku model.SKU, count uint16) error
+	SetCartItemCount(ctx context.Context, cart model.UserCartID, sku model.SKU, count uint16) error
+	RemoveCartItem(ctx context.Context, cart model.UserCartID, sku model.SKU) error
+	ClearCart(ctx context.Context, cart model.UserCartID) error
 	ListCart(ctx context.Context, cart model.UserCartID) ([]model.CartItem, error)
+	ListAbandonedCarts(ctx context.Context, idleSince time.Time, limit uint64) ([]model.AbandonedCart, error)
+	MarkCartReminded(ctx context.Context, cart model.UserCartID, updatedAt time.Time) error
+	PurgeCarts(ctx context.Context, before time.Time) (int64, error)
+	GetCartPromoCode(ctx context.Context, cart model.UserCartID) (model.PromoCode, error)
+	SetCartPromoCode(ctx context.Context, cart model.UserCartID, code model.PromoCode) error
+	SaveForLater(ctx context.Context, cart model.UserCartID, sku model.SKU) error
+	MoveToCart(ctx context.Context, cart model.UserCartID, sku model.SKU) error
+	ListSaved(ctx context.Context, cart model.UserCartID) ([]model.CartItem, error)
+	// Record the placed order, the order id makes repeated calls safe
+	CompletePurchase(ctx context.Context, order model.PlacedOrder) error
+}
+
+// Describe store of promotion definitions and their usage
+type PromotionRepository interface {
+	GetPromotionByCode(ctx context.Context, code model.PromoCode) (model.Promotion, error)
+	CountPromotionUsage(ctx context.Context, promotionID int64, user model.UserID) (uint32, error)
+	ReservePromotionUsage(ctx context.Context, promotionID int64, user model.UserID, limit uint32) (int64, error)
+	ReleasePromotionUsage(ctx context.Context, usageID int64) error
+}
+
+// Describe store of saved delivery addresses, every method is limited to addresses of the user
+type AddressRepository interface {
+	CreateAddress(ctx context.Context, address model.DeliveryAddress) (model.AddressID, error)
+	ListAddresses(ctx context.Context, user model.UserID) ([]model.DeliveryAddress, error)
+	GetAddress(ctx context.Context, user model.UserID, id model.AddressID) (model.DeliveryAddress, error)
+	UpdateAddress(ctx context.Context, address model.DeliveryAddress) error
+	DeleteAddress(ctx context.Context, user model.UserID, id model.AddressID) error
+}
+
+// Describe a publisher of reminders about abandoned carts
+type AbandonedCartNotifier interface {
+	SendAbandonedCart(cart model.AbandonedCart) error
+}
+
+// Describe store of cart events waiting to be published
+type CartEventOutbox interface {
+	ListCartEvents(ctx context.Context, limit uint64) ([]model.CartEvent, error)
+	DeleteCartEvents(ctx context.Context, ids []int64) error
+}
+
+// Describe a publisher of cart events for analytics
+type CartEventPublisher interface {
+	SendCartEvents(events []model.CartEvent) error
 }
 
 // Provide access to the business logic of the service
@@ -32,13 +89,60 @@ type Service struct {
 	lomsChecker    LomsChecker
 	productChecker ProductChecker
 	cart           CartRepository
+	promotion      PromotionRepository
+	address        AddressRepository
+	mergePolicy    model.MergePolicy
+	abandoned      AbandonedCartNotifier
+	policy         model.CartPolicy
+	outbox         CartEventOutbox
+	events         CartEventPublisher
+}
+
+// Describe an optional service setting
+type Option func(*Service)
+
+// Set the policy used when a merge request does not specify one
+func WithMergePolicy(policy model.MergePolicy) Option {
+	return func(s *Service) {
+		s.mergePolicy = policy
+	}
+}
+
+// Set the publisher of reminders about abandoned carts
+func WithAbandonedCartNotifier(notifier AbandonedCartNotifier) Option {
+	return func(s *Service) {
+		s.abandoned = notifier
+	}
+}
+
+// Set limits on cart content
+func WithCartPolicy(policy model.CartPolicy) Option {
+	return func(s *Service) {
+		s.policy = policy
+	}
+}
+
+// Publish cart events from the outbox with the publisher
+func WithCartEvents(outbox CartEventOutbox, publisher CartEventPublisher) Option {
+	return func(s *Service) {
+		s.outbox = outbox
+		s.events = publisher
+	}
 }
 
 // Create a new Service instance
-func New(lomsChecker LomsChecker, productChecker ProductChecker, cart CartRepository) *Service {
-	return &Service{
+func New(lomsChecker LomsChecker, productChecker ProductChecker, cart CartRepository, promotion PromotionRepository, address AddressRepository, opts ...Option) *Service {
+	s := &Service{
 		lomsChecker:    lomsChecker,
 		productChecker: productChecker,
 		cart:           cart,
+		promotion:      promotion,
+		address:        address,
+		mergePolicy:    model.MergePolicySum,
+	}
+	for _, opt := range opts {
+		opt(s)
 	}
+
+	return s
 }
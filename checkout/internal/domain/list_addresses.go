@@ -0,0 +1,19 @@
+// Obtaining the delivery addresses of a user
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Get saved delivery addresses of the user
+func (s *Service) ListAddresses(ctx context.Context, user model.UserID) ([]model.DeliveryAddress, error) {
+	addresses, err := s.address.ListAddresses(ctx, user)
+	if err != nil {
+		return nil, errors.Wrap(err, "can not get addresses")
+	}
+
+	return addresses, nil
+}
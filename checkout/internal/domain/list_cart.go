@@ -11,11 +11,14 @@ var (
 	ErrGetProductsInfo = errors.New("can not get all products")
 )
 
-// Get a list of items in the user's cart
-func (s *Service) ListCart(ctx context.Context, user model.UserID) (model.UserCartWithTotal, error) {
-	cart, err := s.cart.GetCartByUserID(ctx, user)
+// Get a list of items in the user's cart with discounts of the attached promo code
+func (s *Service) ListCart(ctx context.Context, owner model.CartOwner) (model.UserCartWithTotal, error) {
+	cart, err := s.getCart(ctx, owner)
 	if err != nil {
-		cart, err = s.cart.CreateCart(ctx, user)
+		if owner.IsGuest() || !errors.Is(err, model.ErrCartNotFound) {
+			return model.UserCartWithTotal{}, err
+		}
+		cart, err = s.cart.CreateCart(ctx, owner.UserID)
 		if err != nil {
 			return model.UserCartWithTotal{}, errors.Wrap(err, "error creating cart")
 		}
@@ -27,19 +30,53 @@ func (s *Service) ListCart(ctx context.Context, user model.UserID) (model.UserCa
 		return model.UserCartWithTotal{}, errors.Wrap(err, "can not get cart info")
 	}
 
-	result, err := s.productChecker.GetProducts(ctx, userCart)
+	goods, err := s.getGoods(ctx, userCart)
+	if err != nil {
+		return model.UserCartWithTotal{}, err
+	}
+
+	result := model.UserCartWithTotal{Items: goods}
+
+	code, err := s.cart.GetCartPromoCode(ctx, cart)
 	if err != nil {
-		return model.UserCartWithTotal{}, errors.Wrap(err, "can not get products info")
+		return model.UserCartWithTotal{}, errors.Wrap(err, "can not get cart promo code")
 	}
 
-	if len(userCart) != len(result) {
-		return model.UserCartWithTotal{}, ErrGetProductsInfo
+	if code != "" {
+		result.PromoCode = code
+
+		// A code that does not fit the cart anymore stays attached but gives no discount
+		promotion, err := s.getValidPromotion(ctx, owner, code, goods)
+		if err != nil && !IsPromoCodeRejected(err) {
+			return model.UserCartWithTotal{}, err
+		}
+		if err == nil {
+			result.PromoApplied = true
+			result.CartDiscount, err = applyPromotion(promotion, goods)
+			if err != nil {
+				return model.UserCartWithTotal{}, errors.Wrap(err, "can not calculate discounts")
+			}
+		}
+	}
+
+	err = fillTotals(&result)
+	if err != nil {
+		return model.UserCartWithTotal{}, errors.Wrap(err, "can not calculate cart total")
+	}
+	return result, nil
+}
+
+// Get names and prices of cart items
+func (s *Service) getGoods(ctx context.Context, items []model.CartItem) ([]model.Good, error) {
+	goods, err := s.productChecker.GetProducts(ctx, items)
+	if err != nil {
+		return nil, errors.Wrap(err, "can not get products info")
 	}
 
-	var totalPrice uint32 = 0
-	for _, v := range result {
-		totalPrice += uint32(v.Count) * v.Price
+	if len(items) != len(goods) {
+		return nil, ErrGetProductsInfo
 	}
 
-	return model.UserCartWithTotal{Items: result, TotalPrice: totalPrice}, nil
+	resetDiscounts(goods)
+	return goods, nil
 }
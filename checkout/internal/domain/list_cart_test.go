@@ -3,8 +3,10 @@ package domain
 import (
 	"context"
 	"errors"
+	"math"
 	"route256/checkout/internal/domain/mocks"
 	"route256/checkout/internal/model"
+	"route256/libs/money"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v6"
@@ -37,32 +39,35 @@ func Test_ListCart(t *testing.T) {
 
 		// fill product service data
 		goods := make([]model.Good, userItemsCount)
-		totalPrice := uint32(0)
+		totalPrice := money.Zero("RUB")
 		for i := 0; i < userItemsCount; i++ {
 			require.NoError(t, gofakeit.Struct(&goods[i]))
 			goods[i].SKU = items[i].SKU
 			goods[i].Count = items[i].Count
+			goods[i].Price = money.New(int64(gofakeit.Uint32()), "RUB")
+			goods[i].Discount = money.Zero("RUB")
 
-			totalPrice += uint32(goods[i].Count) * goods[i].Price
+			totalPrice.Amount += int64(goods[i].Count) * goods[i].Price.Amount
 		}
 		product.On("GetProducts", mock.Anything, items).Return(goods, nil).Once()
+		cartRepository.On("GetCartPromoCode", mock.Anything, userCartID).Return(model.PromoCode(""), nil).Once()
 
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
 
 		// Act
-		userCart, err := service.ListCart(context.Background(), userID)
+		userCart, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
 
 		// Assert
 		require.NoError(t, err)
 		require.Equal(t, goods, userCart.Items, "cart items")
-		require.Equal(t, userCart.TotalPrice, totalPrice, "cart total price")
+		require.Equal(t, totalPrice, userCart.TotalPrice, "cart total price")
+		require.Equal(t, money.Zero("RUB"), userCart.TotalDiscount, "cart total discount")
 		require.ElementsMatch(t, goods, userCart.Items)
 	})
 
 	t.Run("success if the user's shopping cart did not exist", func(t *testing.T) {
 		t.Parallel()
 		// Arrange
-		errStub := errors.New("stub")
 		loms := mocks.NewLomsChecker(t)
 		product := mocks.NewProductChecker(t)
 		cartRepository := mocks.NewCartRepository(t)
@@ -71,20 +76,37 @@ func Test_ListCart(t *testing.T) {
 		userCartID := model.UserCartID(1)
 
 		// fill repository mock data
-		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(model.UserCartID(0), errStub).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(model.UserCartID(0), model.ErrCartNotFound).Once()
 		cartRepository.On("CreateCart", mock.Anything, userID).Return(userCartID, nil).Once()
 
 		// fill product service data
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
 
 		// Act
-		userCart, err := service.ListCart(context.Background(), userID)
+		userCart, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
 
 		// Assert
 		require.NoError(t, err)
 		require.Equal(t, model.UserCartWithTotal{}, userCart, "empty user cart")
 	})
 
+	t.Run("error while getting cart from storage", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		errStub := errors.New("stub")
+		userID := model.UserID(1)
+
+		cartRepository := mocks.NewCartRepository(t)
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(model.UserCartID(0), errStub).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+		// Act
+		_, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
+
+		// Assert
+		require.ErrorIs(t, err, errStub)
+	})
+
 	t.Run("error while creating cart from storage", func(t *testing.T) {
 		t.Parallel()
 		// Arrange
@@ -94,12 +116,12 @@ func Test_ListCart(t *testing.T) {
 		loms := mocks.NewLomsChecker(t)
 		product := mocks.NewProductChecker(t)
 		cartRepository := mocks.NewCartRepository(t)
-		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(model.UserCartID(0), errStub).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(model.UserCartID(0), model.ErrCartNotFound).Once()
 		cartRepository.On("CreateCart", mock.Anything, userID).Return(model.UserCartID(0), errStub).Once()
 
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
 		// Act
-		_, err := service.ListCart(context.Background(), userID)
+		_, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
 
 		// Assert
 		require.ErrorIs(t, err, errStub)
@@ -118,9 +140,9 @@ func Test_ListCart(t *testing.T) {
 		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(cartID, nil).Once()
 		cartRepository.On("ListCart", mock.Anything, cartID).Return(nil, errStub).Once()
 
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
 		// Act
-		_, err := service.ListCart(context.Background(), userID)
+		_, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
 
 		// Assert
 		require.ErrorIs(t, err, errStub)
@@ -148,10 +170,10 @@ func Test_ListCart(t *testing.T) {
 		cartRepository.On("ListCart", mock.Anything, cartID).Return(items, nil).Once()
 		product.On("GetProducts", mock.Anything, items).Return(nil, errStub).Once()
 
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
 
 		// Act
-		_, err := service.ListCart(context.Background(), userID)
+		_, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
 
 		// Assert
 		require.ErrorIs(t, err, errStub)
@@ -179,22 +201,73 @@ func Test_ListCart(t *testing.T) {
 
 		// fill product service data
 		goods := make([]model.Good, userItemsCount-1)
-		totalPrice := uint32(0)
 		for i := 0; i < userItemsCount-1; i++ {
 			require.NoError(t, gofakeit.Struct(&goods[i]))
 			goods[i].SKU = items[i].SKU
 			goods[i].Count = items[i].Count
-
-			totalPrice += uint32(goods[i].Count) * goods[i].Price
 		}
 		product.On("GetProducts", mock.Anything, items).Return(goods, nil).Once()
 
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
 
 		// Act
-		_, err := service.ListCart(context.Background(), userID)
+		_, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
 
 		// Assert
 		require.ErrorIs(t, err, ErrGetProductsInfo)
 	})
+
+	t.Run("error cart total overflow", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		userID := model.UserID(1)
+		cartID := model.UserCartID(1)
+		items := []model.CartItem{{SKU: 1, Count: 2}, {SKU: 2, Count: 2}}
+
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(cartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, cartID).Return(items, nil).Once()
+		product.On("GetProducts", mock.Anything, items).Return([]model.Good{
+			{SKU: 1, Count: 2, Name: "item", Price: money.New(math.MaxInt64/4+1, "RUB")},
+			{SKU: 2, Count: 2, Name: "item", Price: money.New(math.MaxInt64/4+1, "RUB")},
+		}, nil).Once()
+		cartRepository.On("GetCartPromoCode", mock.Anything, cartID).Return(model.PromoCode(""), nil).Once()
+
+		service := New(mocks.NewLomsChecker(t), product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		_, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
+
+		// Assert
+		require.ErrorIs(t, err, money.ErrOverflow)
+	})
+
+	t.Run("error items in different currencies", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		userID := model.UserID(1)
+		cartID := model.UserCartID(1)
+		items := []model.CartItem{{SKU: 1, Count: 1}, {SKU: 2, Count: 1}}
+
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(cartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, cartID).Return(items, nil).Once()
+		product.On("GetProducts", mock.Anything, items).Return([]model.Good{
+			{SKU: 1, Count: 1, Name: "item", Price: money.New(100, "RUB")},
+			{SKU: 2, Count: 1, Name: "item", Price: money.New(100, "USD")},
+		}, nil).Once()
+		cartRepository.On("GetCartPromoCode", mock.Anything, cartID).Return(model.PromoCode(""), nil).Once()
+
+		service := New(mocks.NewLomsChecker(t), product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		_, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
+
+		// Assert
+		require.ErrorIs(t, err, money.ErrCurrencyMismatch)
+	})
 }
@@ -0,0 +1,58 @@
+// Obtaining the list of items saved for later
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+	"route256/checkout/internal/pkg/workerpool"
+
+	"github.com/pkg/errors"
+)
+
+// Number of stock requests to LOMS sent at once
+const stocksWorkerCount = 10
+
+// Get items saved for later with names, prices and current availability
+func (s *Service) ListSaved(ctx context.Context, owner model.CartOwner) ([]model.SavedItem, error) {
+	cart, err := s.getCart(ctx, owner)
+	if err != nil {
+		if !owner.IsGuest() && errors.Is(err, model.ErrCartNotFound) {
+			// A user without a cart has nothing saved yet
+			return []model.SavedItem{}, nil
+		}
+		return nil, err
+	}
+
+	saved, err := s.cart.ListSaved(ctx, cart)
+	if err != nil {
+		return nil, errors.Wrap(err, "can not get saved items")
+	}
+	if len(saved) == 0 {
+		return []model.SavedItem{}, nil
+	}
+
+	goods, err := s.getGoods(ctx, saved)
+	if err != nil {
+		return nil, err
+	}
+
+	// Stocks of every item are asked for concurrently, a few requests at a time
+	stocks := workerpool.Map(ctx, stocksWorkerCount, goods, func(ctx context.Context, good model.Good) (uint64, error) {
+		return s.availableCount(ctx, good.SKU)
+	})
+
+	result := make([]model.SavedItem, 0, len(goods))
+	for i, good := range goods {
+		if stocks[i].Err != nil {
+			return nil, stocks[i].Err
+		}
+		available := *stocks[i].Value
+		result = append(result, model.SavedItem{
+			Good:      good,
+			InStock:   available,
+			Available: available >= uint64(good.Count),
+		})
+	}
+
+	return result, nil
+}
@@ -0,0 +1,104 @@
+// Merging a guest cart into a user's cart
+package domain
+
+import (
+	"context"
+	"math"
+	"route256/checkout/internal/model"
+	"route256/libs/apperr"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrUnknownMergePolicy = apperr.New(apperr.InvalidArgument, "unknown merge policy")
+)
+
+// Move items from the guest cart to the user's cart and delete the guest cart.
+// Counts of items present in both carts are resolved by the policy,
+// the default policy is used when it is empty.
+// Merged counts are reduced to the quantity available in warehouses
+func (s *Service) MergeCarts(ctx context.Context, user model.UserID, token model.CartToken, policy model.MergePolicy) error {
+	if policy == "" {
+		policy = s.mergePolicy
+	}
+	if !policy.Valid() {
+		return ErrUnknownMergePolicy
+	}
+
+	guestCart, err := s.getCart(ctx, model.CartOwner{Token: token})
+	if err != nil {
+		return err
+	}
+
+	guestItems, err := s.cart.ListCart(ctx, guestCart)
+	if err != nil {
+		return errors.Wrap(err, "can not get guest cart items")
+	}
+
+	userCart, err := s.getOrCreateCart(ctx, model.CartOwner{UserID: user})
+	if err != nil {
+		return err
+	}
+
+	// Stocks are asked before the carts are locked, the merged counts are reduced to them
+	limits := make(map[uint32]uint16, len(guestItems))
+	for _, item := range guestItems {
+		available, err := s.availableCount(ctx, item.SKU)
+		if err != nil {
+			return err
+		}
+		limit := uint16(math.MaxUint16)
+		if available < uint64(limit) {
+			limit = uint16(available)
+		}
+		// Merged counts are capped by the cart policy instead of failing the login
+		if s.policy.MaxSKUQuantity > 0 && limit > s.policy.MaxSKUQuantity {
+			limit = s.policy.MaxSKUQuantity
+		}
+		limits[item.SKU] = limit
+	}
+
+	// Counts of the user's cart are read under its lock, so items added meanwhile are not lost
+	merge := func(current map[uint32]uint16) []model.CartItem {
+		merged := make([]model.CartItem, 0, len(guestItems))
+		for _, item := range guestItems {
+			count := mergeCount(policy, current[item.SKU], item.Count)
+			if count > limits[item.SKU] {
+				count = limits[item.SKU]
+			}
+			// Items that are out of stock are not moved, the user's line stays as it is
+			if count == 0 {
+				continue
+			}
+			merged = append(merged, model.CartItem{SKU: item.SKU, Count: count})
+		}
+		return merged
+	}
+
+	err = s.cart.MergeCart(ctx, guestCart, userCart, merge)
+	if err != nil {
+		return errors.Wrap(err, "can not merge carts")
+	}
+
+	return nil
+}
+
+// Resolve the count of an item present in both carts
+func mergeCount(policy model.MergePolicy, userCount uint16, guestCount uint16) uint16 {
+	switch policy {
+	case model.MergePolicyMax:
+		if userCount > guestCount {
+			return userCount
+		}
+		return guestCount
+	case model.MergePolicyPreferGuest:
+		return guestCount
+	default:
+		sum := uint32(userCount) + uint32(guestCount)
+		if sum > math.MaxUint16 {
+			return math.MaxUint16
+		}
+		return uint16(sum)
+	}
+}
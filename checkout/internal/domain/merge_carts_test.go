@@ -0,0 +1,210 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"route256/checkout/internal/domain/mocks"
+	"route256/checkout/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MergeCarts(t *testing.T) {
+	t.Parallel()
+
+	userID := model.UserID(1)
+	token := model.CartToken("guest-token")
+	guestCartID := model.UserCartID(10)
+	userCartID := model.UserCartID(20)
+
+	// Counts of the user's cart at the moment it is locked by the merge
+	userCounts := map[uint32]uint16{1: 2, 2: 5}
+	guestItems := []model.CartItem{
+		{SKU: 1, Count: 3},
+		{SKU: 2, Count: 1},
+		{SKU: 3, Count: 4},
+	}
+
+	cases := []struct {
+		name     string
+		policy   model.MergePolicy
+		defaults []Option
+		expected []model.CartItem
+	}{
+		{
+			name:   "sum",
+			policy: model.MergePolicySum,
+			expected: []model.CartItem{
+				{SKU: 1, Count: 5},
+				{SKU: 2, Count: 6},
+				{SKU: 3, Count: 4},
+			},
+		},
+		{
+			name:   "max",
+			policy: model.MergePolicyMax,
+			expected: []model.CartItem{
+				{SKU: 1, Count: 3},
+				{SKU: 2, Count: 5},
+				{SKU: 3, Count: 4},
+			},
+		},
+		{
+			name:   "prefer guest",
+			policy: model.MergePolicyPreferGuest,
+			expected: []model.CartItem{
+				{SKU: 1, Count: 3},
+				{SKU: 2, Count: 1},
+				{SKU: 3, Count: 4},
+			},
+		},
+		{
+			name:     "default policy from options",
+			defaults: []Option{WithMergePolicy(model.MergePolicyMax)},
+			expected: []model.CartItem{
+				{SKU: 1, Count: 3},
+				{SKU: 2, Count: 5},
+				{SKU: 3, Count: 4},
+			},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			loms := mocks.NewLomsChecker(t)
+			product := mocks.NewProductChecker(t)
+			cartRepository := mocks.NewCartRepository(t)
+
+			cartRepository.On("GetCartByToken", mock.Anything, token).Return(guestCartID, nil).Once()
+			cartRepository.On("ListCart", mock.Anything, guestCartID).Return(guestItems, nil).Once()
+			cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+			loms.On("GetStocksBySKU", mock.Anything, mock.Anything).Return([]model.Stock{{WarehouseID: 1, Count: 100}}, nil)
+			var merged []model.CartItem
+			cartRepository.On("MergeCart", mock.Anything, guestCartID, userCartID, mock.Anything).Return(mergeWith(userCounts, &merged)).Once()
+
+			service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t), tc.defaults...)
+
+			// Act
+			err := service.MergeCarts(context.Background(), userID, token, tc.policy)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, merged)
+		})
+	}
+
+	t.Run("counts are reduced to available stock", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		cartRepository.On("GetCartByToken", mock.Anything, token).Return(guestCartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, guestCartID).Return(guestItems, nil).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		loms.On("GetStocksBySKU", mock.Anything, uint32(1)).Return([]model.Stock{{WarehouseID: 1, Count: 4}}, nil).Once()
+		loms.On("GetStocksBySKU", mock.Anything, uint32(2)).Return([]model.Stock{{WarehouseID: 1, Count: 10}}, nil).Once()
+		loms.On("GetStocksBySKU", mock.Anything, uint32(3)).Return([]model.Stock{}, nil).Once()
+		var merged []model.CartItem
+		cartRepository.On("MergeCart", mock.Anything, guestCartID, userCartID, mock.Anything).Return(mergeWith(userCounts, &merged)).Once()
+
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MergeCarts(context.Background(), userID, token, model.MergePolicySum)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, []model.CartItem{
+			{SKU: 1, Count: 4},
+			{SKU: 2, Count: 6},
+		}, merged)
+	})
+
+	t.Run("item added to the user's cart before the lock is kept", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		cartRepository.On("GetCartByToken", mock.Anything, token).Return(guestCartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, guestCartID).Return([]model.CartItem{{SKU: 1, Count: 3}}, nil).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		loms.On("GetStocksBySKU", mock.Anything, uint32(1)).Return([]model.Stock{{WarehouseID: 1, Count: 100}}, nil).Once()
+		var merged []model.CartItem
+		cartRepository.On("MergeCart", mock.Anything, guestCartID, userCartID, mock.Anything).Return(mergeWith(map[uint32]uint16{1: 2, 4: 1}, &merged)).Once()
+
+		service := New(loms, mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MergeCarts(context.Background(), userID, token, model.MergePolicySum)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, []model.CartItem{{SKU: 1, Count: 5}}, merged)
+	})
+
+	t.Run("error user cart lookup", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		errStub := errors.New("stub")
+		cartRepository := mocks.NewCartRepository(t)
+
+		cartRepository.On("GetCartByToken", mock.Anything, token).Return(guestCartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, guestCartID).Return(guestItems, nil).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(model.UserCartID(0), errStub).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MergeCarts(context.Background(), userID, token, model.MergePolicySum)
+
+		// Assert
+		require.ErrorIs(t, err, errStub)
+	})
+
+	t.Run("error unknown policy", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MergeCarts(context.Background(), userID, token, model.MergePolicy("min"))
+
+		// Assert
+		require.ErrorIs(t, err, ErrUnknownMergePolicy)
+	})
+
+	t.Run("error guest cart not found", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		errStub := errors.New("stub")
+		loms := mocks.NewLomsChecker(t)
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		cartRepository.On("GetCartByToken", mock.Anything, token).Return(model.UserCartID(0), errStub).Once()
+
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MergeCarts(context.Background(), userID, token, model.MergePolicySum)
+
+		// Assert
+		require.ErrorIs(t, err, errStub)
+	})
+}
+
+// Run the merge of MergeCart with the counts of the locked user's cart and keep its result
+func mergeWith(current map[uint32]uint16, merged *[]model.CartItem) func(context.Context, model.UserCartID, model.UserCartID, func(map[uint32]uint16) []model.CartItem) error {
+	return func(_ context.Context, _ model.UserCartID, _ model.UserCartID, merge func(map[uint32]uint16) []model.CartItem) error {
+		*merged = merge(current)
+		return nil
+	}
+}
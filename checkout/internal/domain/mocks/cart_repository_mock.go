@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "route256/checkout/internal/model"
+
+	time "time"
 )
 
 // CartRepository is an autogenerated mock type for the CartRepository type
@@ -15,6 +17,34 @@ type CartRepository struct {
 	mock.Mock
 }
 
+// ClearCart provides a mock function with given fields: ctx, cart
+func (_m *CartRepository) ClearCart(ctx context.Context, cart model.UserCartID) error {
+	ret := _m.Called(ctx, cart)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID) error); ok {
+		r0 = rf(ctx, cart)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CompletePurchase provides a mock function with given fields: ctx, order
+func (_m *CartRepository) CompletePurchase(ctx context.Context, order model.PlacedOrder) error {
+	ret := _m.Called(ctx, order)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.PlacedOrder) error); ok {
+		r0 = rf(ctx, order)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // CreateCart provides a mock function with given fields: ctx, user
 func (_m *CartRepository) CreateCart(ctx context.Context, user model.UserID) (model.UserCartID, error) {
 	ret := _m.Called(ctx, user)
@@ -39,6 +69,30 @@ func (_m *CartRepository) CreateCart(ctx context.Context, user model.UserID) (mo
 	return r0, r1
 }
 
+// CreateGuestCart provides a mock function with given fields: ctx, token
+func (_m *CartRepository) CreateGuestCart(ctx context.Context, token model.CartToken) (model.UserCartID, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 model.UserCartID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.CartToken) (model.UserCartID, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.CartToken) model.UserCartID); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(model.UserCartID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.CartToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // DeleteFromCart provides a mock function with given fields: ctx, user, sku, count
 func (_m *CartRepository) DeleteFromCart(ctx context.Context, user model.UserCartID, sku model.SKU, count uint16) error {
 	ret := _m.Called(ctx, user, sku, count)
@@ -53,6 +107,30 @@ func (_m *CartRepository) DeleteFromCart(ctx context.Context, user model.UserCar
 	return r0
 }
 
+// GetCartByToken provides a mock function with given fields: ctx, token
+func (_m *CartRepository) GetCartByToken(ctx context.Context, token model.CartToken) (model.UserCartID, error) {
+	ret := _m.Called(ctx, token)
+
+	var r0 model.UserCartID
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.CartToken) (model.UserCartID, error)); ok {
+		return rf(ctx, token)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.CartToken) model.UserCartID); ok {
+		r0 = rf(ctx, token)
+	} else {
+		r0 = ret.Get(0).(model.UserCartID)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.CartToken) error); ok {
+		r1 = rf(ctx, token)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetCartByUserID provides a mock function with given fields: ctx, userID
 func (_m *CartRepository) GetCartByUserID(ctx context.Context, userID model.UserID) (model.UserCartID, error) {
 	ret := _m.Called(ctx, userID)
@@ -77,6 +155,56 @@ func (_m *CartRepository) GetCartByUserID(ctx context.Context, userID model.User
 	return r0, r1
 }
 
+// GetCartPromoCode provides a mock function with given fields: ctx, cart
+func (_m *CartRepository) GetCartPromoCode(ctx context.Context, cart model.UserCartID) (model.PromoCode, error) {
+	ret := _m.Called(ctx, cart)
+
+	var r0 model.PromoCode
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID) (model.PromoCode, error)); ok {
+		return rf(ctx, cart)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID) model.PromoCode); ok {
+		r0 = rf(ctx, cart)
+	} else {
+		r0 = ret.Get(0).(model.PromoCode)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.UserCartID) error); ok {
+		r1 = rf(ctx, cart)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// ListAbandonedCarts provides a mock function with given fields: ctx, idleSince, limit
+func (_m *CartRepository) ListAbandonedCarts(ctx context.Context, idleSince time.Time, limit uint64) ([]model.AbandonedCart, error) {
+	ret := _m.Called(ctx, idleSince, limit)
+
+	var r0 []model.AbandonedCart
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64) ([]model.AbandonedCart, error)); ok {
+		return rf(ctx, idleSince, limit)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time, uint64) []model.AbandonedCart); ok {
+		r0 = rf(ctx, idleSince, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.AbandonedCart)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time, uint64) error); ok {
+		r1 = rf(ctx, idleSince, limit)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // ListCart provides a mock function with given fields: ctx, cart
 func (_m *CartRepository) ListCart(ctx context.Context, cart model.UserCartID) ([]model.CartItem, error) {
 	ret := _m.Called(ctx, cart)
@@ -103,6 +231,154 @@ func (_m *CartRepository) ListCart(ctx context.Context, cart model.UserCartID) (
 	return r0, r1
 }
 
+// ListSaved provides a mock function with given fields: ctx, cart
+func (_m *CartRepository) ListSaved(ctx context.Context, cart model.UserCartID) ([]model.CartItem, error) {
+	ret := _m.Called(ctx, cart)
+
+	var r0 []model.CartItem
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID) ([]model.CartItem, error)); ok {
+		return rf(ctx, cart)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID) []model.CartItem); ok {
+		r0 = rf(ctx, cart)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]model.CartItem)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.UserCartID) error); ok {
+		r1 = rf(ctx, cart)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// MarkCartReminded provides a mock function with given fields: ctx, cart, updatedAt
+func (_m *CartRepository) MarkCartReminded(ctx context.Context, cart model.UserCartID, updatedAt time.Time) error {
+	ret := _m.Called(ctx, cart, updatedAt)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID, time.Time) error); ok {
+		r0 = rf(ctx, cart, updatedAt)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MergeCart provides a mock function with given fields: ctx, from, to, merge
+func (_m *CartRepository) MergeCart(ctx context.Context, from model.UserCartID, to model.UserCartID, merge func(map[uint32]uint16) []model.CartItem) error {
+	ret := _m.Called(ctx, from, to, merge)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID, model.UserCartID, func(map[uint32]uint16) []model.CartItem) error); ok {
+		r0 = rf(ctx, from, to, merge)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// MoveToCart provides a mock function with given fields: ctx, cart, sku
+func (_m *CartRepository) MoveToCart(ctx context.Context, cart model.UserCartID, sku model.SKU) error {
+	ret := _m.Called(ctx, cart, sku)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID, model.SKU) error); ok {
+		r0 = rf(ctx, cart, sku)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// PurgeCarts provides a mock function with given fields: ctx, before
+func (_m *CartRepository) PurgeCarts(ctx context.Context, before time.Time) (int64, error) {
+	ret := _m.Called(ctx, before)
+
+	var r0 int64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) (int64, error)); ok {
+		return rf(ctx, before)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, time.Time) int64); ok {
+		r0 = rf(ctx, before)
+	} else {
+		r0 = ret.Get(0).(int64)
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, time.Time) error); ok {
+		r1 = rf(ctx, before)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveCartItem provides a mock function with given fields: ctx, cart, sku
+func (_m *CartRepository) RemoveCartItem(ctx context.Context, cart model.UserCartID, sku model.SKU) error {
+	ret := _m.Called(ctx, cart, sku)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID, model.SKU) error); ok {
+		r0 = rf(ctx, cart, sku)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SaveForLater provides a mock function with given fields: ctx, cart, sku
+func (_m *CartRepository) SaveForLater(ctx context.Context, cart model.UserCartID, sku model.SKU) error {
+	ret := _m.Called(ctx, cart, sku)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID, model.SKU) error); ok {
+		r0 = rf(ctx, cart, sku)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetCartItemCount provides a mock function with given fields: ctx, cart, sku, count
+func (_m *CartRepository) SetCartItemCount(ctx context.Context, cart model.UserCartID, sku model.SKU, count uint16) error {
+	ret := _m.Called(ctx, cart, sku, count)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID, model.SKU, uint16) error); ok {
+		r0 = rf(ctx, cart, sku, count)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SetCartPromoCode provides a mock function with given fields: ctx, cart, code
+func (_m *CartRepository) SetCartPromoCode(ctx context.Context, cart model.UserCartID, code model.PromoCode) error {
+	ret := _m.Called(ctx, cart, code)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserCartID, model.PromoCode) error); ok {
+		r0 = rf(ctx, cart, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateOrAddToCart provides a mock function with given fields: ctx, cart, sku, count
 func (_m *CartRepository) UpdateOrAddToCart(ctx context.Context, cart model.UserCartID, sku model.SKU, count uint16) error {
 	ret := _m.Called(ctx, cart, sku, count)
@@ -8,6 +8,8 @@ import (
 	mock "github.com/stretchr/testify/mock"
 
 	model "route256/checkout/internal/model"
+
+	time "time"
 )
 
 // LomsChecker is an autogenerated mock type for the LomsChecker type
@@ -15,23 +17,23 @@ type LomsChecker struct {
 	mock.Mock
 }
 
-// CreateOrder provides a mock function with given fields: ctx, user, userGoods
-func (_m *LomsChecker) CreateOrder(ctx context.Context, user model.UserID, userGoods []model.CartItem) (model.OrderID, error) {
-	ret := _m.Called(ctx, user, userGoods)
+// CreateOrder provides a mock function with given fields: ctx, user, goods, promotion, delivery, caps
+func (_m *LomsChecker) CreateOrder(ctx context.Context, user model.UserID, goods []model.Good, promotion model.OrderPromotion, delivery model.Delivery, caps []model.OrderCap) (model.OrderID, error) {
+	ret := _m.Called(ctx, user, goods, promotion, delivery, caps)
 
 	var r0 model.OrderID
 	var r1 error
-	if rf, ok := ret.Get(0).(func(context.Context, model.UserID, []model.CartItem) (model.OrderID, error)); ok {
-		return rf(ctx, user, userGoods)
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserID, []model.Good, model.OrderPromotion, model.Delivery, []model.OrderCap) (model.OrderID, error)); ok {
+		return rf(ctx, user, goods, promotion, delivery, caps)
 	}
-	if rf, ok := ret.Get(0).(func(context.Context, model.UserID, []model.CartItem) model.OrderID); ok {
-		r0 = rf(ctx, user, userGoods)
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserID, []model.Good, model.OrderPromotion, model.Delivery, []model.OrderCap) model.OrderID); ok {
+		r0 = rf(ctx, user, goods, promotion, delivery, caps)
 	} else {
 		r0 = ret.Get(0).(model.OrderID)
 	}
 
-	if rf, ok := ret.Get(1).(func(context.Context, model.UserID, []model.CartItem) error); ok {
-		r1 = rf(ctx, user, userGoods)
+	if rf, ok := ret.Get(1).(func(context.Context, model.UserID, []model.Good, model.OrderPromotion, model.Delivery, []model.OrderCap) error); ok {
+		r1 = rf(ctx, user, goods, promotion, delivery, caps)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -65,6 +67,32 @@ func (_m *LomsChecker) GetStocksBySKU(ctx context.Context, sku uint32) ([]model.
 	return r0, r1
 }
 
+// GetUserPurchases provides a mock function with given fields: ctx, user, since, skus
+func (_m *LomsChecker) GetUserPurchases(ctx context.Context, user model.UserID, since time.Time, skus []uint32) (map[uint32]uint64, error) {
+	ret := _m.Called(ctx, user, since, skus)
+
+	var r0 map[uint32]uint64
+	var r1 error
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserID, time.Time, []uint32) (map[uint32]uint64, error)); ok {
+		return rf(ctx, user, since, skus)
+	}
+	if rf, ok := ret.Get(0).(func(context.Context, model.UserID, time.Time, []uint32) map[uint32]uint64); ok {
+		r0 = rf(ctx, user, since, skus)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[uint32]uint64)
+		}
+	}
+
+	if rf, ok := ret.Get(1).(func(context.Context, model.UserID, time.Time, []uint32) error); ok {
+		r1 = rf(ctx, user, since, skus)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // NewLomsChecker creates a new instance of LomsChecker. It also registers a testing interface on the mock and a cleanup function to assert the mocks expectations.
 // The first argument is typically a *testing.T value.
 func NewLomsChecker(t interface {
@@ -0,0 +1,57 @@
+// Moving a saved item back to a user's cart
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Move all saved units of the item back to the user's cart if they are available
+func (s *Service) MoveToCart(ctx context.Context, owner model.CartOwner, sku model.SKU) error {
+	cart, err := s.getCart(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	saved, err := s.cart.ListSaved(ctx, cart)
+	if err != nil {
+		return errors.Wrap(err, "can not get saved items")
+	}
+
+	count := itemCount(saved, uint32(sku))
+	if count == 0 {
+		return model.ErrSavedItemNotFound
+	}
+
+	// Units already in the cart have to be in stock together with the moved ones
+	items, err := s.cart.ListCart(ctx, cart)
+	if err != nil {
+		return errors.Wrap(err, "can not get cart items")
+	}
+	total := uint64(itemCount(items, uint32(sku))) + uint64(count)
+	err = s.checkCountFits(uint32(sku), total)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkStocks(ctx, uint32(sku), total)
+	if err != nil {
+		return err
+	}
+
+	err = s.checkItemChange(ctx, owner, cart, uint32(sku), func(current uint16) uint64 {
+		return uint64(current) + uint64(count)
+	})
+	if err != nil {
+		return err
+	}
+
+	err = s.cart.MoveToCart(ctx, cart, sku)
+	if err != nil {
+		return errors.Wrap(err, "can not move item to cart")
+	}
+
+	return nil
+}
@@ -0,0 +1,215 @@
+// Promotion checks and discount calculation
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+	"route256/libs/apperr"
+	"route256/libs/money"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrPromoCodeNotFound      = apperr.New(apperr.NotFound, "promo code not found")
+	ErrPromoCodeNotActive     = apperr.New(apperr.InvalidState, "promo code is not active")
+	ErrPromoCodeMinCartTotal  = apperr.New(apperr.InvalidState, "cart total is below the promo code minimum")
+	ErrPromoCodeUsageLimit    = apperr.New(apperr.InvalidState, "promo code usage limit is reached")
+	ErrPromoCodeNotApplicable = apperr.New(apperr.InvalidState, "cart has no items the promo code applies to")
+)
+
+// Check if the promo code was rejected by the promotion rules, not by an infrastructure failure
+func IsPromoCodeRejected(err error) bool {
+	return errors.Is(err, ErrPromoCodeNotFound) ||
+		errors.Is(err, ErrPromoCodeNotActive) ||
+		errors.Is(err, ErrPromoCodeMinCartTotal) ||
+		errors.Is(err, ErrPromoCodeUsageLimit) ||
+		errors.Is(err, ErrPromoCodeNotApplicable)
+}
+
+// Get the promotion by code and check that it can be applied to the priced cart.
+// Usage limits are checked for registered users only, guests are checked at purchase
+func (s *Service) getValidPromotion(ctx context.Context, owner model.CartOwner, code model.PromoCode, goods []model.Good) (model.Promotion, error) {
+	promotion, err := s.promotion.GetPromotionByCode(ctx, code)
+	if errors.Is(err, model.ErrPromotionNotFound) {
+		return model.Promotion{}, ErrPromoCodeNotFound
+	}
+	if err != nil {
+		return model.Promotion{}, errors.Wrap(err, "can not get promotion")
+	}
+
+	if !promotion.ActiveAt(time.Now()) {
+		return model.Promotion{}, ErrPromoCodeNotActive
+	}
+
+	total, err := subtotal(goods)
+	if err != nil {
+		return model.Promotion{}, err
+	}
+	if total.Amount < int64(promotion.MinCartTotal) {
+		return model.Promotion{}, ErrPromoCodeMinCartTotal
+	}
+
+	if promotion.SKU != 0 && !hasSKU(goods, promotion.SKU) {
+		return model.Promotion{}, ErrPromoCodeNotApplicable
+	}
+
+	if promotion.UsageLimit > 0 && !owner.IsGuest() {
+		used, err := s.promotion.CountPromotionUsage(ctx, promotion.ID, owner.UserID)
+		if err != nil {
+			return model.Promotion{}, errors.Wrap(err, "can not get promotion usage")
+		}
+		if used >= promotion.UsageLimit {
+			return model.Promotion{}, ErrPromoCodeUsageLimit
+		}
+	}
+
+	return promotion, nil
+}
+
+// Calculate discounts of the promotion for the priced cart items.
+// Line discounts are written to the items, the cart-level discount is returned
+func applyPromotion(promotion model.Promotion, goods []model.Good) (money.Money, error) {
+	resetDiscounts(goods)
+
+	switch promotion.Kind {
+	case model.PromotionNForM:
+		for i := range goods {
+			if goods[i].SKU != promotion.SKU || promotion.BuyCount == 0 || promotion.PayCount >= promotion.BuyCount {
+				continue
+			}
+			free := int64(goods[i].Count/promotion.BuyCount) * int64(promotion.BuyCount-promotion.PayCount)
+			discount, err := goods[i].Price.Mul(free)
+			if err != nil {
+				return money.Money{}, err
+			}
+			goods[i].Discount, err = capDiscount(discount, goods[i])
+			if err != nil {
+				return money.Money{}, err
+			}
+		}
+		return money.Money{}, nil
+	case model.PromotionPercent, model.PromotionFixed:
+		if promotion.SKU == 0 {
+			total, err := subtotal(goods)
+			if err != nil {
+				return money.Money{}, err
+			}
+			discount, err := discountOf(promotion, total)
+			if err != nil {
+				return money.Money{}, err
+			}
+			return discount.Min(total)
+		}
+		for i := range goods {
+			if goods[i].SKU != promotion.SKU {
+				continue
+			}
+			total, err := lineTotal(goods[i])
+			if err != nil {
+				return money.Money{}, err
+			}
+			discount, err := discountOf(promotion, total)
+			if err != nil {
+				return money.Money{}, err
+			}
+			goods[i].Discount, err = capDiscount(discount, goods[i])
+			if err != nil {
+				return money.Money{}, err
+			}
+		}
+		return money.Money{}, nil
+	default:
+		return money.Money{}, nil
+	}
+}
+
+// Fill cart totals from the priced items and the cart-level discount
+func fillTotals(cart *model.UserCartWithTotal) error {
+	total, err := subtotal(cart.Items)
+	if err != nil {
+		return err
+	}
+
+	var lineDiscounts money.Money
+	for _, good := range cart.Items {
+		lineDiscounts, err = lineDiscounts.Add(good.Discount)
+		if err != nil {
+			return err
+		}
+	}
+
+	total, err = total.Sub(lineDiscounts)
+	if err != nil {
+		return err
+	}
+
+	cart.CartDiscount, err = cart.CartDiscount.Min(total)
+	if err != nil {
+		return err
+	}
+
+	cart.TotalDiscount, err = lineDiscounts.Add(cart.CartDiscount)
+	if err != nil {
+		return err
+	}
+
+	cart.TotalPrice, err = total.Sub(cart.CartDiscount)
+	return err
+}
+
+// Calculate percent or fixed discount of the amount
+func discountOf(promotion model.Promotion, amount money.Money) (money.Money, error) {
+	if promotion.Kind == model.PromotionPercent {
+		return amount.Percent(int64(promotion.Value))
+	}
+	return money.New(int64(promotion.Value), amount.Currency), nil
+}
+
+// Never discount more than the line price
+func capDiscount(discount money.Money, good model.Good) (money.Money, error) {
+	total, err := lineTotal(good)
+	if err != nil {
+		return money.Money{}, err
+	}
+	return discount.Min(total)
+}
+
+// Clear discounts of a previous calculation
+func resetDiscounts(goods []model.Good) {
+	for i := range goods {
+		goods[i].Discount = money.Zero(goods[i].Price.Currency)
+	}
+}
+
+// Get price of the line before discounts
+func lineTotal(good model.Good) (money.Money, error) {
+	return good.Price.Mul(int64(good.Count))
+}
+
+// Get price of the cart before discounts
+func subtotal(goods []model.Good) (money.Money, error) {
+	var total money.Money
+	for _, good := range goods {
+		line, err := lineTotal(good)
+		if err != nil {
+			return money.Money{}, err
+		}
+		total, err = total.Add(line)
+		if err != nil {
+			return money.Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// Check if the cart has the item
+func hasSKU(goods []model.Good, sku uint32) bool {
+	for _, good := range goods {
+		if good.SKU == sku {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,419 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"route256/checkout/internal/domain/mocks"
+	"route256/checkout/internal/model"
+	"route256/libs/money"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_applyPromotion(t *testing.T) {
+	t.Parallel()
+
+	goods := func() []model.Good {
+		return []model.Good{
+			{SKU: 1, Count: 5, Price: rub(100)},
+			{SKU: 2, Count: 2, Price: rub(250)},
+		}
+	}
+
+	cases := []struct {
+		name          string
+		promotion     model.Promotion
+		lineDiscounts []int64
+		cartDiscount  int64
+		totalPrice    int64
+	}{
+		{
+			name:          "percent off the cart",
+			promotion:     model.Promotion{Kind: model.PromotionPercent, Value: 10},
+			lineDiscounts: []int64{0, 0},
+			cartDiscount:  100,
+			totalPrice:    900,
+		},
+		{
+			name:          "percent off one sku",
+			promotion:     model.Promotion{Kind: model.PromotionPercent, Value: 20, SKU: 2},
+			lineDiscounts: []int64{0, 100},
+			cartDiscount:  0,
+			totalPrice:    900,
+		},
+		{
+			name:          "fixed off the cart",
+			promotion:     model.Promotion{Kind: model.PromotionFixed, Value: 150},
+			lineDiscounts: []int64{0, 0},
+			cartDiscount:  150,
+			totalPrice:    850,
+		},
+		{
+			name:          "fixed is capped by the cart total",
+			promotion:     model.Promotion{Kind: model.PromotionFixed, Value: 5000},
+			lineDiscounts: []int64{0, 0},
+			cartDiscount:  1000,
+			totalPrice:    0,
+		},
+		{
+			name:          "fixed is capped by the line total",
+			promotion:     model.Promotion{Kind: model.PromotionFixed, Value: 5000, SKU: 1},
+			lineDiscounts: []int64{500, 0},
+			cartDiscount:  0,
+			totalPrice:    500,
+		},
+		{
+			name:          "3 for 2",
+			promotion:     model.Promotion{Kind: model.PromotionNForM, SKU: 1, BuyCount: 3, PayCount: 2},
+			lineDiscounts: []int64{100, 0},
+			cartDiscount:  0,
+			totalPrice:    900,
+		},
+		{
+			name:          "2 for 1 on several sets",
+			promotion:     model.Promotion{Kind: model.PromotionNForM, SKU: 1, BuyCount: 2, PayCount: 1},
+			lineDiscounts: []int64{200, 0},
+			cartDiscount:  0,
+			totalPrice:    800,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			cart := model.UserCartWithTotal{Items: goods()}
+
+			// Act
+			discount, errApply := applyPromotion(tc.promotion, cart.Items)
+			cart.CartDiscount = discount
+			errTotals := fillTotals(&cart)
+
+			// Assert
+			require.NoError(t, errApply)
+			require.NoError(t, errTotals)
+			for i, good := range cart.Items {
+				require.Equal(t, rub(tc.lineDiscounts[i]), good.Discount, "line %d", i)
+			}
+			require.Equal(t, rub(tc.cartDiscount), cart.CartDiscount)
+			require.Equal(t, rub(tc.totalPrice), cart.TotalPrice)
+			require.Equal(t, rub(1000-tc.totalPrice), cart.TotalDiscount)
+		})
+	}
+}
+
+func Test_ApplyPromoCode(t *testing.T) {
+	t.Parallel()
+
+	userID := model.UserID(1)
+	cartID := model.UserCartID(1)
+	code := model.PromoCode("SALE")
+	items := []model.CartItem{{SKU: 1, Count: 2}}
+	goods := func() []model.Good {
+		return []model.Good{{SKU: 1, Count: 2, Name: "item", Price: rub(100)}}
+	}
+
+	cases := []struct {
+		name      string
+		promotion model.Promotion
+		used      uint32
+		err       error
+	}{
+		{
+			name:      "success",
+			promotion: model.Promotion{ID: 7, Code: code, Kind: model.PromotionPercent, Value: 10, UsageLimit: 2},
+			used:      1,
+		},
+		{
+			name:      "error not started",
+			promotion: model.Promotion{ID: 7, Code: code, Kind: model.PromotionPercent, Value: 10, ValidFrom: time.Now().Add(time.Hour)},
+			err:       ErrPromoCodeNotActive,
+		},
+		{
+			name:      "error expired",
+			promotion: model.Promotion{ID: 7, Code: code, Kind: model.PromotionPercent, Value: 10, ValidTo: time.Now().Add(-time.Hour)},
+			err:       ErrPromoCodeNotActive,
+		},
+		{
+			name:      "error min cart total",
+			promotion: model.Promotion{ID: 7, Code: code, Kind: model.PromotionFixed, Value: 10, MinCartTotal: 500},
+			err:       ErrPromoCodeMinCartTotal,
+		},
+		{
+			name:      "error no sku in cart",
+			promotion: model.Promotion{ID: 7, Code: code, Kind: model.PromotionNForM, SKU: 2, BuyCount: 3, PayCount: 2},
+			err:       ErrPromoCodeNotApplicable,
+		},
+		{
+			name:      "error usage limit",
+			promotion: model.Promotion{ID: 7, Code: code, Kind: model.PromotionPercent, Value: 10, UsageLimit: 2},
+			used:      2,
+			err:       ErrPromoCodeUsageLimit,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			product := mocks.NewProductChecker(t)
+			cartRepository := mocks.NewCartRepository(t)
+			promotionRepository := mocks.NewPromotionRepository(t)
+
+			cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(cartID, nil).Once()
+			cartRepository.On("ListCart", mock.Anything, cartID).Return(items, nil).Once()
+			product.On("GetProducts", mock.Anything, items).Return(goods(), nil).Once()
+			promotionRepository.On("GetPromotionByCode", mock.Anything, code).Return(tc.promotion, nil).Once()
+			if tc.promotion.UsageLimit > 0 {
+				promotionRepository.On("CountPromotionUsage", mock.Anything, tc.promotion.ID, userID).Return(tc.used, nil).Once()
+			}
+			if tc.err == nil {
+				cartRepository.On("SetCartPromoCode", mock.Anything, cartID, code).Return(nil).Once()
+			}
+
+			service := New(mocks.NewLomsChecker(t), product, cartRepository, promotionRepository, mocks.NewAddressRepository(t))
+
+			// Act
+			err := service.ApplyPromoCode(context.Background(), model.CartOwner{UserID: userID}, code)
+
+			// Assert
+			if tc.err == nil {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, tc.err)
+			require.True(t, IsPromoCodeRejected(err))
+		})
+	}
+
+	t.Run("error unknown code", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+		promotionRepository := mocks.NewPromotionRepository(t)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(cartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, cartID).Return(items, nil).Once()
+		product.On("GetProducts", mock.Anything, items).Return(goods(), nil).Once()
+		promotionRepository.On("GetPromotionByCode", mock.Anything, code).Return(model.Promotion{}, model.ErrPromotionNotFound).Once()
+
+		service := New(mocks.NewLomsChecker(t), product, cartRepository, promotionRepository, mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.ApplyPromoCode(context.Background(), model.CartOwner{UserID: userID}, code)
+
+		// Assert
+		require.ErrorIs(t, err, ErrPromoCodeNotFound)
+	})
+}
+
+func Test_ListCart_WithPromoCode(t *testing.T) {
+	t.Parallel()
+
+	userID := model.UserID(1)
+	cartID := model.UserCartID(1)
+	code := model.PromoCode("SALE")
+	items := []model.CartItem{{SKU: 1, Count: 3}}
+
+	t.Run("discount is applied", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+		promotionRepository := mocks.NewPromotionRepository(t)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(cartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, cartID).Return(items, nil).Once()
+		product.On("GetProducts", mock.Anything, items).Return([]model.Good{{SKU: 1, Count: 3, Name: "item", Price: rub(100)}}, nil).Once()
+		cartRepository.On("GetCartPromoCode", mock.Anything, cartID).Return(code, nil).Once()
+		promotionRepository.On("GetPromotionByCode", mock.Anything, code).Return(model.Promotion{
+			Code: code, Kind: model.PromotionNForM, SKU: 1, BuyCount: 3, PayCount: 2,
+		}, nil).Once()
+
+		service := New(mocks.NewLomsChecker(t), product, cartRepository, promotionRepository, mocks.NewAddressRepository(t))
+
+		// Act
+		cart, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, code, cart.PromoCode)
+		require.True(t, cart.PromoApplied)
+		require.Equal(t, rub(100), cart.Items[0].Discount)
+		require.Equal(t, rub(100), cart.TotalDiscount)
+		require.Equal(t, rub(200), cart.TotalPrice)
+	})
+
+	t.Run("code that does not fit the cart gives no discount", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+		promotionRepository := mocks.NewPromotionRepository(t)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(cartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, cartID).Return(items, nil).Once()
+		product.On("GetProducts", mock.Anything, items).Return([]model.Good{{SKU: 1, Count: 3, Name: "item", Price: rub(100)}}, nil).Once()
+		cartRepository.On("GetCartPromoCode", mock.Anything, cartID).Return(code, nil).Once()
+		promotionRepository.On("GetPromotionByCode", mock.Anything, code).Return(model.Promotion{
+			Code: code, Kind: model.PromotionFixed, Value: 50, MinCartTotal: 1000,
+		}, nil).Once()
+
+		service := New(mocks.NewLomsChecker(t), product, cartRepository, promotionRepository, mocks.NewAddressRepository(t))
+
+		// Act
+		cart, err := service.ListCart(context.Background(), model.CartOwner{UserID: userID})
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, code, cart.PromoCode)
+		require.False(t, cart.PromoApplied)
+		require.True(t, cart.TotalDiscount.IsZero())
+		require.Equal(t, rub(300), cart.TotalPrice)
+	})
+}
+
+func Test_Purchase_WithPromoCode(t *testing.T) {
+	t.Parallel()
+
+	userID := model.UserID(1)
+	cartID := model.UserCartID(1)
+	orderID := model.OrderID(5)
+	usageID := int64(11)
+	code := model.PromoCode("SALE")
+	items := []model.CartItem{{SKU: 1, Count: 2}}
+	promotion := model.Promotion{ID: 7, Code: code, Kind: model.PromotionPercent, Value: 10, UsageLimit: 1}
+	address := model.DeliveryAddress{ID: 3, UserID: userID, City: "Moscow", Street: "Tverskaya", House: "1"}
+	orderGoods := []model.Good{{SKU: 1, Count: 2, Name: "item", Price: rub(500), Discount: rub(0)}}
+	orderPromotion := model.OrderPromotion{Code: code, Discount: rub(100)}
+	delivery := model.Delivery{Method: model.ShippingWarehousePickup, Address: address}
+	placedOrder := model.PlacedOrder{OrderID: orderID, UserID: userID, CartID: cartID, Items: items, PromoCode: code, UsageID: usageID}
+	newAddressRepository := func(t *testing.T) *mocks.AddressRepository {
+		addressRepository := mocks.NewAddressRepository(t)
+		addressRepository.On("GetAddress", mock.Anything, userID, address.ID).Return(address, nil).Once()
+		return addressRepository
+	}
+	// Mock the steps of the purchase until the promo code is checked
+	newRepositories := func(t *testing.T) (*mocks.ProductChecker, *mocks.CartRepository, *mocks.PromotionRepository) {
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+		promotionRepository := mocks.NewPromotionRepository(t)
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(cartID, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, cartID).Return(items, nil).Once()
+		cartRepository.On("GetCartPromoCode", mock.Anything, cartID).Return(code, nil).Once()
+		product.On("GetProducts", mock.Anything, items).Return([]model.Good{{SKU: 1, Count: 2, Name: "item", Price: rub(500)}}, nil).Once()
+		return product, cartRepository, promotionRepository
+	}
+	newValidPromotion := func(promotionRepository *mocks.PromotionRepository) {
+		promotionRepository.On("GetPromotionByCode", mock.Anything, code).Return(promotion, nil).Once()
+		promotionRepository.On("CountPromotionUsage", mock.Anything, promotion.ID, userID).Return(uint32(0), nil).Once()
+	}
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		product, cartRepository, promotionRepository := newRepositories(t)
+		newValidPromotion(promotionRepository)
+
+		promotionRepository.On("ReservePromotionUsage", mock.Anything, promotion.ID, userID, promotion.UsageLimit).Return(usageID, nil).Once()
+		loms.On("CreateOrder", mock.Anything, userID, orderGoods, orderPromotion, delivery, []model.OrderCap(nil)).Return(orderID, nil).Once()
+		cartRepository.On("CompletePurchase", mock.Anything, placedOrder).Return(nil).Once()
+
+		service := New(loms, product, cartRepository, promotionRepository, newAddressRepository(t))
+
+		// Act
+		id, err := service.Purchase(context.Background(), userID, address.ID, model.ShippingWarehousePickup)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, orderID, id)
+	})
+
+	t.Run("error usage limit taken by a concurrent purchase", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		product, cartRepository, promotionRepository := newRepositories(t)
+		newValidPromotion(promotionRepository)
+
+		promotionRepository.On("ReservePromotionUsage", mock.Anything, promotion.ID, userID, promotion.UsageLimit).Return(int64(0), model.ErrPromotionUsageLimit).Once()
+
+		service := New(mocks.NewLomsChecker(t), product, cartRepository, promotionRepository, newAddressRepository(t))
+
+		// Act
+		_, err := service.Purchase(context.Background(), userID, address.ID, model.ShippingWarehousePickup)
+
+		// Assert
+		require.ErrorIs(t, err, ErrPromoCodeUsageLimit)
+	})
+
+	t.Run("reserved usage released when the order fails", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		errStub := errors.New("stub")
+		loms := mocks.NewLomsChecker(t)
+		product, cartRepository, promotionRepository := newRepositories(t)
+		newValidPromotion(promotionRepository)
+
+		promotionRepository.On("ReservePromotionUsage", mock.Anything, promotion.ID, userID, promotion.UsageLimit).Return(usageID, nil).Once()
+		loms.On("CreateOrder", mock.Anything, userID, orderGoods, orderPromotion, delivery, []model.OrderCap(nil)).Return(model.OrderID(0), errStub).Once()
+		promotionRepository.On("ReleasePromotionUsage", mock.Anything, usageID).Return(nil).Once()
+
+		service := New(loms, product, cartRepository, promotionRepository, newAddressRepository(t))
+
+		// Act
+		_, err := service.Purchase(context.Background(), userID, address.ID, model.ShippingWarehousePickup)
+
+		// Assert
+		require.ErrorIs(t, err, errStub)
+	})
+
+	t.Run("placed order recorded again after a failed attempt", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		errStub := errors.New("stub")
+		loms := mocks.NewLomsChecker(t)
+		product, cartRepository, promotionRepository := newRepositories(t)
+		newValidPromotion(promotionRepository)
+
+		promotionRepository.On("ReservePromotionUsage", mock.Anything, promotion.ID, userID, promotion.UsageLimit).Return(usageID, nil).Once()
+		loms.On("CreateOrder", mock.Anything, userID, orderGoods, orderPromotion, delivery, []model.OrderCap(nil)).Return(orderID, nil).Once()
+		cartRepository.On("CompletePurchase", mock.Anything, placedOrder).Return(errStub).Once()
+		cartRepository.On("CompletePurchase", mock.Anything, placedOrder).Return(nil).Once()
+
+		service := New(loms, product, cartRepository, promotionRepository, newAddressRepository(t))
+
+		// Act
+		id, err := service.Purchase(context.Background(), userID, address.ID, model.ShippingWarehousePickup)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, orderID, id)
+	})
+
+	t.Run("error promo code is not valid anymore", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		product, cartRepository, promotionRepository := newRepositories(t)
+		promotionRepository.On("GetPromotionByCode", mock.Anything, code).Return(model.Promotion{}, errors.New("stub")).Once()
+
+		service := New(mocks.NewLomsChecker(t), product, cartRepository, promotionRepository, newAddressRepository(t))
+
+		// Act
+		_, err := service.Purchase(context.Background(), userID, address.ID, model.ShippingWarehousePickup)
+
+		// Assert
+		require.Error(t, err)
+	})
+}
+
+// Create an amount in roubles for tests
+func rub(amount int64) money.Money {
+	return money.New(amount, "RUB")
+}
@@ -4,12 +4,34 @@ package domain
 import (
 	"context"
 	"route256/checkout/internal/model"
+	"route256/checkout/internal/pkg/logger"
+	"route256/libs/apperr"
+	"time"
 
 	"github.com/pkg/errors"
 )
 
-// Create a custom order
-func (s *Service) Purchase(ctx context.Context, user model.UserID) (model.OrderID, error) {
+var (
+	ErrUnknownShippingMethod = apperr.New(apperr.InvalidArgument, "unknown shipping method")
+)
+
+// Delays between attempts to record a placed order, doubled after every failure
+const (
+	completePurchaseBackoff    = 100 * time.Millisecond
+	completePurchaseMaxBackoff = 10 * time.Second
+)
+
+// Create a custom order with current prices, the promotion attached to the cart
+// and a snapshot of the delivery address are passed to LOMS
+func (s *Service) Purchase(ctx context.Context, user model.UserID, addressID model.AddressID, method model.ShippingMethod) (model.OrderID, error) {
+	if !method.Valid() {
+		return 0, ErrUnknownShippingMethod
+	}
+
+	address, err := s.address.GetAddress(ctx, user, addressID)
+	if err != nil {
+		return 0, errors.Wrap(err, "get delivery address")
+	}
 
 	cart, err := s.cart.GetCartByUserID(ctx, user)
 	if err != nil {
@@ -21,10 +43,108 @@ func (s *Service) Purchase(ctx context.Context, user model.UserID) (model.OrderI
 		return 0, errors.Wrap(err, "get user cart items")
 	}
 
-	orderID, err := s.lomsChecker.CreateOrder(ctx, user, cartItems)
+	code, err := s.cart.GetCartPromoCode(ctx, cart)
+	if err != nil {
+		return 0, errors.Wrap(err, "get cart promo code")
+	}
+
+	// Prices are passed to LOMS as a snapshot of the order cost
+	goods, err := s.getGoods(ctx, cartItems)
+	if err != nil {
+		return 0, err
+	}
+
+	// Purchase caps could be reached by other orders since the items were added,
+	// the check fails fast and LOMS checks the caps again when it creates the order
+	err = s.checkCartPolicy(ctx, model.CartOwner{UserID: user}, cartItems, goods)
 	if err != nil {
+		return 0, err
+	}
+
+	var promotion model.Promotion
+	var orderPromotion model.OrderPromotion
+	if code != "" {
+		promotion, err = s.getValidPromotion(ctx, model.CartOwner{UserID: user}, code, goods)
+		if err != nil {
+			return 0, errors.Wrap(err, "promo code can not be applied")
+		}
+
+		totals := model.UserCartWithTotal{Items: goods}
+		totals.CartDiscount, err = applyPromotion(promotion, goods)
+		if err != nil {
+			return 0, errors.Wrap(err, "calculate discounts")
+		}
+		err = fillTotals(&totals)
+		if err != nil {
+			return 0, errors.Wrap(err, "calculate cart total")
+		}
+		orderPromotion = model.OrderPromotion{Code: code, Discount: totals.TotalDiscount}
+	}
+
+	// The use is reserved before the order is created, so concurrent purchases can not exceed the usage limit
+	var usageID int64
+	if code != "" {
+		usageID, err = s.promotion.ReservePromotionUsage(ctx, promotion.ID, user, promotion.UsageLimit)
+		if errors.Is(err, model.ErrPromotionUsageLimit) {
+			return 0, errors.Wrap(ErrPromoCodeUsageLimit, "promo code can not be applied")
+		}
+		if err != nil {
+			return 0, errors.Wrap(err, "reserve promo code usage")
+		}
+	}
+
+	orderID, err := s.lomsChecker.CreateOrder(ctx, user, goods, orderPromotion, model.Delivery{Method: method, Address: address}, s.orderCaps(goods))
+	if err != nil {
+		err = s.purchaseCapViolation(err)
+		if code != "" {
+			releaseErr := s.promotion.ReleasePromotionUsage(ctx, usageID)
+			if releaseErr != nil {
+				logger.Errorf(ctx, "domain/purchase", "release usage %d of promo code %s: %v", usageID, code, releaseErr)
+			}
+		}
 		return 0, errors.Wrap(err, "purchase order")
 	}
 
+	// The order is placed, so the purchase does not fail anymore:
+	// an error would make the client place the same order again
+	s.completePurchase(ctx, model.PlacedOrder{OrderID: orderID, UserID: user, CartID: cart, Items: cartItems, PromoCode: code, UsageID: usageID})
+
 	return orderID, nil
 }
+
+// Record the placed order against the cart until it is saved. The order id makes the attempts safe to repeat,
+// the client going away does not stop them
+func (s *Service) completePurchase(ctx context.Context, order model.PlacedOrder) {
+	ctx = detachedContext{ctx}
+	backoff := completePurchaseBackoff
+	for {
+		err := s.cart.CompletePurchase(ctx, order)
+		if err == nil {
+			return
+		}
+		logger.Errorf(ctx, "domain/purchase", "record order %d of cart %d: %v", order.OrderID, order.CartID, err)
+
+		time.Sleep(backoff)
+		backoff *= 2
+		if backoff > completePurchaseMaxBackoff {
+			backoff = completePurchaseMaxBackoff
+		}
+	}
+}
+
+// Keep the values of a context, e.g. the trace, without its deadline and cancellation
+type detachedContext struct {
+	context.Context
+}
+
+func (detachedContext) Deadline() (time.Time, bool) {
+	return time.Time{}, false
+}
+
+func (detachedContext) Done() <-chan struct{} {
+	return nil
+}
+
+func (detachedContext) Err() error {
+	return nil
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"route256/checkout/internal/domain/mocks"
 	"route256/checkout/internal/model"
+	"route256/libs/money"
 	"testing"
 
 	"github.com/brianvoe/gofakeit/v6"
@@ -15,6 +16,10 @@ import (
 func Test_Purchase(t *testing.T) {
 	t.Parallel()
 
+	addressID := model.AddressID(3)
+	address := model.DeliveryAddress{ID: addressID, UserID: 1, City: "Moscow", Street: "Tverskaya", House: "1"}
+	delivery := model.Delivery{Method: model.ShippingCourier, Address: address}
+
 	t.Run("success", func(t *testing.T) {
 		t.Parallel()
 
@@ -23,24 +28,31 @@ func Test_Purchase(t *testing.T) {
 		loms := mocks.NewLomsChecker(t)
 		product := mocks.NewProductChecker(t)
 		cartRepository := mocks.NewCartRepository(t)
+		addressRepository := mocks.NewAddressRepository(t)
 
 		userID := model.UserID(1)
+		addressRepository.On("GetAddress", mock.Anything, userID, addressID).Return(address, nil).Once()
 		userCartID := model.UserCartID(1)
 		orderID := model.OrderID(1)
 
 		// fill repository mock data
 		items := make([]model.CartItem, userItemsCount)
+		goods := make([]model.Good, userItemsCount)
 		for i := 0; i < userItemsCount; i++ {
 			require.NoError(t, gofakeit.Struct(&items[i]))
+			goods[i] = model.Good{SKU: items[i].SKU, Count: items[i].Count, Name: gofakeit.Name(), Price: money.New(int64(gofakeit.Uint32()), "RUB")}
 		}
 		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
 		cartRepository.On("ListCart", mock.Anything, userCartID).Return(items, nil).Once()
-		loms.On("CreateOrder", mock.Anything, userID, items).Return(orderID, nil).Once()
+		cartRepository.On("GetCartPromoCode", mock.Anything, userCartID).Return(model.PromoCode(""), nil).Once()
+		product.On("GetProducts", mock.Anything, items).Return(goods, nil).Once()
+		loms.On("CreateOrder", mock.Anything, userID, goods, model.OrderPromotion{}, delivery, []model.OrderCap(nil)).Return(orderID, nil).Once()
+		cartRepository.On("CompletePurchase", mock.Anything, model.PlacedOrder{OrderID: orderID, UserID: userID, CartID: userCartID, Items: items}).Return(nil).Once()
 
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), addressRepository)
 
 		// Act
-		id, err := service.Purchase(context.Background(), userID)
+		id, err := service.Purchase(context.Background(), userID, addressID, model.ShippingCourier)
 		// Assert
 		require.NoError(t, err)
 		require.Equal(t, id, orderID)
@@ -54,15 +66,17 @@ func Test_Purchase(t *testing.T) {
 		loms := mocks.NewLomsChecker(t)
 		product := mocks.NewProductChecker(t)
 		cartRepository := mocks.NewCartRepository(t)
+		addressRepository := mocks.NewAddressRepository(t)
 
 		userID := model.UserID(1)
+		addressRepository.On("GetAddress", mock.Anything, userID, addressID).Return(address, nil).Once()
 
 		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(model.UserCartID(0), errStub).Once()
 
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), addressRepository)
 
 		// Act
-		_, err := service.Purchase(context.Background(), userID)
+		_, err := service.Purchase(context.Background(), userID, addressID, model.ShippingCourier)
 		// Assert
 		require.ErrorIs(t, err, errStub)
 	})
@@ -75,17 +89,19 @@ func Test_Purchase(t *testing.T) {
 		loms := mocks.NewLomsChecker(t)
 		product := mocks.NewProductChecker(t)
 		cartRepository := mocks.NewCartRepository(t)
+		addressRepository := mocks.NewAddressRepository(t)
 
 		userID := model.UserID(1)
+		addressRepository.On("GetAddress", mock.Anything, userID, addressID).Return(address, nil).Once()
 		userCartID := model.UserCartID(1)
 
 		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
 		cartRepository.On("ListCart", mock.Anything, userCartID).Return(nil, errStub).Once()
 
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), addressRepository)
 
 		// Act
-		_, err := service.Purchase(context.Background(), userID)
+		_, err := service.Purchase(context.Background(), userID, addressID, model.ShippingCourier)
 		// Assert
 		require.ErrorIs(t, err, errStub)
 	})
@@ -99,24 +115,58 @@ func Test_Purchase(t *testing.T) {
 		loms := mocks.NewLomsChecker(t)
 		product := mocks.NewProductChecker(t)
 		cartRepository := mocks.NewCartRepository(t)
+		addressRepository := mocks.NewAddressRepository(t)
 
 		userID := model.UserID(1)
+		addressRepository.On("GetAddress", mock.Anything, userID, addressID).Return(address, nil).Once()
 		userCartID := model.UserCartID(1)
 
 		// fill repository mock data
 		items := make([]model.CartItem, userItemsCount)
+		goods := make([]model.Good, userItemsCount)
 		for i := 0; i < userItemsCount; i++ {
 			require.NoError(t, gofakeit.Struct(&items[i]))
+			goods[i] = model.Good{SKU: items[i].SKU, Count: items[i].Count, Name: gofakeit.Name(), Price: money.New(int64(gofakeit.Uint32()), "RUB")}
 		}
 		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
 		cartRepository.On("ListCart", mock.Anything, userCartID).Return(items, nil).Once()
-		loms.On("CreateOrder", mock.Anything, userID, items).Return(model.OrderID(0), errStub).Once()
+		cartRepository.On("GetCartPromoCode", mock.Anything, userCartID).Return(model.PromoCode(""), nil).Once()
+		product.On("GetProducts", mock.Anything, items).Return(goods, nil).Once()
+		loms.On("CreateOrder", mock.Anything, userID, goods, model.OrderPromotion{}, delivery, []model.OrderCap(nil)).Return(model.OrderID(0), errStub).Once()
 
-		service := New(loms, product, cartRepository)
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), addressRepository)
 
 		// Act
-		_, err := service.Purchase(context.Background(), userID)
+		_, err := service.Purchase(context.Background(), userID, addressID, model.ShippingCourier)
 		// Assert
 		require.ErrorIs(t, err, errStub)
 	})
+
+	t.Run("error unknown shipping method", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		_, err := service.Purchase(context.Background(), model.UserID(1), addressID, model.ShippingMethod("drone"))
+
+		// Assert
+		require.ErrorIs(t, err, ErrUnknownShippingMethod)
+	})
+
+	t.Run("error address of another user", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		userID := model.UserID(2)
+		addressRepository := mocks.NewAddressRepository(t)
+		addressRepository.On("GetAddress", mock.Anything, userID, addressID).Return(model.DeliveryAddress{}, model.ErrAddressNotFound).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), mocks.NewCartRepository(t), mocks.NewPromotionRepository(t), addressRepository)
+
+		// Act
+		_, err := service.Purchase(context.Background(), userID, addressID, model.ShippingPickupPoint)
+
+		// Assert
+		require.ErrorIs(t, err, model.ErrAddressNotFound)
+	})
 }
@@ -0,0 +1,24 @@
+// Removing a line from a user's cart
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Remove the item from the user's cart regardless of its quantity
+func (s *Service) RemoveCartItem(ctx context.Context, owner model.CartOwner, sku model.SKU) error {
+	cart, err := s.getCart(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	err = s.cart.RemoveCartItem(ctx, cart, sku)
+	if err != nil {
+		return errors.Wrap(err, "can not remove item")
+	}
+
+	return nil
+}
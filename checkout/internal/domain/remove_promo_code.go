@@ -0,0 +1,24 @@
+// Detaching a promo code from the cart
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Detach the promo code from the cart
+func (s *Service) RemovePromoCode(ctx context.Context, owner model.CartOwner) error {
+	cart, err := s.getCart(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	err = s.cart.SetCartPromoCode(ctx, cart, "")
+	if err != nil {
+		return errors.Wrap(err, "can not detach promo code")
+	}
+
+	return nil
+}
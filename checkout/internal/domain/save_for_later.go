@@ -0,0 +1,24 @@
+// Saving an item of a user's cart for later
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Move the whole line of the item from the user's cart to the saved for later list
+func (s *Service) SaveForLater(ctx context.Context, owner model.CartOwner, sku model.SKU) error {
+	cart, err := s.getCart(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	err = s.cart.SaveForLater(ctx, cart, sku)
+	if err != nil {
+		return errors.Wrap(err, "can not save item for later")
+	}
+
+	return nil
+}
@@ -0,0 +1,216 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"math"
+	"route256/checkout/internal/domain/mocks"
+	"route256/checkout/internal/model"
+	"route256/libs/money"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_MoveToCart(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+		sku := model.SKU(10)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("ListSaved", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 10, Count: 3}}, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 11, Count: 1}}, nil).Once()
+		loms.On("GetStocksBySKU", mock.Anything, uint32(sku)).Return([]model.Stock{
+			{WarehouseID: 1, Count: 3},
+		}, nil).Once()
+		cartRepository.On("MoveToCart", mock.Anything, userCartID, sku).Return(nil).Once()
+
+		service := New(loms, mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MoveToCart(context.Background(), model.CartOwner{UserID: userID}, sku)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("error item is not saved", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("ListSaved", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 11, Count: 3}}, nil).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MoveToCart(context.Background(), model.CartOwner{UserID: userID}, 10)
+
+		// Assert
+		require.ErrorIs(t, err, model.ErrSavedItemNotFound)
+	})
+
+	t.Run("error stock insufficient", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("ListSaved", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 10, Count: 3}}, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, userCartID).Return([]model.CartItem{}, nil).Once()
+		loms.On("GetStocksBySKU", mock.Anything, uint32(10)).Return([]model.Stock{
+			{WarehouseID: 1, Count: 2},
+		}, nil).Once()
+
+		service := New(loms, mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MoveToCart(context.Background(), model.CartOwner{UserID: userID}, 10)
+
+		// Assert
+		require.ErrorIs(t, err, ErrStockInsufficient)
+	})
+
+	t.Run("error stock insufficient for units in cart and saved", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("ListSaved", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 10, Count: 3}}, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 10, Count: 2}}, nil).Once()
+		loms.On("GetStocksBySKU", mock.Anything, uint32(10)).Return([]model.Stock{
+			{WarehouseID: 1, Count: 4},
+		}, nil).Once()
+
+		service := New(loms, mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MoveToCart(context.Background(), model.CartOwner{UserID: userID}, 10)
+
+		// Assert
+		require.ErrorIs(t, err, ErrStockInsufficient)
+	})
+
+	t.Run("error count does not fit the cart", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("ListSaved", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 10, Count: 3}}, nil).Once()
+		cartRepository.On("ListCart", mock.Anything, userCartID).Return([]model.CartItem{{SKU: 10, Count: math.MaxUint16}}, nil).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.MoveToCart(context.Background(), model.CartOwner{UserID: userID}, 10)
+
+		// Assert
+		require.ErrorIs(t, err, ErrCartPolicyViolation)
+	})
+}
+
+func Test_ListSaved(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+		saved := []model.CartItem{{SKU: 10, Count: 2}, {SKU: 11, Count: 5}}
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("ListSaved", mock.Anything, userCartID).Return(saved, nil).Once()
+		product.On("GetProducts", mock.Anything, saved).Return([]model.Good{
+			{SKU: 10, Count: 2, Name: "first", Price: money.New(100, "RUB")},
+			{SKU: 11, Count: 5, Name: "second", Price: money.New(200, "RUB")},
+		}, nil).Once()
+		loms.On("GetStocksBySKU", mock.Anything, uint32(10)).Return([]model.Stock{
+			{WarehouseID: 1, Count: 1},
+			{WarehouseID: 2, Count: 2},
+		}, nil).Once()
+		loms.On("GetStocksBySKU", mock.Anything, uint32(11)).Return([]model.Stock{
+			{WarehouseID: 1, Count: 4},
+		}, nil).Once()
+
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		result, err := service.ListSaved(context.Background(), model.CartOwner{UserID: userID})
+
+		// Assert
+		require.NoError(t, err)
+		require.Len(t, result, 2)
+		require.Equal(t, "first", result[0].Good.Name)
+		require.Equal(t, uint64(3), result[0].InStock)
+		require.True(t, result[0].Available)
+		require.Equal(t, money.New(200, "RUB"), result[1].Good.Price)
+		require.Equal(t, uint64(4), result[1].InStock)
+		require.False(t, result[1].Available)
+	})
+
+	t.Run("user without cart", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		cartRepository := mocks.NewCartRepository(t)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, model.UserID(1)).Return(model.UserCartID(0), model.ErrCartNotFound).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		result, err := service.ListSaved(context.Background(), model.CartOwner{UserID: 1})
+
+		// Assert
+		require.NoError(t, err)
+		require.Empty(t, result)
+	})
+
+	t.Run("error cart lookup fails", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		cartRepository := mocks.NewCartRepository(t)
+
+		lookupErr := errors.New("connection refused")
+		cartRepository.On("GetCartByUserID", mock.Anything, model.UserID(1)).Return(model.UserCartID(0), lookupErr).Once()
+
+		service := New(mocks.NewLomsChecker(t), mocks.NewProductChecker(t), cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		result, err := service.ListSaved(context.Background(), model.CartOwner{UserID: 1})
+
+		// Assert
+		require.ErrorIs(t, err, lookupErr)
+		require.Nil(t, result)
+	})
+}
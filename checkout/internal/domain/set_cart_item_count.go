@@ -0,0 +1,41 @@
+// Setting the quantity of an item in a user's cart
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Set the absolute quantity of the item in the user's cart,
+// zero count removes the item from the cart
+func (s *Service) SetCartItemCount(ctx context.Context, owner model.CartOwner, sku model.SKU, count uint16) error {
+	if count > 0 {
+		err := s.checkStocks(ctx, uint32(sku), uint64(count))
+		if err != nil {
+			return err
+		}
+	}
+
+	cart, err := s.getOrCreateCart(ctx, owner)
+	if err != nil {
+		return err
+	}
+
+	if count > 0 {
+		err = s.checkItemChange(ctx, owner, cart, uint32(sku), func(uint16) uint64 {
+			return uint64(count)
+		})
+		if err != nil {
+			return err
+		}
+	}
+
+	err = s.cart.SetCartItemCount(ctx, cart, sku, count)
+	if err != nil {
+		return errors.Wrap(err, "can not set item count")
+	}
+
+	return nil
+}
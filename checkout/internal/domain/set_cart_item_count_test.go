@@ -0,0 +1,117 @@
+package domain
+
+import (
+	"context"
+	"errors"
+	"route256/checkout/internal/domain/mocks"
+	"route256/checkout/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_SetCartItemCount(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+		sku := model.SKU(10)
+		count := uint16(5)
+
+		loms.On("GetStocksBySKU", mock.Anything, uint32(sku)).Return([]model.Stock{
+			{WarehouseID: 1, Count: 3},
+			{WarehouseID: 2, Count: 3},
+		}, nil).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("SetCartItemCount", mock.Anything, userCartID, sku, count).Return(nil).Once()
+
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.SetCartItemCount(context.Background(), model.CartOwner{UserID: userID}, sku, count)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("zero count removes item without stock check", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+		sku := model.SKU(10)
+
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("SetCartItemCount", mock.Anything, userCartID, sku, uint16(0)).Return(nil).Once()
+
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.SetCartItemCount(context.Background(), model.CartOwner{UserID: userID}, sku, 0)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("error stock insufficient", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		loms := mocks.NewLomsChecker(t)
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		sku := model.SKU(10)
+
+		loms.On("GetStocksBySKU", mock.Anything, uint32(sku)).Return([]model.Stock{
+			{WarehouseID: 1, Count: 3},
+		}, nil).Once()
+
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.SetCartItemCount(context.Background(), model.CartOwner{UserID: 1}, sku, 5)
+
+		// Assert
+		require.ErrorIs(t, err, ErrStockInsufficient)
+	})
+
+	t.Run("error set item count", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		errStub := errors.New("stub")
+		loms := mocks.NewLomsChecker(t)
+		product := mocks.NewProductChecker(t)
+		cartRepository := mocks.NewCartRepository(t)
+
+		userID := model.UserID(1)
+		userCartID := model.UserCartID(1)
+		sku := model.SKU(10)
+
+		loms.On("GetStocksBySKU", mock.Anything, uint32(sku)).Return([]model.Stock{
+			{WarehouseID: 1, Count: 10},
+		}, nil).Once()
+		cartRepository.On("GetCartByUserID", mock.Anything, userID).Return(model.UserCartID(0), model.ErrCartNotFound).Once()
+		cartRepository.On("CreateCart", mock.Anything, userID).Return(userCartID, nil).Once()
+		cartRepository.On("SetCartItemCount", mock.Anything, userCartID, sku, uint16(1)).Return(errStub).Once()
+
+		service := New(loms, product, cartRepository, mocks.NewPromotionRepository(t), mocks.NewAddressRepository(t))
+
+		// Act
+		err := service.SetCartItemCount(context.Background(), model.CartOwner{UserID: userID}, sku, 1)
+
+		// Assert
+		require.ErrorIs(t, err, errStub)
+	})
+}
@@ -0,0 +1,19 @@
+// Changing a delivery address
+package domain
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Replace the saved delivery address, orders already placed keep their own copy
+func (s *Service) UpdateAddress(ctx context.Context, address model.DeliveryAddress) error {
+	err := s.address.UpdateAddress(ctx, address)
+	if err != nil {
+		return errors.Wrap(err, "can not update address")
+	}
+
+	return nil
+}
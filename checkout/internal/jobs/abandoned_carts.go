@@ -0,0 +1,71 @@
+// Periodic reminders about abandoned carts and purging of stale carts
+package jobs
+
+import (
+	"context"
+	"route256/checkout/internal/pkg/logger"
+	"time"
+)
+
+// Describe the business logic used by the job
+type AbandonedCartsService interface {
+	RemindAbandonedCarts(ctx context.Context, idleFor time.Duration) (int, error)
+	PurgeExpiredCarts(ctx context.Context, retention time.Duration) (int64, error)
+}
+
+// Define abandoned carts job
+type AbandonedCarts struct {
+	service   AbandonedCartsService
+	interval  time.Duration
+	idleAfter time.Duration
+	retention time.Duration
+}
+
+// Create a new abandoned carts job
+func NewAbandonedCarts(service AbandonedCartsService, interval, idleAfter, retention time.Duration) *AbandonedCarts {
+	return &AbandonedCarts{
+		service:   service,
+		interval:  interval,
+		idleAfter: idleAfter,
+		retention: retention,
+	}
+}
+
+// Run the job every interval until the context is cancelled
+func (j *AbandonedCarts) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.runOnce(ctx)
+		}
+	}
+}
+
+// Send reminders and purge stale carts, errors are logged and retried on the next tick
+func (j *AbandonedCarts) runOnce(ctx context.Context) {
+	sent, err := j.service.RemindAbandonedCarts(ctx, j.idleAfter)
+	if err != nil {
+		logger.Errorf(ctx, "jobs/abandoned_carts", "remind abandoned carts: %v", err)
+	}
+	if sent > 0 {
+		logger.Info("abandoned cart reminders sent: ", sent)
+	}
+
+	// Carts are kept forever when retention is not set
+	if j.retention <= 0 {
+		return
+	}
+
+	purged, err := j.service.PurgeExpiredCarts(ctx, j.retention)
+	if err != nil {
+		logger.Errorf(ctx, "jobs/abandoned_carts", "purge expired carts: %v", err)
+	}
+	if purged > 0 {
+		logger.Info("expired carts purged: ", purged)
+	}
+}
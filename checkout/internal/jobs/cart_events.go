@@ -0,0 +1,56 @@
+// Periodic publishing of cart events from the outbox
+package jobs
+
+import (
+	"context"
+	"route256/checkout/internal/pkg/logger"
+	"time"
+)
+
+// Describe the business logic used by the job
+type CartEventsService interface {
+	PublishCartEvents(ctx context.Context) (int, error)
+}
+
+// Define cart events relay job
+type CartEventsRelay struct {
+	service  CartEventsService
+	interval time.Duration
+}
+
+// Create a new cart events relay job
+func NewCartEventsRelay(service CartEventsService, interval time.Duration) *CartEventsRelay {
+	return &CartEventsRelay{
+		service:  service,
+		interval: interval,
+	}
+}
+
+// Run the job every interval until the context is cancelled
+func (j *CartEventsRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(j.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			j.runOnce(ctx)
+		}
+	}
+}
+
+// Publish batches until the outbox is drained, errors are logged and retried on the next tick
+func (j *CartEventsRelay) runOnce(ctx context.Context) {
+	for ctx.Err() == nil {
+		published, err := j.service.PublishCartEvents(ctx)
+		if err != nil {
+			logger.Errorf(ctx, "jobs/cart_events", "publish cart events: %v", err)
+			return
+		}
+		if published == 0 {
+			return
+		}
+	}
+}
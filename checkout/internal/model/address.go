@@ -0,0 +1,51 @@
+// Delivery models
+package model
+
+import "route256/libs/apperr"
+
+var (
+	ErrAddressNotFound = apperr.New(apperr.NotFound, "address not found")
+)
+
+// Describe delivery address id
+type AddressID int64
+
+// Describe saved delivery address of a user
+type DeliveryAddress struct {
+	ID         AddressID
+	UserID     UserID
+	City       string
+	Street     string
+	House      string
+	Apartment  string
+	PostalCode string
+	// Free-form note for the courier
+	Comment string
+}
+
+// Describe how the order gets to the shopper
+type ShippingMethod string
+
+const (
+	// Courier brings the order to the address
+	ShippingCourier ShippingMethod = "courier"
+	// Shopper picks the order up at a pickup point near the address
+	ShippingPickupPoint ShippingMethod = "pickup_point"
+	// Shopper picks the order up at a warehouse in the city of the address
+	ShippingWarehousePickup ShippingMethod = "warehouse_pickup"
+)
+
+// Check if the shipping method is known
+func (m ShippingMethod) Valid() bool {
+	switch m {
+	case ShippingCourier, ShippingPickupPoint, ShippingWarehousePickup:
+		return true
+	}
+	return false
+}
+
+// Describe delivery of an order passed to LOMS with a snapshot of the address
+type Delivery struct {
+	Method  ShippingMethod
+	Address DeliveryAddress
+}
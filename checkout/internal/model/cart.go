@@ -1,6 +1,16 @@
 // User cart models
 package model
 
+import (
+	"route256/libs/apperr"
+	"route256/libs/money"
+	"time"
+)
+
+var (
+	ErrCartNotFound = apperr.New(apperr.NotFound, "cart not found")
+)
+
 // Describe user id
 type UserID int64
 
@@ -10,20 +20,83 @@ type OrderID int64
 // Describe user cart id
 type UserCartID int64
 
+// Describe opaque token of a guest cart
+type CartToken string
+
+// Describe the owner of a cart: a registered user or a guest with a cart token
+type CartOwner struct {
+	UserID UserID
+	Token  CartToken
+}
+
+// Check if the cart belongs to an anonymous shopper
+func (o CartOwner) IsGuest() bool {
+	return o.UserID == 0
+}
+
+// Describe how to resolve item counts when a guest cart is merged into a user cart
+type MergePolicy string
+
+const (
+	// Add guest count to user count
+	MergePolicySum MergePolicy = "sum"
+	// Keep the larger of the two counts
+	MergePolicyMax MergePolicy = "max"
+	// Replace user count with guest count
+	MergePolicyPreferGuest MergePolicy = "prefer_guest"
+)
+
+// Check if the policy is known
+func (p MergePolicy) Valid() bool {
+	switch p {
+	case MergePolicySum, MergePolicyMax, MergePolicyPreferGuest:
+		return true
+	}
+	return false
+}
+
 // Describe user cart
 type Cart struct {
 	ID     int64
 	UserID int64
 }
 
+// Describe a user cart that has not been touched for a while
+type AbandonedCart struct {
+	ID        UserCartID
+	UserID    UserID
+	UpdatedAt time.Time
+	Items     []CartItem
+}
+
 // Describe cart item
 type CartItem struct {
 	SKU   uint32
 	Count uint16
 }
 
+// Describe an order placed in LOMS from the cart
+type PlacedOrder struct {
+	OrderID OrderID
+	UserID  UserID
+	CartID  UserCartID
+	Items   []CartItem
+	// Promo code of the order and its reserved use, empty code means no promotion
+	PromoCode PromoCode
+	UsageID   int64
+}
+
 // Describe user cart with total price
 type UserCartWithTotal struct {
-	Items      []Good
-	TotalPrice uint32
+	Items []Good
+	// Promo code attached to the cart, empty if there is none
+	PromoCode PromoCode
+	// False if the attached promo code does not apply to the cart anymore
+	PromoApplied bool
+	// Promotion discount for the whole cart on top of line discounts
+	CartDiscount money.Money
+	// Sum of line and cart discounts
+	TotalDiscount money.Money
+	// Price to pay after all discounts
+	TotalPrice money.Money
 }
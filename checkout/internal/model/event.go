@@ -0,0 +1,31 @@
+// Cart event models
+package model
+
+import "time"
+
+// Describe the type of a cart event
+type CartEventType string
+
+const (
+	CartItemAdded   CartEventType = "cart.item_added"
+	CartItemRemoved CartEventType = "cart.item_removed"
+	CartCleared     CartEventType = "cart.cleared"
+	CartPurchased   CartEventType = "cart.purchased"
+)
+
+// Describe a change of a cart for analytics, events are published through the outbox
+type CartEvent struct {
+	ID   int64
+	Type CartEventType
+	// Zero for guest carts
+	UserID UserID
+	CartID UserCartID
+	// Zero for events about the whole cart
+	SKU   uint32
+	Count uint32
+	// Order created by the purchase
+	OrderID OrderID
+	// Span context of the request that caused the event
+	TraceContext map[string]string
+	CreatedAt    time.Time
+}
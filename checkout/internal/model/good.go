@@ -1,6 +1,8 @@
 // Good models
 package model
 
+import "route256/libs/money"
+
 // Describe good sku
 type SKU uint32
 
@@ -9,5 +11,8 @@ type Good struct {
 	SKU   uint32
 	Count uint16
 	Name  string
-	Price uint32
+	// Price of one unit
+	Price money.Money
+	// Promotion discount for the whole line
+	Discount money.Money
 }
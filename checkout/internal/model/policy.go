@@ -0,0 +1,56 @@
+// Cart policy models
+package model
+
+import (
+	"route256/libs/apperr"
+	"route256/libs/money"
+	"time"
+)
+
+var (
+	ErrPurchaseCapReached = apperr.New(apperr.PolicyViolation, "purchase cap is reached")
+)
+
+// Describe limits on cart content, zero values mean no limit
+type CartPolicy struct {
+	// Maximum units of one item in the cart
+	MaxSKUQuantity uint16
+	// Maximum number of distinct items in the cart
+	MaxLines int
+	// Maximum cart value before discounts
+	MaxCartValue money.Money
+	// Limits on units a user can buy within a period
+	PurchaseCaps []PurchaseCap
+}
+
+// Describe a limit on units of an item a registered user can buy within a period
+type PurchaseCap struct {
+	// Item the cap is limited to, zero applies the cap to every item separately
+	SKU    uint32
+	Limit  uint64
+	Period time.Duration
+}
+
+// Describe a cap of an item of the order, LOMS counts the units bought since the moment
+// and the order together when the order is created
+type OrderCap struct {
+	SKU   uint32
+	Limit uint64
+	Since time.Time
+}
+
+// Check if the policy has no limits
+func (p CartPolicy) IsZero() bool {
+	return p.MaxSKUQuantity == 0 && p.MaxLines == 0 && p.MaxCartValue.IsZero() && len(p.PurchaseCaps) == 0
+}
+
+// Get the caps that apply to the item
+func (p CartPolicy) CapsFor(sku uint32) []PurchaseCap {
+	var result []PurchaseCap
+	for _, c := range p.PurchaseCaps {
+		if c.SKU == 0 || c.SKU == sku {
+			result = append(result, c)
+		}
+	}
+	return result
+}
@@ -1,8 +1,10 @@
 // Good info from loms service models
 package model
 
+import "route256/libs/money"
+
 // Product information
 type Product struct {
 	Name  string
-	Price uint32
+	Price money.Money
 }
@@ -0,0 +1,66 @@
+// Promotion models
+package model
+
+import (
+	"route256/libs/apperr"
+	"route256/libs/money"
+	"time"
+)
+
+var (
+	ErrPromotionNotFound   = apperr.New(apperr.NotFound, "promotion not found")
+	ErrPromotionUsageLimit = apperr.New(apperr.InvalidState, "promotion usage limit is reached")
+)
+
+// Describe promo code entered by a shopper
+type PromoCode string
+
+// Describe how a promotion calculates the discount
+type PromotionKind string
+
+const (
+	// Percent off the cart or off the lines of one SKU
+	PromotionPercent PromotionKind = "percent"
+	// Fixed amount off the cart or off the lines of one SKU
+	PromotionFixed PromotionKind = "fixed"
+	// Buy N units of one SKU and pay for M of them
+	PromotionNForM PromotionKind = "n_for_m"
+)
+
+// Describe promotion definition
+type Promotion struct {
+	ID   int64
+	Code PromoCode
+	Kind PromotionKind
+	// Percent for percent promotions, amount in minor units of the cart currency for fixed promotions
+	Value uint32
+	// Item the promotion is limited to, zero for the whole cart
+	SKU uint32
+	// Units to buy and units to pay for in N-for-M promotions
+	BuyCount uint16
+	PayCount uint16
+	// Minimal cart total before discounts in minor units of the cart currency
+	MinCartTotal uint32
+	// Number of orders a user can place with the promotion, zero for unlimited
+	UsageLimit uint32
+	// Validity window, zero time means no bound
+	ValidFrom time.Time
+	ValidTo   time.Time
+}
+
+// Check if the promotion is valid at the moment
+func (p Promotion) ActiveAt(t time.Time) bool {
+	if !p.ValidFrom.IsZero() && t.Before(p.ValidFrom) {
+		return false
+	}
+	if !p.ValidTo.IsZero() && t.After(p.ValidTo) {
+		return false
+	}
+	return true
+}
+
+// Describe promotion passed to LOMS with the order, empty code means no promotion
+type OrderPromotion struct {
+	Code     PromoCode
+	Discount money.Money
+}
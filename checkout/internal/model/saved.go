@@ -0,0 +1,18 @@
+// Saved for later models
+package model
+
+import "route256/libs/apperr"
+
+var (
+	ErrCartItemNotFound  = apperr.New(apperr.NotFound, "item is not in the cart")
+	ErrSavedItemNotFound = apperr.New(apperr.NotFound, "item is not saved for later")
+)
+
+// Describe an item saved for later with its current availability
+type SavedItem struct {
+	Good Good
+	// Units available in all warehouses
+	InStock uint64
+	// All saved units can be moved back to the cart
+	Available bool
+}
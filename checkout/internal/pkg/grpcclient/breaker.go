@@ -0,0 +1,144 @@
+// Circuit breaker for client calls
+package grpcclient
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Describe when the circuit breaker opens
+type BreakerPolicy struct {
+	// Consecutive failures that open the breaker, zero disables the breaker
+	FailureThreshold int
+	// Time the breaker stays open before a probe request is let through
+	OpenTimeout time.Duration
+}
+
+type breakerState int
+
+const (
+	breakerClosed breakerState = iota
+	breakerOpen
+	breakerHalfOpen
+)
+
+// Circuit breaker that fails fast while the service is down
+type Breaker struct {
+	mu       sync.Mutex
+	name     string
+	policy   BreakerPolicy
+	state    breakerState
+	failures int
+	openedAt time.Time
+	now      func() time.Time
+}
+
+// Create a new closed circuit breaker
+func NewBreaker(name string, policy BreakerPolicy) *Breaker {
+	return &Breaker{
+		name:   name,
+		policy: policy,
+		now:    time.Now,
+	}
+}
+
+// Check if a request can be sent: always when closed, never when open,
+// a single probe request when the open timeout has passed
+func (b *Breaker) Allow() bool {
+	if b.policy.FailureThreshold <= 0 {
+		return true
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	switch b.state {
+	case breakerOpen:
+		if b.now().Sub(b.openedAt) < b.policy.OpenTimeout {
+			return false
+		}
+		b.setState(breakerHalfOpen)
+		return true
+	case breakerHalfOpen:
+		// The probe request is in flight
+		return false
+	default:
+		return true
+	}
+}
+
+// Record the result of a request sent after Allow
+func (b *Breaker) Record(err error) {
+	if b.policy.FailureThreshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if !isServiceFailure(err) {
+		b.failures = 0
+		b.setState(breakerClosed)
+		return
+	}
+
+	b.failures++
+	if b.state == breakerHalfOpen || b.failures >= b.policy.FailureThreshold {
+		b.openedAt = b.now()
+		b.setState(breakerOpen)
+	}
+}
+
+// Fail fast with Unavailable while the breaker is open
+func (b *Breaker) Interceptor() grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if !b.Allow() {
+			return status.Errorf(codes.Unavailable, "circuit breaker of %s is open", b.name)
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		// A request cancelled by the caller says nothing about the service
+		if ctx.Err() != nil && err != nil {
+			b.release()
+			return err
+		}
+
+		b.Record(err)
+		return err
+	}
+}
+
+// Let the next probe through if the probe request was cancelled
+func (b *Breaker) release() {
+	if b.policy.FailureThreshold <= 0 {
+		return
+	}
+
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	if b.state == breakerHalfOpen {
+		b.setState(breakerOpen)
+	}
+}
+
+// Change the state and report it, must be called with the lock held
+func (b *Breaker) setState(state breakerState) {
+	b.state = state
+	breakerStateGauge.WithLabelValues(b.name).Set(float64(state))
+}
+
+// Check if the error means the service is unhealthy, errors about the request itself do not count
+func isServiceFailure(err error) bool {
+	switch status.Code(err) {
+	case codes.Unavailable, codes.DeadlineExceeded, codes.Internal, codes.Unknown:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,68 @@
+// Long-lived gRPC client connections with deadlines, retries and circuit breaking
+package grpcclient
+
+import (
+	"path"
+	"route256/libs/auth"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+// Describe the behaviour of a client connection, zero values disable a feature
+type Options struct {
+	// Deadline of every attempt if the method has no own timeout
+	Timeout time.Duration
+	// Deadlines of attempts by method name, e.g. "CreateOrder"
+	MethodTimeouts map[string]time.Duration
+	// Methods that are safe to retry, by method name
+	IdempotentMethods []string
+	Retry             RetryPolicy
+	Breaker           BreakerPolicy
+	// Bearer token sent with every call
+	Token string
+	// Send the token over a plaintext connection, by default it requires TLS
+	InsecureToken bool
+	// Transport security of the connection, nil connects in plaintext
+	Credentials credentials.TransportCredentials
+}
+
+// Create a connection to the service, the connection is established lazily
+// and is meant to be shared by all requests until Close.
+// Interceptors are applied from the outside in: tracing and metrics of the whole call,
+// circuit breaker, retries, deadline of each attempt
+func Dial(name string, target string, opts Options) (*grpc.ClientConn, error) {
+	creds := opts.Credentials
+	if creds == nil {
+		creds = insecure.NewCredentials()
+	}
+
+	dialOpts := []grpc.DialOption{
+		grpc.WithTransportCredentials(creds),
+		grpc.WithChainUnaryInterceptor(
+			TracingInterceptor(name),
+			MetricsInterceptor(name),
+			NewBreaker(name, opts.Breaker).Interceptor(),
+			RetryInterceptor(name, opts.Retry, opts.IdempotentMethods),
+			DeadlineInterceptor(opts.Timeout, opts.MethodTimeouts),
+		),
+	}
+	if opts.Token != "" {
+		dialOpts = append(dialOpts, grpc.WithPerRPCCredentials(auth.TokenCredentials{Token: opts.Token, AllowInsecure: opts.InsecureToken}))
+	}
+
+	conn, err := grpc.Dial(target, dialOpts...)
+	if err != nil {
+		return nil, errors.Wrapf(err, "dial %s", name)
+	}
+
+	return conn, nil
+}
+
+// Get the method name from the full method, e.g. "Stocks" from "/loms.Loms/Stocks"
+func methodName(fullMethod string) string {
+	return path.Base(fullMethod)
+}
@@ -0,0 +1,27 @@
+// Deadlines of client calls
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	"google.golang.org/grpc"
+)
+
+// Set the deadline of the method on every call, a deadline of the caller that is sooner is kept
+func DeadlineInterceptor(timeout time.Duration, methodTimeouts map[string]time.Duration) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		methodTimeout := timeout
+		if t, ok := methodTimeouts[methodName(method)]; ok {
+			methodTimeout = t
+		}
+		if methodTimeout <= 0 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		ctx, cancel := context.WithTimeout(ctx, methodTimeout)
+		defer cancel()
+
+		return invoker(ctx, method, req, reply, cc, opts...)
+	}
+}
@@ -0,0 +1,223 @@
+// gRPC client interceptor tests
+package grpcclient
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Invoker that returns the given errors one by one and counts calls
+type fakeInvoker struct {
+	errs  []error
+	calls int
+}
+
+func (f *fakeInvoker) invoke(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+	f.calls++
+	if len(f.errs) == 0 {
+		return nil
+	}
+	err := f.errs[0]
+	f.errs = f.errs[1:]
+	return err
+}
+
+var testRetryPolicy = RetryPolicy{MaxAttempts: 3, InitialBackoff: time.Millisecond, MaxBackoff: time.Millisecond}
+
+func Test_RetryInterceptor(t *testing.T) {
+	t.Parallel()
+
+	t.Run("retries transient errors of idempotent methods", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		invoker := &fakeInvoker{errs: []error{status.Error(codes.Unavailable, "down"), status.Error(codes.Unavailable, "down")}}
+		interceptor := RetryInterceptor("test", testRetryPolicy, []string{"Stocks"})
+
+		// Act
+		err := interceptor(context.Background(), "/loms.Loms/Stocks", nil, nil, nil, invoker.invoke)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, 3, invoker.calls)
+	})
+
+	t.Run("gives up after max attempts", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		invoker := &fakeInvoker{errs: []error{
+			status.Error(codes.Unavailable, "down"),
+			status.Error(codes.Unavailable, "down"),
+			status.Error(codes.Unavailable, "down"),
+			nil,
+		}}
+		interceptor := RetryInterceptor("test", testRetryPolicy, []string{"Stocks"})
+
+		// Act
+		err := interceptor(context.Background(), "/loms.Loms/Stocks", nil, nil, nil, invoker.invoke)
+
+		// Assert
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, 3, invoker.calls)
+	})
+
+	t.Run("does not retry other methods", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		invoker := &fakeInvoker{errs: []error{status.Error(codes.Unavailable, "down")}}
+		interceptor := RetryInterceptor("test", testRetryPolicy, []string{"Stocks"})
+
+		// Act
+		err := interceptor(context.Background(), "/loms.Loms/CreateOrder", nil, nil, nil, invoker.invoke)
+
+		// Assert
+		require.Error(t, err)
+		require.Equal(t, 1, invoker.calls)
+	})
+
+	t.Run("does not retry request errors", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		invoker := &fakeInvoker{errs: []error{status.Error(codes.InvalidArgument, "bad")}}
+		interceptor := RetryInterceptor("test", testRetryPolicy, []string{"Stocks"})
+
+		// Act
+		err := interceptor(context.Background(), "/loms.Loms/Stocks", nil, nil, nil, invoker.invoke)
+
+		// Assert
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+		require.Equal(t, 1, invoker.calls)
+	})
+}
+
+func Test_RetryPolicyBackoff(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	policy := RetryPolicy{MaxAttempts: 10, InitialBackoff: 10 * time.Millisecond, MaxBackoff: 50 * time.Millisecond}
+
+	for attempt := 1; attempt < 100; attempt++ {
+		// Act
+		backoff := policy.backoff(attempt)
+
+		// Assert
+		require.GreaterOrEqual(t, backoff, time.Duration(0))
+		require.Less(t, backoff, policy.MaxBackoff)
+	}
+}
+
+func Test_Breaker(t *testing.T) {
+	t.Parallel()
+
+	newTestBreaker := func() (*Breaker, *time.Time) {
+		now := time.Unix(0, 0)
+		b := NewBreaker("test", BreakerPolicy{FailureThreshold: 2, OpenTimeout: time.Second})
+		b.now = func() time.Time { return now }
+		return b, &now
+	}
+	unavailable := status.Error(codes.Unavailable, "down")
+
+	t.Run("opens after consecutive failures", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		b, _ := newTestBreaker()
+
+		// Act
+		b.Record(unavailable)
+		b.Record(unavailable)
+
+		// Assert
+		require.False(t, b.Allow())
+	})
+
+	t.Run("request errors do not open", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		b, _ := newTestBreaker()
+
+		// Act
+		b.Record(unavailable)
+		b.Record(status.Error(codes.NotFound, "no"))
+		b.Record(unavailable)
+
+		// Assert
+		require.True(t, b.Allow())
+	})
+
+	t.Run("probe closes after open timeout", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		b, now := newTestBreaker()
+		b.Record(unavailable)
+		b.Record(unavailable)
+		*now = now.Add(time.Second)
+
+		// Act
+		probe := b.Allow()
+		concurrent := b.Allow()
+		b.Record(nil)
+
+		// Assert
+		require.True(t, probe)
+		require.False(t, concurrent)
+		require.True(t, b.Allow())
+	})
+
+	t.Run("failed probe opens again", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		b, now := newTestBreaker()
+		b.Record(unavailable)
+		b.Record(unavailable)
+		*now = now.Add(time.Second)
+		require.True(t, b.Allow())
+
+		// Act
+		b.Record(unavailable)
+
+		// Assert
+		require.False(t, b.Allow())
+	})
+
+	t.Run("interceptor fails fast when open", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		b, _ := newTestBreaker()
+		invoker := &fakeInvoker{errs: []error{unavailable, unavailable}}
+		interceptor := b.Interceptor()
+		for i := 0; i < 2; i++ {
+			_ = interceptor(context.Background(), "/loms.Loms/Stocks", nil, nil, nil, invoker.invoke)
+		}
+
+		// Act
+		err := interceptor(context.Background(), "/loms.Loms/Stocks", nil, nil, nil, invoker.invoke)
+
+		// Assert
+		require.Equal(t, codes.Unavailable, status.Code(err))
+		require.Equal(t, 2, invoker.calls)
+	})
+}
+
+func Test_DeadlineInterceptor(t *testing.T) {
+	t.Parallel()
+
+	// Arrange
+	interceptor := DeadlineInterceptor(time.Second, map[string]time.Duration{"CreateOrder": time.Minute})
+	var deadline time.Time
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, opts ...grpc.CallOption) error {
+		deadline, _ = ctx.Deadline()
+		return nil
+	}
+
+	// Act
+	err := interceptor(context.Background(), "/loms.Loms/CreateOrder", nil, nil, nil, invoker)
+
+	// Assert
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Minute), deadline, time.Second)
+}
@@ -0,0 +1,49 @@
+// Client tracing and metrics
+package grpcclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/opentracing/opentracing-go/ext"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+// Start a client span for every call and pass its context to the service in metadata
+func TracingInterceptor(name string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		span, ctx := opentracing.StartSpanFromContext(ctx, "grpc_client/"+name+method)
+		defer span.Finish()
+		ext.SpanKindRPCClient.Set(span)
+
+		carrier := opentracing.TextMapCarrier{}
+		if err := opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, carrier); err == nil {
+			for key, value := range carrier {
+				ctx = metadata.AppendToOutgoingContext(ctx, key, value)
+			}
+		}
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+		if err != nil {
+			ext.Error.Set(span, true)
+			span.LogKV("error", err.Error())
+		}
+
+		return err
+	}
+}
+
+// Measure duration and result of every call
+func MetricsInterceptor(name string) grpc.UnaryClientInterceptor {
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		start := time.Now()
+
+		err := invoker(ctx, method, req, reply, cc, opts...)
+
+		requestDuration.WithLabelValues(name, methodName(method), status.Code(err).String()).Observe(time.Since(start).Seconds())
+		return err
+	}
+}
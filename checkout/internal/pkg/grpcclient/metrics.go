@@ -0,0 +1,38 @@
+// gRPC client metrics
+package grpcclient
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	requestDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "checkout",
+			Subsystem: "grpc_client",
+			Name:      "request_duration_seconds",
+			Help:      "Duration of calls to other services including retries",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"client", "method", "code"},
+	)
+	retries = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "checkout",
+			Subsystem: "grpc_client",
+			Name:      "retries_total",
+			Help:      "Number of retried attempts",
+		},
+		[]string{"client", "method"},
+	)
+	breakerStateGauge = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "checkout",
+			Subsystem: "grpc_client",
+			Name:      "breaker_state",
+			Help:      "State of the circuit breaker: 0 closed, 1 open, 2 half-open",
+		},
+		[]string{"client"},
+	)
+)
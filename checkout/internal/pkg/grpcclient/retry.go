@@ -0,0 +1,84 @@
+// Retries of idempotent client calls
+package grpcclient
+
+import (
+	"context"
+	"math/rand"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Describe retries of idempotent methods
+type RetryPolicy struct {
+	// Attempts including the first one, less than two disables retries
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+}
+
+// Retry idempotent methods with exponential backoff and full jitter
+// while the error is transient and the caller is still waiting
+func RetryInterceptor(name string, policy RetryPolicy, idempotentMethods []string) grpc.UnaryClientInterceptor {
+	idempotent := make(map[string]struct{}, len(idempotentMethods))
+	for _, method := range idempotentMethods {
+		idempotent[method] = struct{}{}
+	}
+
+	return func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn, invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+		if _, ok := idempotent[methodName(method)]; !ok || policy.MaxAttempts < 2 {
+			return invoker(ctx, method, req, reply, cc, opts...)
+		}
+
+		var err error
+		for attempt := 0; attempt < policy.MaxAttempts; attempt++ {
+			if attempt > 0 {
+				retries.WithLabelValues(name, methodName(method)).Inc()
+
+				timer := time.NewTimer(policy.backoff(attempt))
+				select {
+				case <-ctx.Done():
+					timer.Stop()
+					return err
+				case <-timer.C:
+				}
+			}
+
+			err = invoker(ctx, method, req, reply, cc, opts...)
+			if err == nil || !isRetryable(ctx, err) {
+				return err
+			}
+		}
+
+		return err
+	}
+}
+
+// Get a random delay before the attempt, the upper bound doubles with every attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	limit := p.InitialBackoff << (attempt - 1)
+	if limit <= 0 || (p.MaxBackoff > 0 && limit > p.MaxBackoff) {
+		limit = p.MaxBackoff
+	}
+	if limit <= 0 {
+		return 0
+	}
+
+	return time.Duration(rand.Int63n(int64(limit)))
+}
+
+// Check if the error is transient, a deadline of an attempt is retried only if the caller has time left
+func isRetryable(ctx context.Context, err error) bool {
+	if ctx.Err() != nil {
+		return false
+	}
+
+	switch status.Code(err) {
+	case codes.Unavailable, codes.ResourceExhausted, codes.Aborted, codes.DeadlineExceeded:
+		return true
+	default:
+		return false
+	}
+}
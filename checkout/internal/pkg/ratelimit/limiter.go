@@ -3,60 +3,172 @@ package ratelimit
 
 import (
 	"context"
+	"sync"
 	"time"
 
-	"golang.org/x/sync/semaphore"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// Define custom rate limiter
-type RateLimiter struct {
-	queue          chan struct{}
-	maxConcurrency uint16
-	rps uint16
-	sem            *semaphore.Weighted
+const (
+	// The rate is never reduced below this share of the configured limit
+	minRateFactor = 0.1
+	// Share of the configured limit restored after each successful request
+	recoveryFactor = 0.05
+)
+
+// Token bucket rate limiter with adaptive backoff
+type Limiter struct {
+	mu       sync.Mutex
+	name     string
+	baseRate float64
+	rate     float64
+	burst    float64
+	tokens   float64
+	last     time.Time
+	now      func() time.Time
 }
 
 // Create a new rate limiter instance
-// Takes two parameters as input:
-// 		rps - limit on the number of requests per second
-// 		maxConcurrency - limit on the number of simultaneous queries
-func New(rps uint16, maxConcurrency uint16) *RateLimiter {
-	sem := semaphore.NewWeighted(int64(maxConcurrency))
+// Takes three parameters as input:
+//
+//	name - limiter name used as a metrics label
+//	rps - limit on the number of requests per second
+//	burst - number of requests that can be made at once
+func New(name string, rps float64, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
 
-	r := &RateLimiter{
-		queue: make(chan struct{}, rps),
-		sem: sem,
-		rps: rps,
-		maxConcurrency: maxConcurrency,
+	l := &Limiter{
+		name:     name,
+		baseRate: rps,
+		rate:     rps,
+		burst:    float64(burst),
+		tokens:   float64(burst),
+		now:      time.Now,
 	}
+	l.last = l.now()
 
-	go r.emitter()
+	return l
+}
+
+// Take a token if it is available right now
+func (l *Limiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.now())
+	if l.tokens < 1 {
+		rejected.WithLabelValues(l.name).Inc()
+		return false
+	}
 
-	return r
+	l.tokens--
+	return true
 }
 
-// Keeps the number of requests per second and frees up resources
-func (r *RateLimiter) emitter() {
-	for range r.queue {
-		time.Sleep(time.Second / time.Duration(r.rps))
-		r.sem.Release(1)
+// Wait until a token is available or the context is done
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		rejected.WithLabelValues(l.name).Inc()
+		return err
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	l.advance(now)
+	// Reserve a token in advance, the bucket goes into debt if it is empty
+	l.tokens--
+	delay := l.delay()
+	l.mu.Unlock()
+
+	waitTime.WithLabelValues(l.name).Set(delay.Seconds())
+	if delay == 0 {
+		return nil
+	}
+
+	queueSize.WithLabelValues(l.name).Inc()
+	defer queueSize.WithLabelValues(l.name).Dec()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Return the reserved token so that other requests do not wait for it
+		l.mu.Lock()
+		l.advance(l.now())
+		l.tokens++
+		l.mu.Unlock()
+
+		rejected.WithLabelValues(l.name).Inc()
+		return ctx.Err()
 	}
 }
 
-// Allows the request or waits for permission
-func (r *RateLimiter) Acquire(ctx context.Context) error {
-	return r.sem.Acquire(ctx, 1)
+// Adjust the rate according to the upstream response:
+// halve it when the upstream returns ResourceExhausted
+// and slowly restore it after successful requests
+func (l *Limiter) Observe(err error) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.now())
+	switch {
+	case status.Code(err) == codes.ResourceExhausted:
+		l.rate /= 2
+		if min := l.baseRate * minRateFactor; l.rate < min {
+			l.rate = min
+		}
+	case err == nil && l.rate < l.baseRate:
+		l.rate += l.baseRate * recoveryFactor
+		if l.rate > l.baseRate {
+			l.rate = l.baseRate
+		}
+	}
+	currentRate.WithLabelValues(l.name).Set(l.rate)
 }
 
-// Notifies you that the request has ended
-func (r *RateLimiter) Release() {
-	r.queue <- struct{}{}
+// Return the current number of requests per second
+func (l *Limiter) Rate() float64 {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return l.rate
+}
+
+// Report whether the bucket is full and its rate is not reduced,
+// such a bucket behaves the same as a new one and can be dropped
+func (l *Limiter) idle() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.now())
+	return l.tokens >= l.burst && l.rate >= l.baseRate
 }
 
-// Frees up resources
-func (r *RateLimiter) Close() {
-	for i := 0; i < int(r.maxConcurrency); i++ {
-		r.Acquire(context.Background())
+// Refill the bucket with tokens accumulated since the last call
+func (l *Limiter) advance(now time.Time) {
+	elapsed := now.Sub(l.last)
+	if elapsed <= 0 {
+		return
 	}
-	close(r.queue)
+	l.last = now
+
+	l.tokens += elapsed.Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// Calculate how long to wait until the bucket is out of debt
+func (l *Limiter) delay() time.Duration {
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
 }
@@ -3,90 +3,203 @@ package ratelimit
 
 import (
 	"context"
-	"fmt"
 	"sync"
 	"testing"
 	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
-// Test if the number of requests per second limit is correctly enforced
-func TestLimiter_RPSCondition(t * testing.T) {
-	rps := 10
-	maxConcurrency := 5
-	longRequestCount := 10
-	shortRequestCount := 15
-	dateFormat := "15:04:05.9999"
+// Manually driven clock for deterministic tests
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
 
-	testChan := make(chan time.Time, longRequestCount + shortRequestCount)
+func (c *fakeClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
 
-	ctx := context.Background()
+func newTestLimiter(rps float64, burst int) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := New("test", rps, burst)
+	l.now = clock.Now
+	l.last = clock.Now()
+	return l, clock
+}
 
-	limiter := New(uint16(rps), uint16(maxConcurrency))
-	var wg sync.WaitGroup
-	wg.Add(longRequestCount + shortRequestCount)
+// Test that the burst is available at once and then requests are rejected
+func TestLimiter_Allow_Burst(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	burst := 5
+	limiter, _ := newTestLimiter(10, burst)
 
-	for i := 1; i <= longRequestCount; i++ {
-		go func(reqNum int) {
-			defer wg.Done()
-			
-			err := limiter.Acquire(ctx)
-			defer limiter.Release()
-			if err != nil {
-				t.Errorf("wait: %v", err)
-				return
-			}
-
-			startTime := time.Now()
-			testChan <- startTime
-			// Simulate a long-running operation
-			time.Sleep(2 * time.Second)
-			fmt.Printf("Long request %d completed, start: %v, end: %v\n", reqNum, startTime.Format(dateFormat), time.Now().Format(dateFormat))
-
-		}(i)
+	// Act
+	for i := 0; i < burst; i++ {
+		require.True(t, limiter.Allow(), "request %d", i)
 	}
 
-	for i := 1; i <= shortRequestCount; i++ {
-		go func(reqNum int) {
+	// Assert
+	require.False(t, limiter.Allow())
+}
+
+// Test that tokens are refilled according to the rate and never exceed the burst
+func TestLimiter_Allow_Refill(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limiter, clock := newTestLimiter(10, 2)
+	require.True(t, limiter.Allow())
+	require.True(t, limiter.Allow())
+	require.False(t, limiter.Allow())
+
+	// Act
+	clock.Add(100 * time.Millisecond)
+
+	// Assert
+	require.True(t, limiter.Allow())
+	require.False(t, limiter.Allow())
+
+	clock.Add(time.Hour)
+	require.True(t, limiter.Allow())
+	require.True(t, limiter.Allow())
+	require.False(t, limiter.Allow())
+}
+
+// Test if the number of requests per second limit is correctly enforced
+func TestLimiter_Wait_RPSCondition(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	rps := 100
+	burst := 10
+	requestCount := 60
+	limiter := New("test", float64(rps), burst)
+
+	var wg sync.WaitGroup
+	wg.Add(requestCount)
+	start := time.Now()
+
+	// Act
+	for i := 0; i < requestCount; i++ {
+		go func() {
 			defer wg.Done()
-			
-			err := limiter.Acquire(ctx)
-			defer limiter.Release()
-			if err != nil {
-				t.Errorf("wait: %v", err)
-				return
-			}
-
-			startTime := time.Now()
-			testChan <- startTime
-
-			// Simulate a short-running operation
-			time.Sleep(150 * time.Millisecond)
-			fmt.Printf("Short request %d completed, start: %v, end: %v\n", reqNum, startTime.Format(dateFormat), time.Now().Format(dateFormat))
-
-		}(i)
+			require.NoError(t, limiter.Wait(context.Background()))
+		}()
 	}
-
 	wg.Wait()
-	limiter.Close()
-	close(testChan)
-	timeArr := make([]time.Time, 0, longRequestCount + shortRequestCount)
 
-	for d := range testChan {
-		timeArr = append(timeArr, d)
+	// Assert
+	minDuration := time.Duration(requestCount-burst) * time.Second / time.Duration(rps)
+	require.GreaterOrEqual(t, time.Since(start), minDuration-10*time.Millisecond)
+}
+
+// Test that a cancelled wait returns the context error and gives the token back
+func TestLimiter_Wait_ContextCancelled(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limiter, clock := newTestLimiter(1, 1)
+	require.True(t, limiter.Allow())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// Act
+	err := limiter.Wait(ctx)
+
+	// Assert
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	clock.Add(time.Second)
+	require.True(t, limiter.Allow())
+}
+
+// Test that a wait with a done context fails even when a token is available
+func TestLimiter_Wait_ContextAlreadyCancelled(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limiter, _ := newTestLimiter(1, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	err := limiter.Wait(ctx)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, limiter.Allow())
+}
+
+// Test that ResourceExhausted halves the rate and successes restore it
+func TestLimiter_Observe_AdaptiveBackoff(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	rps := 100.0
+	limiter, _ := newTestLimiter(rps, 1)
+
+	// Act
+	limiter.Observe(status.Error(codes.ResourceExhausted, "slow down"))
+
+	// Assert
+	require.Equal(t, rps/2, limiter.Rate())
+
+	for i := 0; i < 20; i++ {
+		limiter.Observe(status.Error(codes.ResourceExhausted, "slow down"))
 	}
+	require.Equal(t, rps*minRateFactor, limiter.Rate())
 
-	for i := 0; i < longRequestCount + shortRequestCount; i++ {
-		fmt.Println(timeArr[i].Format(dateFormat))
+	limiter.Observe(status.Error(codes.Internal, "other error"))
+	require.Equal(t, rps*minRateFactor, limiter.Rate())
+
+	for i := 0; i < 100; i++ {
+		limiter.Observe(nil)
 	}
+	require.Equal(t, rps, limiter.Rate())
+}
+
+// Test that every key has its own bucket
+func TestKeyedLimiter_Allow_SeparateBuckets(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limiter := NewKeyed("test", 1, 1)
+
+	// Act
+	first := limiter.Allow("first")
+
+	// Assert
+	require.True(t, first)
+	require.False(t, limiter.Allow("first"))
+	require.True(t, limiter.Allow("second"))
+	require.Same(t, limiter.Get("first"), limiter.Get("first"))
+}
 
-	for i := 0; i < longRequestCount + shortRequestCount - rps; i += 1 {
-		for j := i + 1; j < longRequestCount + shortRequestCount; j++ {
-			if timeArr[j].Sub(timeArr[i]) > time.Second {
-				if j - i > rps {
-					t.Errorf("More than %v requests per second: first: %v, last: %v", rps, timeArr[i], timeArr[i + rps])
-				}
-				break
-			}
-		}
-    }
+// Test that refilled buckets are dropped and buckets still in use are kept
+func TestKeyedLimiter_Get_IdleBucketsEvicted(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	limiter := NewKeyed("test", 0.5, 1)
+	limiter.now = clock.Now
+	limiter.lastSweep = clock.Now()
+	require.True(t, limiter.Allow("idle"))
+	clock.Add(sweepInterval - time.Second)
+	require.True(t, limiter.Allow("busy"))
+	limiter.Observe("slow", status.Error(codes.ResourceExhausted, "slow down"))
+
+	// Act
+	clock.Add(time.Second)
+	limiter.Get("other")
+
+	// Assert
+	require.Equal(t, 3, limiter.Len())
+	require.False(t, limiter.Allow("busy"))
+	require.Less(t, limiter.Get("slow").Rate(), 0.5)
 }
@@ -0,0 +1,23 @@
+package tracer
+
+import (
+	"context"
+
+	"github.com/opentracing/opentracing-go"
+)
+
+// Serialize the span context of ctx to pass it with a message, nil without a span
+func InjectTextMap(ctx context.Context) map[string]string {
+	span := opentracing.SpanFromContext(ctx)
+	if span == nil {
+		return nil
+	}
+
+	carrier := opentracing.TextMapCarrier{}
+	err := opentracing.GlobalTracer().Inject(span.Context(), opentracing.TextMap, carrier)
+	if err != nil || len(carrier) == 0 {
+		return nil
+	}
+
+	return carrier
+}
@@ -0,0 +1,28 @@
+// Worker pool metrics
+package workerpool
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	queueDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "checkout",
+			Subsystem: "workerpool",
+			Name:      "queue_depth",
+			Help:      "Number of tasks waiting for a free worker",
+		},
+	)
+	taskDuration = promauto.NewHistogramVec(
+		prometheus.HistogramOpts{
+			Namespace: "checkout",
+			Subsystem: "workerpool",
+			Name:      "task_duration_seconds",
+			Help:      "Task execution time",
+			Buckets:   prometheus.DefBuckets,
+		},
+		[]string{"result"},
+	)
+)
@@ -3,30 +3,61 @@ package workerpool
 
 import (
 	"context"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrPoolClosed = errors.New("worker pool is closed")
 )
 
+// Describe an optional worker pool setting
+type Option func(*options)
+
+type options struct {
+	taskTimeout time.Duration
+}
+
+// Limit the execution time of every task
+func WithTaskTimeout(timeout time.Duration) Option {
+	return func(o *options) {
+		o.taskTimeout = timeout
+	}
+}
+
 // Implement worker pool
 type Pool[I, O any] struct {
-	ctx   context.Context
-	limit int
-	tasks chan *Task[I, O]
+	ctx       context.Context
+	limit     int
+	opts      options
+	tasks     chan *Task[I, O]
+	done      chan struct{}
+	closeOnce sync.Once
+	workers   sync.WaitGroup
 }
 
-// Create a new worker pool
-func NewPool[I, O any](ctx context.Context, limit int) *Pool[I, O] {
+// Create a new worker pool,
+// workers stop when the context is done or the pool is closed
+func NewPool[I, O any](ctx context.Context, limit int, opts ...Option) *Pool[I, O] {
+	if limit < 1 {
+		limit = 1
+	}
+
 	p := &Pool[I, O]{
 		ctx:   ctx,
 		limit: limit,
-		tasks: make(chan *Task[I, O], limit),
+		tasks: make(chan *Task[I, O]),
+		done:  make(chan struct{}),
+	}
+	for _, opt := range opts {
+		opt(&p.opts)
 	}
 
-	go func() {
-		<-ctx.Done()
-		close(p.tasks)
-	}()
-
+	p.workers.Add(p.limit)
 	for i := 0; i < p.limit; i++ {
-		worker := NewWorker(p.tasks, i)
+		worker := NewWorker(p, i)
 		worker.Start()
 	}
 
@@ -36,11 +67,51 @@ func NewPool[I, O any](ctx context.Context, limit int) *Pool[I, O] {
 // Send a task to the worker pool tasks channel and execute it
 func (p *Pool[I, O]) Exec(data I, step func(ctx context.Context, i I) (O, error)) Promise[O] {
 	result := Promise[O]{Out: make(chan Either[O], 1)}
+
+	queueDepth.Inc()
+	defer queueDepth.Dec()
+
+	// The tasks channel is unbuffered, so a task is either taken by a worker
+	// or rejected here, and the promise is always resolved
 	select {
 	case <-p.ctx.Done():
 		result.Out <- Either[O]{Err: p.ctx.Err()}
+	case <-p.done:
+		result.Out <- Either[O]{Err: ErrPoolClosed}
 	case p.tasks <- NewTask[I, O](p.ctx, data, step, result):
 	}
 
 	return result
 }
+
+// Stop accepting new tasks and wait for running tasks to finish
+func (p *Pool[I, O]) Close() {
+	p.closeOnce.Do(func() {
+		close(p.done)
+	})
+	p.Wait()
+}
+
+// Wait until all workers have stopped
+func (p *Pool[I, O]) Wait() {
+	p.workers.Wait()
+}
+
+// Run step for every input with at most limit concurrent calls
+// and return the results in the order of the inputs
+func Map[I, O any](ctx context.Context, limit int, inputs []I, step func(ctx context.Context, i I) (O, error), opts ...Option) []Either[O] {
+	pool := NewPool[I, O](ctx, limit, opts...)
+	defer pool.Close()
+
+	promises := make([]Promise[O], len(inputs))
+	for i, input := range inputs {
+		promises[i] = pool.Exec(input, step)
+	}
+
+	results := make([]Either[O], len(inputs))
+	for i, promise := range promises {
+		results[i] = <-promise.Out
+	}
+
+	return results
+}
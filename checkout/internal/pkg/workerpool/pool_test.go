@@ -0,0 +1,243 @@
+// Worker pool tests
+package workerpool
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/goleak"
+)
+
+// Fail the suite if any test leaves goroutines behind
+func TestMain(m *testing.M) {
+	goleak.VerifyTestMain(m)
+}
+
+func double(_ context.Context, i int) (int, error) {
+	return i * 2, nil
+}
+
+// Test that a task is executed and its result is returned
+func TestPool_Exec_OK(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	pool := NewPool[int, int](context.Background(), 2)
+	defer pool.Close()
+
+	// Act
+	result := <-pool.Exec(21, double).Out
+
+	// Assert
+	require.NoError(t, result.Err)
+	require.Equal(t, 42, *result.Value)
+}
+
+// Test that a panicking task does not kill the process and returns an error
+func TestPool_Exec_PanicRecovered(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	pool := NewPool[int, int](context.Background(), 1)
+	defer pool.Close()
+
+	// Act
+	result := <-pool.Exec(1, func(context.Context, int) (int, error) {
+		panic("boom")
+	}).Out
+
+	// Assert
+	require.ErrorIs(t, result.Err, ErrTaskPanic)
+	require.Contains(t, result.Err.Error(), "boom")
+	require.Nil(t, result.Value)
+
+	// The worker is still alive after the panic
+	next := <-pool.Exec(1, double).Out
+	require.NoError(t, next.Err)
+	require.Equal(t, 2, *next.Value)
+}
+
+// Test that a task is cancelled when it runs longer than the timeout
+func TestPool_Exec_TaskTimeout(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	pool := NewPool[int, int](context.Background(), 1, WithTaskTimeout(10*time.Millisecond))
+	defer pool.Close()
+
+	// Act
+	result := <-pool.Exec(1, func(ctx context.Context, i int) (int, error) {
+		<-ctx.Done()
+		return 0, ctx.Err()
+	}).Out
+
+	// Assert
+	require.ErrorIs(t, result.Err, context.DeadlineExceeded)
+}
+
+// Test that tasks are rejected after the pool is closed
+func TestPool_Exec_AfterClose(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	pool := NewPool[int, int](context.Background(), 2)
+	pool.Close()
+
+	// Act
+	result := <-pool.Exec(1, double).Out
+
+	// Assert
+	require.ErrorIs(t, result.Err, ErrPoolClosed)
+}
+
+// Test that tasks are rejected after the pool context is cancelled
+func TestPool_Exec_ContextCancelled(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	pool := NewPool[int, int](ctx, 2)
+	cancel()
+	pool.Wait()
+
+	// Act
+	result := <-pool.Exec(1, double).Out
+
+	// Assert
+	require.ErrorIs(t, result.Err, context.Canceled)
+}
+
+// Test that Close waits for running tasks to finish
+func TestPool_Close_WaitsRunningTasks(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	pool := NewPool[int, int](context.Background(), 3)
+	var finished atomic.Int32
+	started := make(chan struct{}, 3)
+
+	promises := make([]Promise[int], 3)
+	for i := range promises {
+		promises[i] = pool.Exec(i, func(_ context.Context, i int) (int, error) {
+			started <- struct{}{}
+			time.Sleep(20 * time.Millisecond)
+			finished.Add(1)
+			return i, nil
+		})
+	}
+	for range promises {
+		<-started
+	}
+
+	// Act
+	pool.Close()
+
+	// Assert
+	require.Equal(t, int32(3), finished.Load())
+	for _, p := range promises {
+		require.NoError(t, (<-p.Out).Err)
+	}
+}
+
+// Test that no more than limit tasks run at the same time
+func TestPool_Exec_ConcurrencyLimit(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limit := 3
+	taskCount := 20
+	pool := NewPool[int, int](context.Background(), limit)
+	defer pool.Close()
+
+	var running, maxRunning atomic.Int32
+	step := func(_ context.Context, i int) (int, error) {
+		current := running.Add(1)
+		defer running.Add(-1)
+		for {
+			prev := maxRunning.Load()
+			if current <= prev || maxRunning.CompareAndSwap(prev, current) {
+				break
+			}
+		}
+		time.Sleep(5 * time.Millisecond)
+		return i, nil
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	wg.Add(taskCount)
+	for i := 0; i < taskCount; i++ {
+		go func(i int) {
+			defer wg.Done()
+			<-pool.Exec(i, step).Out
+		}(i)
+	}
+	wg.Wait()
+
+	// Assert
+	require.LessOrEqual(t, maxRunning.Load(), int32(limit))
+}
+
+// Test that Map returns results in the order of the inputs
+func TestMap_OrderedResults(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	inputs := make([]int, 50)
+	for i := range inputs {
+		inputs[i] = i
+	}
+	errOdd := errors.New("odd")
+
+	// Act
+	results := Map(context.Background(), 4, inputs, func(_ context.Context, i int) (string, error) {
+		// Finish later inputs first to shuffle completion order
+		time.Sleep(time.Duration(len(inputs)-i) * 100 * time.Microsecond)
+		if i%2 == 1 {
+			return "", errOdd
+		}
+		return fmt.Sprint(i), nil
+	})
+
+	// Assert
+	require.Len(t, results, len(inputs))
+	for i, result := range results {
+		if i%2 == 1 {
+			require.ErrorIs(t, result.Err, errOdd)
+			continue
+		}
+		require.NoError(t, result.Err)
+		require.Equal(t, fmt.Sprint(i), *result.Value)
+	}
+}
+
+// Test that Map resolves every input when the context is cancelled
+func TestMap_ContextCancelled(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	inputs := []int{1, 2, 3, 4, 5}
+	var calls atomic.Int32
+
+	// Act
+	results := Map(ctx, 1, inputs, func(ctx context.Context, i int) (int, error) {
+		if calls.Add(1) == 2 {
+			cancel()
+		}
+		return i, ctx.Err()
+	})
+
+	// Assert
+	require.Len(t, results, len(inputs))
+	require.NoError(t, results[0].Err)
+	require.ErrorIs(t, results[len(results)-1].Err, context.Canceled)
+}
+
+// Test that Map with no inputs returns an empty result
+func TestMap_Empty(t *testing.T) {
+	t.Parallel()
+
+	// Act
+	results := Map(context.Background(), 2, []int{}, double)
+
+	// Assert
+	require.Empty(t, results)
+}
@@ -3,7 +3,14 @@ package workerpool
 
 import (
 	"context"
-	"log"
+	"fmt"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrTaskPanic = errors.New("task panicked")
 )
 
 // Describe the result of a task or an error
@@ -37,11 +44,50 @@ func NewTask[I, O any](ctx context.Context, data I, f func(ctx context.Context,
 }
 
 // Run task and send the result to the task result channel
-func process[I, O any](workerID int, task *Task[I, O]) {
-	log.Printf("Worker %d processes task", workerID)
-	v, err := task.step(task.ctx, task.data)
-	task.result.Out <- Either[O]{
+func process[I, O any](task *Task[I, O], timeout time.Duration) {
+	start := time.Now()
+	result := run(task, timeout)
+	taskDuration.WithLabelValues(resultLabel(result.Err)).Observe(time.Since(start).Seconds())
+
+	task.result.Out <- result
+}
+
+// Run task step, limit its execution time and recover from its panic
+func run[I, O any](task *Task[I, O], timeout time.Duration) (result Either[O]) {
+	ctx := task.ctx
+	if timeout > 0 {
+		var cancel context.CancelFunc
+		ctx, cancel = context.WithTimeout(ctx, timeout)
+		defer cancel()
+	}
+
+	defer func() {
+		if r := recover(); r != nil {
+			result = Either[O]{Err: fmt.Errorf("%w: %v", ErrTaskPanic, r)}
+		}
+	}()
+
+	if err := ctx.Err(); err != nil {
+		return Either[O]{Err: err}
+	}
+
+	v, err := task.step(ctx, task.data)
+	return Either[O]{
 		Value: &v,
 		Err:   err,
 	}
 }
+
+// Get the metrics label for the task result
+func resultLabel(err error) string {
+	switch {
+	case err == nil:
+		return "ok"
+	case errors.Is(err, ErrTaskPanic):
+		return "panic"
+	case errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	default:
+		return "error"
+	}
+}
@@ -1,31 +1,34 @@
 // Worker
 package workerpool
 
-import (
-	"log"
-)
-
 // Describe worker
 type Worker[I, O any] struct {
-	ID       int
-	taskChan chan *Task[I, O]
+	ID   int
+	pool *Pool[I, O]
 }
 
 // Create new worker instance
-func NewWorker[I, O any](channel chan *Task[I, O], ID int) *Worker[I, O] {
+func NewWorker[I, O any](pool *Pool[I, O], ID int) *Worker[I, O] {
 	return &Worker[I, O]{
-		ID:       ID,
-		taskChan: channel,
+		ID:   ID,
+		pool: pool,
 	}
 }
 
 // Run worker to listen and proccess tasks
 func (wr *Worker[I, O]) Start() {
-	log.Printf("Starting worker %d\n", wr.ID)
-
 	go func() {
-		for task := range wr.taskChan {
-			process(wr.ID, task)
+		defer wr.pool.workers.Done()
+
+		for {
+			select {
+			case <-wr.pool.ctx.Done():
+				return
+			case <-wr.pool.done:
+				return
+			case task := <-wr.pool.tasks:
+				process(task, wr.pool.opts.taskTimeout)
+			}
 		}
 	}()
 }
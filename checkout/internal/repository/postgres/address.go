@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"route256/checkout/internal/converter/repository"
+	"route256/checkout/internal/model"
+	"route256/checkout/internal/pkg/tracer"
+	"route256/checkout/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNameDeliveryAddress = "delivery_address"
+)
+
+var addressColumns = []string{"id", "user_id", "city", "street", "house", "apartment", "postal_code", "comment"}
+
+// Define delivery address repository
+type AddressRepository struct {
+	db *pgxpool.Pool
+}
+
+// Create a new delivery address repository instance
+func NewAddressRepository(db *pgxpool.Pool) *AddressRepository {
+	return &AddressRepository{db: db}
+}
+
+// Save a new delivery address of the user
+func (r *AddressRepository) CreateAddress(ctx context.Context, address model.DeliveryAddress) (model.AddressID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/address/create_address")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Insert(tableNameDeliveryAddress).
+		Columns("user_id", "city", "street", "house", "apartment", "postal_code", "comment").
+		Values(address.UserID, address.City, address.Street, address.House, address.Apartment, address.PostalCode, address.Comment).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build insert query"))
+	}
+
+	var id model.AddressID
+	err = r.db.QueryRow(ctx, query, args...).Scan(&id)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to insert address"))
+	}
+
+	return id, nil
+}
+
+// Get all delivery addresses of the user, oldest first
+func (r *AddressRepository) ListAddresses(ctx context.Context, user model.UserID) ([]model.DeliveryAddress, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/address/list_addresses")
+	defer span.Finish()
+
+	query := psql.Select(addressColumns...).From(tableNameDeliveryAddress).Where(sq.Eq{"user_id": user}).OrderBy("id")
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	var result []schema.DeliveryAddress
+	err = pgxscan.Select(ctx, r.db, &result, rawSQL, args...)
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
+	}
+
+	return repository.ToDeliveryAddresses(result), nil
+}
+
+// Get the delivery address if it belongs to the user
+func (r *AddressRepository) GetAddress(ctx context.Context, user model.UserID, id model.AddressID) (model.DeliveryAddress, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/address/get_address")
+	defer span.Finish()
+
+	query := psql.Select(addressColumns...).From(tableNameDeliveryAddress).Where(sq.Eq{"id": id, "user_id": user})
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return model.DeliveryAddress{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	var result []schema.DeliveryAddress
+	err = pgxscan.Select(ctx, r.db, &result, rawSQL, args...)
+	if err != nil {
+		return model.DeliveryAddress{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
+	}
+	if len(result) == 0 {
+		return model.DeliveryAddress{}, model.ErrAddressNotFound
+	}
+
+	return repository.ToDeliveryAddress(result[0]), nil
+}
+
+// Replace fields of the delivery address that belongs to the user
+func (r *AddressRepository) UpdateAddress(ctx context.Context, address model.DeliveryAddress) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/address/update_address")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Update(tableNameDeliveryAddress).
+		Set("city", address.City).
+		Set("street", address.Street).
+		Set("house", address.House).
+		Set("apartment", address.Apartment).
+		Set("postal_code", address.PostalCode).
+		Set("comment", address.Comment).
+		Where(sq.Eq{"id": address.ID, "user_id": address.UserID}).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build update query"))
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to update address"))
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrAddressNotFound
+	}
+
+	return nil
+}
+
+// Delete the delivery address that belongs to the user
+func (r *AddressRepository) DeleteAddress(ctx context.Context, user model.UserID, id model.AddressID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/address/delete_address")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Delete(tableNameDeliveryAddress).
+		Where(sq.Eq{"id": id, "user_id": user}).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete query"))
+	}
+
+	tag, err := r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete address"))
+	}
+	if tag.RowsAffected() == 0 {
+		return model.ErrAddressNotFound
+	}
+
+	return nil
+}
@@ -2,13 +2,16 @@ package postgres
 
 import (
 	"context"
+	"math"
 	"route256/checkout/internal/converter/repository"
 	"route256/checkout/internal/model"
 	"route256/checkout/internal/pkg/tracer"
 	"route256/checkout/internal/repository/schema"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgconn"
 	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/opentracing/opentracing-go"
@@ -22,14 +25,36 @@ const (
 	tableNameCartItem = "cart_item"
 )
 
+// Describe the part of a pool or transaction used for write queries
+type execer interface {
+	Exec(ctx context.Context, sql string, args ...interface{}) (pgconn.CommandTag, error)
+}
+
 // Define Cart repository
 type CartRepository struct {
 	db *pgxpool.Pool
+	// Record events about cart changes to the outbox
+	events bool
+}
+
+// Describe an optional Cart repository setting
+type Option func(*CartRepository)
+
+// Record events about every change of the cart items to the outbox in the transaction of the change
+func WithCartEvents() Option {
+	return func(r *CartRepository) {
+		r.events = true
+	}
 }
 
 // Create a new Cart repository instance
-func New(db *pgxpool.Pool) *CartRepository {
-	return &CartRepository{db: db}
+func New(db *pgxpool.Pool, opts ...Option) *CartRepository {
+	r := &CartRepository{db: db}
+	for _, opt := range opts {
+		opt(r)
+	}
+
+	return r
 }
 
 // Get user cart id
@@ -47,6 +72,9 @@ func (r *CartRepository) GetCartByUserID(ctx context.Context, userID model.UserI
 	var result schema.Cart
 
 	err = pgxscan.Get(ctx, r.db, &result, rawSQL, args...)
+	if pgxscan.NotFound(err) {
+		return 0, model.ErrCartNotFound
+	}
 	if err != nil {
 		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
 	}
@@ -75,11 +103,177 @@ func (r *CartRepository) CreateCart(ctx context.Context, user model.UserID) (mod
 	return result, nil
 }
 
+// Get guest cart id by its token
+func (r *CartRepository) GetCartByToken(ctx context.Context, token model.CartToken) (model.UserCartID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/get_cart_by_token")
+	defer span.Finish()
+
+	query := psql.Select("id").From(tableNameCart).Where(sq.Eq{"token": token})
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	var result model.UserCartID
+	err = r.db.QueryRow(ctx, rawSQL, args...).Scan(&result)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, model.ErrCartNotFound
+	}
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
+	}
+
+	return result, nil
+}
+
+// Create cart for anonymous shopper
+func (r *CartRepository) CreateGuestCart(ctx context.Context, token model.CartToken) (model.UserCartID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/create_guest_cart")
+	defer span.Finish()
+
+	query := psql.Insert(tableNameCart).Columns("token").Values(string(token)).Suffix("RETURNING id")
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query for create guest cart"))
+	}
+
+	var result model.UserCartID
+	err = r.db.QueryRow(ctx, rawSQL, args...).Scan(&result)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec insert cart"))
+	}
+
+	return result, nil
+}
+
+// Set item counts returned by merge for the locked counts of the target cart,
+// move saved items and delete the source cart in one transaction
+func (r *CartRepository) MergeCart(ctx context.Context, from model.UserCartID, to model.UserCartID, merge func(current map[uint32]uint16) []model.CartItem) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/merge_cart")
+	defer span.Finish()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := lockItemCounts(ctx, tx, to)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	current := make(map[uint32]uint16, len(before))
+	for sku, count := range before {
+		if count > math.MaxUint16 {
+			count = math.MaxUint16
+		}
+		current[sku] = uint16(count)
+	}
+	items := merge(current)
+
+	if len(items) > 0 {
+		upsertQuery := psql.
+			Insert(tableNameCartItem).
+			Columns("cart_id", "sku", "count").
+			Suffix("ON CONFLICT (cart_id, sku) DO UPDATE SET count = EXCLUDED.count, updated_at = now()")
+		for _, item := range items {
+			upsertQuery = upsertQuery.Values(to, item.SKU, item.Count)
+		}
+
+		rawSQL, args, err := upsertQuery.ToSql()
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build upsert query"))
+		}
+
+		_, err = tx.Exec(ctx, rawSQL, args...)
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to upsert items"))
+		}
+	}
+
+	deleteItemsQuery, args, err := psql.Delete(tableNameCartItem).Where(sq.Eq{"cart_id": from}).ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete items query"))
+	}
+
+	_, err = tx.Exec(ctx, deleteItemsQuery, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete source cart items"))
+	}
+
+	// Items saved for later follow the cart, units saved in both carts are summed up
+	moveSavedQuery, args, err := psql.
+		Insert(tableNameSavedItem).
+		Columns("cart_id", "sku", "count", "saved_at").
+		Select(psql.Select().Column("?::BIGINT", to).Columns("sku", "count", "saved_at").From(tableNameSavedItem).Where(sq.Eq{"cart_id": from})).
+		Suffix("ON CONFLICT (cart_id, sku) DO UPDATE SET count = " + tableNameSavedItem + ".count + EXCLUDED.count, saved_at = GREATEST(" + tableNameSavedItem + ".saved_at, EXCLUDED.saved_at)").
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build move saved items query"))
+	}
+
+	_, err = tx.Exec(ctx, moveSavedQuery, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to move saved items"))
+	}
+
+	deleteSavedQuery, args, err := psql.Delete(tableNameSavedItem).Where(sq.Eq{"cart_id": from}).ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete saved items query"))
+	}
+
+	_, err = tx.Exec(ctx, deleteSavedQuery, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete source saved items"))
+	}
+
+	deleteCartQuery, args, err := psql.Delete(tableNameCart).Where(sq.Eq{"id": from}).ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete cart query"))
+	}
+
+	_, err = tx.Exec(ctx, deleteCartQuery, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete source cart"))
+	}
+
+	err = touchCart(ctx, tx, to)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	// The guest cart is gone, lines of the user's cart changed by the merge are described one by one
+	events := []model.CartEvent{{Type: model.CartCleared, CartID: from}}
+	for _, item := range items {
+		events = append(events, itemCountEvent(to, model.SKU(item.SKU), before[item.SKU], int64(item.Count))...)
+	}
+	err = r.recordCartEvents(ctx, tx, events...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return nil
+}
+
 // Add item to user cart or update if it already exists
 func (r *CartRepository) UpdateOrAddToCart(ctx context.Context, cart model.UserCartID, sku model.SKU, count uint16) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/update_or_add_item")
 	defer span.Finish()
 
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
 	query := psql.Select("cart_id").From(tableNameCartItem).Where(sq.Eq{"cart_id": cart, "sku": sku})
 
 	rawSQL, args, err := query.ToSql()
@@ -89,7 +283,7 @@ func (r *CartRepository) UpdateOrAddToCart(ctx context.Context, cart model.UserC
 
 	var cartID int64
 
-	err = r.db.QueryRow(ctx, rawSQL, args...).Scan(&cartID)
+	err = tx.QueryRow(ctx, rawSQL, args...).Scan(&cartID)
 
 	if err == pgx.ErrNoRows {
 		// If item not exists in cart
@@ -103,7 +297,7 @@ func (r *CartRepository) UpdateOrAddToCart(ctx context.Context, cart model.UserC
 			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build insert query"))
 		}
 
-		_, err = r.db.Exec(ctx, insertQuery, args...)
+		_, err = tx.Exec(ctx, insertQuery, args...)
 		if err != nil {
 			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to insert item"))
 		}
@@ -112,13 +306,14 @@ func (r *CartRepository) UpdateOrAddToCart(ctx context.Context, cart model.UserC
 		updateQuery, args, err := psql.
 			Update(tableNameCartItem).
 			Set("count", sq.Expr("count + ?", count)).
+			Set("updated_at", sq.Expr("now()")).
 			Where(sq.Eq{"cart_id": cart, "sku": sku}).
 			ToSql()
 		if err != nil {
 			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to build update query"))
 		}
 
-		_, err = r.db.Exec(ctx, updateQuery, args...)
+		_, err = tx.Exec(ctx, updateQuery, args...)
 		if err != nil {
 			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to update item"))
 		}
@@ -126,27 +321,275 @@ func (r *CartRepository) UpdateOrAddToCart(ctx context.Context, cart model.UserC
 		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to check item existence"))
 	}
 
+	err = touchCart(ctx, tx, cart)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = r.recordCartEvents(ctx, tx, model.CartEvent{Type: model.CartItemAdded, CartID: cart, SKU: uint32(sku), Count: uint32(count)})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
 	return nil
 }
 
-// Remove item from user cart
+// Remove count units of the item from user cart,
+// the line is removed when its count reaches zero
 func (r *CartRepository) DeleteFromCart(ctx context.Context, cart model.UserCartID, sku model.SKU, count uint16) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/delete_from_cart")
 	defer span.Finish()
 
-	query := psql.
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	updateQuery, args, err := psql.
 		Update(tableNameCartItem).
 		Set("count", sq.Expr("count - ?", count)).
-		Where(sq.Eq{"cart_id": cart})
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"cart_id": cart, "sku": sku}).
+		Suffix("RETURNING count").
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	// The count left tells how many units were in the cart, nothing changes without the line
+	var left int64
+	err = tx.QueryRow(ctx, updateQuery, args...).Scan(&left)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil
+	}
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete item"))
+	}
+
+	deleteQuery, args, err := psql.
+		Delete(tableNameCartItem).
+		Where(sq.Eq{"cart_id": cart, "sku": sku}).
+		Where(sq.LtOrEq{"count": 0}).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete query"))
+	}
+
+	_, err = tx.Exec(ctx, deleteQuery, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to remove empty item"))
+	}
+
+	err = touchCart(ctx, tx, cart)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	after := left
+	if after < 0 {
+		after = 0
+	}
+	err = r.recordCartEvents(ctx, tx, itemCountEvent(cart, sku, left+int64(count), after)...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return nil
+}
+
+// Set the absolute count of the item in user cart,
+// the line is removed when the count is zero
+func (r *CartRepository) SetCartItemCount(ctx context.Context, cart model.UserCartID, sku model.SKU, count uint16) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/set_cart_item_count")
+	defer span.Finish()
+
+	if count == 0 {
+		return r.RemoveCartItem(ctx, cart, sku)
+	}
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	before, err := lockItemCounts(ctx, tx, cart, sku)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	query, args, err := psql.
+		Insert(tableNameCartItem).
+		Columns("cart_id", "sku", "count").
+		Values(cart, sku, count).
+		Suffix("ON CONFLICT (cart_id, sku) DO UPDATE SET count = EXCLUDED.count, updated_at = now()").
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to set item count"))
+	}
+
+	err = touchCart(ctx, tx, cart)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = r.recordCartEvents(ctx, tx, itemCountEvent(cart, sku, before[uint32(sku)], int64(count))...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return nil
+}
+
+// Remove the whole line of the item from user cart
+func (r *CartRepository) RemoveCartItem(ctx context.Context, cart model.UserCartID, sku model.SKU) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/remove_cart_item")
+	defer span.Finish()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	count, err := takeItem(ctx, tx, tableNameCartItem, cart, sku)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = touchCart(ctx, tx, cart)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = r.recordCartEvents(ctx, tx, itemCountEvent(cart, sku, int64(count), 0)...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return nil
+}
+
+// Remove all items from user cart
+func (r *CartRepository) ClearCart(ctx context.Context, cart model.UserCartID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/clear_cart")
+	defer span.Finish()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := psql.
+		Delete(tableNameCartItem).
+		Where(sq.Eq{"cart_id": cart}).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to clear cart"))
+	}
+
+	err = touchCart(ctx, tx, cart)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = r.recordCartEvents(ctx, tx, model.CartEvent{Type: model.CartCleared, CartID: cart})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return nil
+}
+
+// Get promo code attached to the cart, empty if there is none
+func (r *CartRepository) GetCartPromoCode(ctx context.Context, cart model.UserCartID) (model.PromoCode, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/get_cart_promo_code")
+	defer span.Finish()
+
+	query := psql.Select("promo_code").From(tableNameCart).Where(sq.Eq{"id": cart})
 
 	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return "", tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	var code *string
+	err = r.db.QueryRow(ctx, rawSQL, args...).Scan(&code)
+	if err != nil {
+		return "", tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
+	}
+	if code == nil {
+		return "", nil
+	}
+
+	return model.PromoCode(*code), nil
+}
+
+// Attach promo code to the cart, an empty code detaches it
+func (r *CartRepository) SetCartPromoCode(ctx context.Context, cart model.UserCartID, code model.PromoCode) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/set_cart_promo_code")
+	defer span.Finish()
+
+	var value *string
+	if code != "" {
+		str := string(code)
+		value = &str
+	}
+
+	query, args, err := psql.
+		Update(tableNameCart).
+		Set("promo_code", value).
+		Where(sq.Eq{"id": cart}).
+		ToSql()
 	if err != nil {
 		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
 	}
 
-	_, err = r.db.Exec(ctx, rawSQL, args...)
+	_, err = r.db.Exec(ctx, query, args...)
 	if err != nil {
-		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete item"))
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to set promo code"))
+	}
+
+	err = touchCart(ctx, r.db, cart)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
 	}
 
 	return nil
@@ -174,3 +617,170 @@ func (r *CartRepository) ListCart(ctx context.Context, cart model.UserCartID) ([
 	cartItems := repository.ToCartItems(result)
 	return cartItems, nil
 }
+
+// Get user carts with items that have not been touched since idleSince
+// and have not been reminded about yet, the oldest first
+func (r *CartRepository) ListAbandonedCarts(ctx context.Context, idleSince time.Time, limit uint64) ([]model.AbandonedCart, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/list_abandoned_carts")
+	defer span.Finish()
+
+	query := psql.
+		Select("*").
+		From(tableNameCart).
+		Where(sq.NotEq{"user_id": nil}).
+		Where(sq.Eq{"reminded_at": nil}).
+		Where(sq.Lt{"updated_at": idleSince}).
+		Where(sq.Expr("EXISTS (SELECT 1 FROM " + tableNameCartItem + " WHERE cart_id = " + tableNameCart + ".id)")).
+		OrderBy("updated_at").
+		Limit(limit)
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	var result []schema.Cart
+
+	err = pgxscan.Select(ctx, r.db, &result, rawSQL, args...)
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
+	}
+
+	return repository.ToAbandonedCarts(result), nil
+}
+
+// Remember that the user has been reminded about the cart if it has not changed since updatedAt,
+// a cart changed after it was listed is left to be reminded about later.
+// The mark is reset by the next change of the cart
+func (r *CartRepository) MarkCartReminded(ctx context.Context, cart model.UserCartID, updatedAt time.Time) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/mark_cart_reminded")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Update(tableNameCart).
+		Set("reminded_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": cart, "updated_at": updatedAt}).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to mark cart"))
+	}
+
+	return nil
+}
+
+// Delete carts of users and guests that have not been touched since the given time,
+// return the number of deleted carts
+func (r *CartRepository) PurgeCarts(ctx context.Context, before time.Time) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/purge_carts")
+	defer span.Finish()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	deleteItemsQuery, args, err := psql.
+		Delete(tableNameCartItem).
+		Where(sq.Expr("cart_id IN (SELECT id FROM "+tableNameCart+" WHERE updated_at < ?)", before)).
+		ToSql()
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete items query"))
+	}
+
+	_, err = tx.Exec(ctx, deleteItemsQuery, args...)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete cart items"))
+	}
+
+	deleteSavedQuery, args, err := psql.
+		Delete(tableNameSavedItem).
+		Where(sq.Expr("cart_id IN (SELECT id FROM "+tableNameCart+" WHERE updated_at < ?)", before)).
+		ToSql()
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete saved items query"))
+	}
+
+	_, err = tx.Exec(ctx, deleteSavedQuery, args...)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete saved items"))
+	}
+
+	deleteCartsQuery, args, err := psql.
+		Delete(tableNameCart).
+		Where(sq.Lt{"updated_at": before}).
+		ToSql()
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete carts query"))
+	}
+
+	tag, err := tx.Exec(ctx, deleteCartsQuery, args...)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete carts"))
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return tag.RowsAffected(), nil
+}
+
+// Lock the lines of the cart, only of the given items if there are any, and get their counts by item.
+// The counts describe changes made later in the transaction
+func lockItemCounts(ctx context.Context, tx pgx.Tx, cart model.UserCartID, skus ...model.SKU) (map[uint32]int64, error) {
+	query := psql.Select("sku", "count").From(tableNameCartItem).Where(sq.Eq{"cart_id": cart}).Suffix("FOR UPDATE")
+	if len(skus) > 0 {
+		query = query.Where(sq.Eq{"sku": skus})
+	}
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build lock items query")
+	}
+
+	rows, err := tx.Query(ctx, rawSQL, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to lock items")
+	}
+	defer rows.Close()
+
+	counts := make(map[uint32]int64)
+	for rows.Next() {
+		var sku uint32
+		var count int64
+		err = rows.Scan(&sku, &count)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan item count")
+		}
+		counts[sku] = count
+	}
+
+	return counts, errors.Wrap(rows.Err(), "read item counts")
+}
+
+// Record activity on the cart and reset the reminder mark
+func touchCart(ctx context.Context, db execer, cart model.UserCartID) error {
+	query, args, err := psql.
+		Update(tableNameCart).
+		Set("updated_at", sq.Expr("now()")).
+		Set("reminded_at", nil).
+		Where(sq.Eq{"id": cart}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build touch cart query")
+	}
+
+	_, err = db.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to touch cart")
+	}
+
+	return nil
+}
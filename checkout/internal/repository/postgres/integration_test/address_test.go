@@ -0,0 +1,66 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+)
+
+const (
+	tableNameDeliveryAddress = "delivery_address"
+)
+
+// Test saving and listing delivery addresses of a user
+func (s *Suite) Test_CreateAddress() {
+	// Arrange
+	userID := model.UserID(15)
+	address := model.DeliveryAddress{UserID: userID, City: "Moscow", Street: "Tverskaya", House: "1", Apartment: "12", PostalCode: "125009"}
+
+	// Act
+	id, err := s.address.CreateAddress(context.Background(), address)
+	s.Require().NoError(err)
+	_, err = s.address.CreateAddress(context.Background(), model.DeliveryAddress{UserID: model.UserID(16), City: "Kazan", Street: "Baumana", House: "2"})
+	s.Require().NoError(err)
+
+	// Assert
+	addresses, err := s.address.ListAddresses(context.Background(), userID)
+	s.Require().NoError(err)
+	address.ID = id
+	s.Require().Equal([]model.DeliveryAddress{address}, addresses)
+}
+
+// Test that addresses of another user are not visible
+func (s *Suite) Test_GetAddress_AnotherUser() {
+	// Arrange
+	id, err := s.address.CreateAddress(context.Background(), model.DeliveryAddress{UserID: model.UserID(15), City: "Moscow", Street: "Tverskaya", House: "1"})
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.address.GetAddress(context.Background(), model.UserID(16), id)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrAddressNotFound)
+}
+
+// Test updating and deleting a delivery address
+func (s *Suite) Test_UpdateDeleteAddress() {
+	// Arrange
+	userID := model.UserID(15)
+	id, err := s.address.CreateAddress(context.Background(), model.DeliveryAddress{UserID: userID, City: "Moscow", Street: "Tverskaya", House: "1"})
+	s.Require().NoError(err)
+	updated := model.DeliveryAddress{ID: id, UserID: userID, City: "Moscow", Street: "Arbat", House: "5", Comment: "call before"}
+
+	// Act
+	err = s.address.UpdateAddress(context.Background(), updated)
+	s.Require().NoError(err)
+	address, err := s.address.GetAddress(context.Background(), userID, id)
+	s.Require().NoError(err)
+	errDelete := s.address.DeleteAddress(context.Background(), userID, id)
+	errDeleteAgain := s.address.DeleteAddress(context.Background(), userID, id)
+
+	// Assert
+	s.Require().Equal(updated, address)
+	s.Require().NoError(errDelete)
+	s.Require().ErrorIs(errDeleteAgain, model.ErrAddressNotFound)
+}
@@ -5,6 +5,7 @@ package integrationtest
 import (
 	"context"
 	"route256/checkout/internal/model"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/georgysavva/scany/pgxscan"
@@ -38,6 +39,15 @@ func (s *Suite) Test_GetCartByUserID() {
 	s.Require().NotEmpty(cartID)
 }
 
+// Test getting cart id of a user without a cart
+func (s *Suite) Test_GetCartByUserID_NotFound() {
+	// Act
+	_, err := s.cart.GetCartByUserID(context.Background(), model.UserID(21))
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrCartNotFound)
+}
+
 // Test creating user cart
 func (s *Suite) Test_CreateCart() {
 	// Arrange
@@ -123,6 +133,189 @@ func (s *Suite) Test_DeleteFromCart() {
 	s.Require().Equal(stock, int(count-removedCount))
 }
 
+// Test that removing items only affects the given sku
+func (s *Suite) Test_DeleteFromCart_OnlyGivenSKU() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	sku := model.SKU(751)
+	otherSKU := model.SKU(760)
+	count := uint16(5)
+	s.addCartItem(cartID, sku, count)
+	s.addCartItem(cartID, otherSKU, count)
+
+	// Act
+	err := s.cart.DeleteFromCart(context.Background(), cartID, sku, 2)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(3, s.getItemCount(cartID, sku))
+	s.Require().Equal(int(count), s.getItemCount(cartID, otherSKU))
+}
+
+// Test that the line disappears when its count reaches zero
+func (s *Suite) Test_DeleteFromCart_RemovesEmptyLine() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	sku := model.SKU(751)
+	s.addCartItem(cartID, sku, 5)
+
+	// Act
+	err := s.cart.DeleteFromCart(context.Background(), cartID, sku, 7)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(s.itemExists(cartID, sku))
+}
+
+// Test setting the count of a new item
+func (s *Suite) Test_SetCartItemCount_NewItem() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	sku := model.SKU(751)
+
+	// Act
+	err := s.cart.SetCartItemCount(context.Background(), cartID, sku, 4)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(4, s.getItemCount(cartID, sku))
+}
+
+// Test that setting the count replaces the existing value
+func (s *Suite) Test_SetCartItemCount_ExistingItem() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	sku := model.SKU(751)
+	s.addCartItem(cartID, sku, 5)
+
+	// Act
+	err := s.cart.SetCartItemCount(context.Background(), cartID, sku, 2)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(2, s.getItemCount(cartID, sku))
+}
+
+// Test that setting zero count removes the line
+func (s *Suite) Test_SetCartItemCount_Zero() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	sku := model.SKU(751)
+	s.addCartItem(cartID, sku, 5)
+
+	// Act
+	err := s.cart.SetCartItemCount(context.Background(), cartID, sku, 0)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(s.itemExists(cartID, sku))
+}
+
+// Test removing the whole line from user cart
+func (s *Suite) Test_RemoveCartItem() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	sku := model.SKU(751)
+	otherSKU := model.SKU(760)
+	s.addCartItem(cartID, sku, 5)
+	s.addCartItem(cartID, otherSKU, 3)
+
+	// Act
+	err := s.cart.RemoveCartItem(context.Background(), cartID, sku)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(s.itemExists(cartID, sku))
+	s.Require().Equal(3, s.getItemCount(cartID, otherSKU))
+}
+
+// Test removing all items only from the given cart
+func (s *Suite) Test_ClearCart() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	otherCartID := s.createCart(model.UserID(16))
+	s.addCartItem(cartID, model.SKU(751), 5)
+	s.addCartItem(cartID, model.SKU(760), 3)
+	s.addCartItem(otherCartID, model.SKU(751), 1)
+
+	// Act
+	err := s.cart.ClearCart(context.Background(), cartID)
+
+	// Assert
+	s.Require().NoError(err)
+	items, err := s.cart.ListCart(context.Background(), cartID)
+	s.Require().NoError(err)
+	s.Require().Empty(items)
+	s.Require().True(s.itemExists(otherCartID, model.SKU(751)))
+}
+
+// Test creating and finding a guest cart by token
+func (s *Suite) Test_CreateGuestCart() {
+	// Arrange
+	token := model.CartToken("guest-token")
+
+	// Act
+	cartID, err := s.cart.CreateGuestCart(context.Background(), token)
+
+	// Assert
+	s.Require().NoError(err)
+
+	foundID, err := s.cart.GetCartByToken(context.Background(), token)
+	s.Require().NoError(err)
+	s.Require().Equal(cartID, foundID)
+}
+
+// Test getting cart id by an unknown guest token
+func (s *Suite) Test_GetCartByToken_NotFound() {
+	// Act
+	_, err := s.cart.GetCartByToken(context.Background(), model.CartToken("unknown-token"))
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrCartNotFound)
+}
+
+// Test merging guest cart items into user cart
+func (s *Suite) Test_MergeCart() {
+	// Arrange
+	userCartID := s.createCart(model.UserID(15))
+	guestCartID, err := s.cart.CreateGuestCart(context.Background(), model.CartToken("guest-token"))
+	s.Require().NoError(err)
+	s.addCartItem(userCartID, model.SKU(751), 2)
+	s.addCartItem(userCartID, model.SKU(770), 1)
+	s.addCartItem(guestCartID, model.SKU(751), 3)
+	s.addCartItem(guestCartID, model.SKU(760), 4)
+
+	guestCounts := map[uint32]uint16{751: 3, 760: 4}
+
+	// Act
+	var locked map[uint32]uint16
+	err = s.cart.MergeCart(context.Background(), guestCartID, userCartID, func(current map[uint32]uint16) []model.CartItem {
+		locked = current
+		return []model.CartItem{
+			{SKU: 751, Count: current[751] + guestCounts[751]},
+			{SKU: 760, Count: current[760] + guestCounts[760]},
+		}
+	})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(map[uint32]uint16{751: 2, 770: 1}, locked)
+	s.Require().Equal(5, s.getItemCount(userCartID, model.SKU(751)))
+	s.Require().Equal(4, s.getItemCount(userCartID, model.SKU(760)))
+	s.Require().Equal(1, s.getItemCount(userCartID, model.SKU(770)))
+	s.Require().False(s.itemExists(guestCartID, model.SKU(751)))
+
+	_, err = s.cart.GetCartByToken(context.Background(), model.CartToken("guest-token"))
+	s.Require().Error(err)
+}
+
+// Merge setting the given lines whatever the target cart holds
+func mergeLines(items ...model.CartItem) func(map[uint32]uint16) []model.CartItem {
+	return func(map[uint32]uint16) []model.CartItem {
+		return items
+	}
+}
+
 // Test getting all items from user cart
 func (s *Suite) Test_ListCart() {
 	// Arrange
@@ -161,3 +354,167 @@ func (s *Suite) Test_ListCart() {
 		{SKU: uint32(sku2), Count: count1},
 	})
 }
+
+// Test that changing a cart moves its activity time and resets the reminder mark
+func (s *Suite) Test_UpdateOrAddToCart_TouchesCart() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	s.setCartActivity(cartID, time.Now().Add(-48*time.Hour), true)
+
+	// Act
+	err := s.cart.UpdateOrAddToCart(context.Background(), cartID, model.SKU(751), 1)
+
+	// Assert
+	s.Require().NoError(err)
+	carts, err := s.cart.ListAbandonedCarts(context.Background(), time.Now().Add(-time.Hour), 10)
+	s.Require().NoError(err)
+	s.Require().Empty(carts)
+
+	carts, err = s.cart.ListAbandonedCarts(context.Background(), time.Now().Add(time.Hour), 10)
+	s.Require().NoError(err)
+	s.Require().Len(carts, 1)
+	s.Require().Equal(cartID, carts[0].ID)
+}
+
+// Test listing only idle, not reminded, non-empty user carts
+func (s *Suite) Test_ListAbandonedCarts() {
+	// Arrange
+	idle := s.createCart(model.UserID(15))
+	s.addCartItem(idle, model.SKU(751), 1)
+	s.setCartActivity(idle, time.Now().Add(-48*time.Hour), false)
+
+	fresh := s.createCart(model.UserID(16))
+	s.addCartItem(fresh, model.SKU(751), 1)
+
+	reminded := s.createCart(model.UserID(17))
+	s.addCartItem(reminded, model.SKU(751), 1)
+	s.setCartActivity(reminded, time.Now().Add(-48*time.Hour), true)
+
+	empty := s.createCart(model.UserID(18))
+	s.setCartActivity(empty, time.Now().Add(-48*time.Hour), false)
+
+	guest, err := s.cart.CreateGuestCart(context.Background(), model.CartToken("guest-token"))
+	s.Require().NoError(err)
+	s.addCartItem(guest, model.SKU(751), 1)
+	s.setCartActivity(guest, time.Now().Add(-48*time.Hour), false)
+
+	// Act
+	carts, err := s.cart.ListAbandonedCarts(context.Background(), time.Now().Add(-24*time.Hour), 10)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Len(carts, 1)
+	s.Require().Equal(idle, carts[0].ID)
+	s.Require().Equal(model.UserID(15), carts[0].UserID)
+
+	err = s.cart.MarkCartReminded(context.Background(), idle, carts[0].UpdatedAt)
+	s.Require().NoError(err)
+
+	carts, err = s.cart.ListAbandonedCarts(context.Background(), time.Now().Add(-24*time.Hour), 10)
+	s.Require().NoError(err)
+	s.Require().Empty(carts)
+}
+
+// Test that a cart changed after it was listed is not marked as reminded
+func (s *Suite) Test_MarkCartReminded_ChangedCart() {
+	// Arrange
+	cart := s.createCart(model.UserID(15))
+	s.addCartItem(cart, model.SKU(751), 1)
+	s.setCartActivity(cart, time.Now().Add(-48*time.Hour), false)
+
+	carts, err := s.cart.ListAbandonedCarts(context.Background(), time.Now().Add(-24*time.Hour), 10)
+	s.Require().NoError(err)
+	s.Require().Len(carts, 1)
+	s.setCartActivity(cart, time.Now().Add(-36*time.Hour), false)
+
+	// Act
+	err = s.cart.MarkCartReminded(context.Background(), cart, carts[0].UpdatedAt)
+
+	// Assert
+	s.Require().NoError(err)
+	carts, err = s.cart.ListAbandonedCarts(context.Background(), time.Now().Add(-24*time.Hour), 10)
+	s.Require().NoError(err)
+	s.Require().Len(carts, 1)
+}
+
+// Test deleting carts that have not been touched since the given time
+func (s *Suite) Test_PurgeCarts() {
+	// Arrange
+	stale := s.createCart(model.UserID(15))
+	s.addCartItem(stale, model.SKU(751), 1)
+	s.setCartActivity(stale, time.Now().Add(-60*24*time.Hour), false)
+
+	fresh := s.createCart(model.UserID(16))
+	s.addCartItem(fresh, model.SKU(751), 1)
+
+	// Act
+	purged, err := s.cart.PurgeCarts(context.Background(), time.Now().Add(-30*24*time.Hour))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(int64(1), purged)
+	s.Require().False(s.itemExists(stale, model.SKU(751)))
+	s.Require().True(s.itemExists(fresh, model.SKU(751)))
+
+	_, err = s.cart.GetCartByUserID(context.Background(), model.UserID(15))
+	s.Require().Error(err)
+}
+
+// Create a cart for the user
+func (s *Suite) createCart(userID model.UserID) model.UserCartID {
+	insertQuery, args, err := psql.Insert(tableNameCart).Columns("user_id").Values(userID).Suffix("RETURNING id").ToSql()
+	s.Require().NoError(err)
+
+	var cartID model.UserCartID
+	err = s.pg.QueryRow(context.Background(), insertQuery, args...).Scan(&cartID)
+	s.Require().NoError(err)
+
+	return cartID
+}
+
+// Put the item to the cart
+func (s *Suite) addCartItem(cartID model.UserCartID, sku model.SKU, count uint16) {
+	insertQuery, args, err := psql.Insert(tableNameCartItem).Columns("cart_id", "sku", "count").Values(cartID, sku, count).ToSql()
+	s.Require().NoError(err)
+
+	_, err = s.pg.Exec(context.Background(), insertQuery, args...)
+	s.Require().NoError(err)
+}
+
+// Get the count of the item in the cart
+func (s *Suite) getItemCount(cartID model.UserCartID, sku model.SKU) int {
+	selectQuery, args, err := psql.Select("count").From(tableNameCartItem).Where(sq.Eq{"cart_id": cartID, "sku": sku}).ToSql()
+	s.Require().NoError(err)
+
+	var count int
+	err = pgxscan.Get(context.Background(), s.pg, &count, selectQuery, args...)
+	s.Require().NoError(err)
+
+	return count
+}
+
+// Check if the item is in the cart
+func (s *Suite) itemExists(cartID model.UserCartID, sku model.SKU) bool {
+	selectQuery, args, err := psql.Select("count(*)").From(tableNameCartItem).Where(sq.Eq{"cart_id": cartID, "sku": sku}).ToSql()
+	s.Require().NoError(err)
+
+	var count int
+	err = pgxscan.Get(context.Background(), s.pg, &count, selectQuery, args...)
+	s.Require().NoError(err)
+
+	return count > 0
+}
+
+// Set the time of the last cart change and whether the user was reminded about it
+func (s *Suite) setCartActivity(cartID model.UserCartID, updatedAt time.Time, reminded bool) {
+	query := psql.Update(tableNameCart).Set("updated_at", updatedAt).Where(sq.Eq{"id": cartID})
+	if reminded {
+		query = query.Set("reminded_at", updatedAt)
+	}
+
+	updateQuery, args, err := query.ToSql()
+	s.Require().NoError(err)
+
+	_, err = s.pg.Exec(context.Background(), updateQuery, args...)
+	s.Require().NoError(err)
+}
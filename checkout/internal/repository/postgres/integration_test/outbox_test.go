@@ -0,0 +1,161 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+	"time"
+)
+
+const (
+	tableNameCartEventOutbox = "cart_event_outbox"
+)
+
+// Test cart events stay in the outbox until they are deleted
+func (s *Suite) Test_CartEventOutbox() {
+	// Arrange
+	events := []model.CartEvent{
+		{Type: model.CartItemAdded, UserID: 15, CartID: 1, SKU: 751, Count: 2, TraceContext: map[string]string{"uber-trace-id": "1:2:0:1"}},
+		{Type: model.CartPurchased, UserID: 15, CartID: 1, SKU: 751, Count: 2, OrderID: 7},
+	}
+
+	// Act
+	err := s.outbox.AddCartEvents(context.Background(), events)
+
+	// Assert
+	s.Require().NoError(err)
+	stored, err := s.outbox.ListCartEvents(context.Background(), 10)
+	s.Require().NoError(err)
+	s.Require().Len(stored, 2)
+	s.Require().Equal(model.CartItemAdded, stored[0].Type)
+	s.Require().Equal(map[string]string{"uber-trace-id": "1:2:0:1"}, stored[0].TraceContext)
+	s.Require().Equal(model.OrderID(7), stored[1].OrderID)
+	s.Require().Nil(stored[1].TraceContext)
+
+	// Act
+	err = s.outbox.DeleteCartEvents(context.Background(), []int64{stored[0].ID})
+
+	// Assert
+	s.Require().NoError(err)
+	stored, err = s.outbox.ListCartEvents(context.Background(), 10)
+	s.Require().NoError(err)
+	s.Require().Len(stored, 1)
+	s.Require().Equal(model.CartPurchased, stored[0].Type)
+}
+
+// Test changes of item counts are recorded with the user of the cart
+func (s *Suite) Test_SetCartItemCount_RecordsEvents() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	s.addCartItem(cartID, model.SKU(751), 5)
+
+	// Act
+	err := s.cart.SetCartItemCount(context.Background(), cartID, model.SKU(751), 2)
+	s.Require().NoError(err)
+	err = s.cart.SetCartItemCount(context.Background(), cartID, model.SKU(760), 3)
+	s.Require().NoError(err)
+	err = s.cart.SetCartItemCount(context.Background(), cartID, model.SKU(760), 3)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal([]model.CartEvent{
+		{Type: model.CartItemRemoved, UserID: 15, CartID: cartID, SKU: 751, Count: 3},
+		{Type: model.CartItemAdded, UserID: 15, CartID: cartID, SKU: 760, Count: 3},
+	}, s.listCartEvents())
+}
+
+// Test only units that were in the cart are recorded as removed
+func (s *Suite) Test_DeleteFromCart_RecordsEvents() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	s.addCartItem(cartID, model.SKU(751), 2)
+
+	// Act
+	err := s.cart.DeleteFromCart(context.Background(), cartID, model.SKU(751), 5)
+	s.Require().NoError(err)
+	err = s.cart.DeleteFromCart(context.Background(), cartID, model.SKU(751), 1)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal([]model.CartEvent{
+		{Type: model.CartItemRemoved, UserID: 15, CartID: cartID, SKU: 751, Count: 2},
+	}, s.listCartEvents())
+}
+
+// Test events of a guest cart have no user
+func (s *Suite) Test_UpdateOrAddToCart_GuestRecordsEvents() {
+	// Arrange
+	cartID, err := s.cart.CreateGuestCart(context.Background(), model.CartToken("guest-token"))
+	s.Require().NoError(err)
+
+	// Act
+	err = s.cart.UpdateOrAddToCart(context.Background(), cartID, model.SKU(751), 2)
+	s.Require().NoError(err)
+	err = s.cart.ClearCart(context.Background(), cartID)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal([]model.CartEvent{
+		{Type: model.CartItemAdded, CartID: cartID, SKU: 751, Count: 2},
+		{Type: model.CartCleared, CartID: cartID},
+	}, s.listCartEvents())
+}
+
+// Test moving items between the cart and the saved list is recorded
+func (s *Suite) Test_SaveForLater_RecordsEvents() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	s.addCartItem(cartID, model.SKU(751), 2)
+
+	// Act
+	err := s.cart.SaveForLater(context.Background(), cartID, model.SKU(751))
+	s.Require().NoError(err)
+	err = s.cart.MoveToCart(context.Background(), cartID, model.SKU(751))
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal([]model.CartEvent{
+		{Type: model.CartItemRemoved, UserID: 15, CartID: cartID, SKU: 751, Count: 2},
+		{Type: model.CartItemAdded, UserID: 15, CartID: cartID, SKU: 751, Count: 2},
+	}, s.listCartEvents())
+}
+
+// Test merging records the deleted guest cart and the changed lines of the user's cart
+func (s *Suite) Test_MergeCart_RecordsEvents() {
+	// Arrange
+	userCartID := s.createCart(model.UserID(15))
+	guestCartID, err := s.cart.CreateGuestCart(context.Background(), model.CartToken("guest-token"))
+	s.Require().NoError(err)
+	s.addCartItem(userCartID, model.SKU(751), 2)
+	s.addCartItem(userCartID, model.SKU(770), 1)
+	s.addCartItem(guestCartID, model.SKU(751), 3)
+	s.addCartItem(guestCartID, model.SKU(760), 4)
+
+	// Act
+	err = s.cart.MergeCart(context.Background(), guestCartID, userCartID, mergeLines(
+		model.CartItem{SKU: 751, Count: 5},
+		model.CartItem{SKU: 760, Count: 4},
+	))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal([]model.CartEvent{
+		{Type: model.CartCleared, CartID: guestCartID},
+		{Type: model.CartItemAdded, UserID: 15, CartID: userCartID, SKU: 751, Count: 3},
+		{Type: model.CartItemAdded, UserID: 15, CartID: userCartID, SKU: 760, Count: 4},
+	}, s.listCartEvents())
+}
+
+// Get events of the outbox without the fields set by the database
+func (s *Suite) listCartEvents() []model.CartEvent {
+	events, err := s.outbox.ListCartEvents(context.Background(), 100)
+	s.Require().NoError(err)
+
+	for i := range events {
+		events[i].ID = 0
+		events[i].TraceContext = nil
+		events[i].CreatedAt = time.Time{}
+	}
+	return events
+}
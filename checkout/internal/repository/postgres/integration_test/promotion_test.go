@@ -0,0 +1,157 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+	"time"
+)
+
+const (
+	tableNamePromotion      = "promotion"
+	tableNamePromotionUsage = "promotion_usage"
+)
+
+// Test getting promotion definition by code
+func (s *Suite) Test_GetPromotionByCode() {
+	// Arrange
+	validTo := time.Now().Add(24 * time.Hour).Truncate(time.Second)
+	insertQuery, args, err := psql.
+		Insert(tableNamePromotion).
+		Columns("code", "kind", "sku", "buy_count", "pay_count", "usage_limit", "valid_to").
+		Values("3FOR2", "n_for_m", 751, 3, 2, 1, validTo).
+		ToSql()
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), insertQuery, args...)
+	s.Require().NoError(err)
+
+	// Act
+	promotion, err := s.promotion.GetPromotionByCode(context.Background(), model.PromoCode("3FOR2"))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(model.PromotionNForM, promotion.Kind)
+	s.Require().Equal(uint32(751), promotion.SKU)
+	s.Require().Equal(uint16(3), promotion.BuyCount)
+	s.Require().Equal(uint16(2), promotion.PayCount)
+	s.Require().Equal(uint32(1), promotion.UsageLimit)
+	s.Require().True(promotion.ValidFrom.IsZero())
+	s.Require().True(validTo.Equal(promotion.ValidTo))
+}
+
+// Test that an unknown code is reported as not found
+func (s *Suite) Test_GetPromotionByCode_NotFound() {
+	// Act
+	_, err := s.promotion.GetPromotionByCode(context.Background(), model.PromoCode("UNKNOWN"))
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPromotionNotFound)
+}
+
+// Insert a promotion with the usage limit per user
+func (s *Suite) createPromotion(code string, usageLimit uint32) int64 {
+	insertQuery, args, err := psql.
+		Insert(tableNamePromotion).
+		Columns("code", "kind", "value", "usage_limit").
+		Values(code, "percent", 10, usageLimit).
+		Suffix("RETURNING id").
+		ToSql()
+	s.Require().NoError(err)
+
+	var id int64
+	err = s.pg.QueryRow(context.Background(), insertQuery, args...).Scan(&id)
+	s.Require().NoError(err)
+	return id
+}
+
+// Test counting promotion usage per user
+func (s *Suite) Test_ReservePromotionUsage() {
+	// Arrange
+	promotionID := s.createPromotion("SALE", 0)
+	userID := model.UserID(15)
+
+	// Act
+	usageID, err := s.promotion.ReservePromotionUsage(context.Background(), promotionID, userID, 0)
+	s.Require().NoError(err)
+	err = s.cart.CompletePurchase(context.Background(), model.PlacedOrder{OrderID: 100, UserID: userID, CartID: 1, PromoCode: "SALE", UsageID: usageID})
+	s.Require().NoError(err)
+	_, err = s.promotion.ReservePromotionUsage(context.Background(), promotionID, userID, 0)
+	s.Require().NoError(err)
+	_, err = s.promotion.ReservePromotionUsage(context.Background(), promotionID, model.UserID(16), 0)
+	s.Require().NoError(err)
+
+	// Assert
+	used, err := s.promotion.CountPromotionUsage(context.Background(), promotionID, userID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(2), used)
+}
+
+// Test that a reservation over the usage limit is rejected
+func (s *Suite) Test_ReservePromotionUsage_LimitReached() {
+	// Arrange
+	promotionID := s.createPromotion("ONCE", 1)
+	userID := model.UserID(15)
+	_, err := s.promotion.ReservePromotionUsage(context.Background(), promotionID, userID, 1)
+	s.Require().NoError(err)
+
+	// Act
+	_, err = s.promotion.ReservePromotionUsage(context.Background(), promotionID, userID, 1)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPromotionUsageLimit)
+}
+
+// Test that a reservation of an unknown promotion is reported as not found
+func (s *Suite) Test_ReservePromotionUsage_NotFound() {
+	// Act
+	_, err := s.promotion.ReservePromotionUsage(context.Background(), int64(1), model.UserID(15), 1)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPromotionNotFound)
+}
+
+// Test that a released reservation frees the usage and a confirmed one is kept
+func (s *Suite) Test_ReleasePromotionUsage() {
+	// Arrange
+	promotionID := s.createPromotion("ONCE", 1)
+	userID := model.UserID(15)
+	usageID, err := s.promotion.ReservePromotionUsage(context.Background(), promotionID, userID, 1)
+	s.Require().NoError(err)
+
+	// Act
+	err = s.promotion.ReleasePromotionUsage(context.Background(), usageID)
+
+	// Assert
+	s.Require().NoError(err)
+	usageID, err = s.promotion.ReservePromotionUsage(context.Background(), promotionID, userID, 1)
+	s.Require().NoError(err)
+	err = s.cart.CompletePurchase(context.Background(), model.PlacedOrder{OrderID: 100, UserID: userID, CartID: 1, PromoCode: "ONCE", UsageID: usageID})
+	s.Require().NoError(err)
+	err = s.promotion.ReleasePromotionUsage(context.Background(), usageID)
+	s.Require().NoError(err)
+	used, err := s.promotion.CountPromotionUsage(context.Background(), promotionID, userID)
+	s.Require().NoError(err)
+	s.Require().Equal(uint32(1), used)
+}
+
+// Test attaching and detaching promo code to the cart
+func (s *Suite) Test_SetCartPromoCode() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+
+	// Act
+	err := s.cart.SetCartPromoCode(context.Background(), cartID, model.PromoCode("SALE"))
+
+	// Assert
+	s.Require().NoError(err)
+	code, err := s.cart.GetCartPromoCode(context.Background(), cartID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.PromoCode("SALE"), code)
+
+	err = s.cart.SetCartPromoCode(context.Background(), cartID, "")
+	s.Require().NoError(err)
+	code, err = s.cart.GetCartPromoCode(context.Background(), cartID)
+	s.Require().NoError(err)
+	s.Require().Empty(code)
+}
@@ -0,0 +1,83 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+const (
+	tableNamePurchaseCompletion = "purchase_completion"
+)
+
+// Test the placed order is recorded once: events, the promo code use and the detached code
+func (s *Suite) Test_CompletePurchase() {
+	// Arrange
+	userID := model.UserID(15)
+	cartID := s.createCart(userID)
+	err := s.cart.SetCartPromoCode(context.Background(), cartID, model.PromoCode("ONCE"))
+	s.Require().NoError(err)
+	promotionID := s.createPromotion("ONCE", 1)
+	usageID, err := s.promotion.ReservePromotionUsage(context.Background(), promotionID, userID, 1)
+	s.Require().NoError(err)
+	order := model.PlacedOrder{
+		OrderID:   100,
+		UserID:    userID,
+		CartID:    cartID,
+		Items:     []model.CartItem{{SKU: 751, Count: 2}, {SKU: 760, Count: 1}},
+		PromoCode: "ONCE",
+		UsageID:   usageID,
+	}
+
+	// Act
+	err = s.cart.CompletePurchase(context.Background(), order)
+	s.Require().NoError(err)
+	err = s.cart.CompletePurchase(context.Background(), order)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().Equal([]model.CartEvent{
+		{Type: model.CartPurchased, UserID: userID, CartID: cartID, SKU: 751, Count: 2, OrderID: 100},
+		{Type: model.CartPurchased, UserID: userID, CartID: cartID, SKU: 760, Count: 1, OrderID: 100},
+	}, s.listCartEvents())
+	code, err := s.cart.GetCartPromoCode(context.Background(), cartID)
+	s.Require().NoError(err)
+	s.Require().Empty(code)
+	s.Require().Equal(model.OrderID(100), s.usageOrder(usageID))
+}
+
+// Test a code attached to the cart after the order is kept
+func (s *Suite) Test_CompletePurchase_KeepsAnotherCode() {
+	// Arrange
+	userID := model.UserID(15)
+	cartID := s.createCart(userID)
+	err := s.cart.SetCartPromoCode(context.Background(), cartID, model.PromoCode("NEXT"))
+	s.Require().NoError(err)
+	promotionID := s.createPromotion("ONCE", 1)
+	usageID, err := s.promotion.ReservePromotionUsage(context.Background(), promotionID, userID, 1)
+	s.Require().NoError(err)
+
+	// Act
+	err = s.cart.CompletePurchase(context.Background(), model.PlacedOrder{OrderID: 100, UserID: userID, CartID: cartID, PromoCode: "ONCE", UsageID: usageID})
+
+	// Assert
+	s.Require().NoError(err)
+	code, err := s.cart.GetCartPromoCode(context.Background(), cartID)
+	s.Require().NoError(err)
+	s.Require().Equal(model.PromoCode("NEXT"), code)
+	s.Require().Equal(model.OrderID(100), s.usageOrder(usageID))
+}
+
+// Get the order of the promotion use, zero for a reservation
+func (s *Suite) usageOrder(usageID int64) model.OrderID {
+	query, args, err := psql.Select("COALESCE(order_id, 0)").From(tableNamePromotionUsage).Where(sq.Eq{"id": usageID}).ToSql()
+	s.Require().NoError(err)
+
+	var order int64
+	err = s.pg.QueryRow(context.Background(), query, args...).Scan(&order)
+	s.Require().NoError(err)
+	return model.OrderID(order)
+}
@@ -0,0 +1,81 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+)
+
+const (
+	tableNameSavedItem = "saved_item"
+)
+
+// Test moving a cart line to the saved list and back
+func (s *Suite) Test_SaveForLater_MoveToCart() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+	s.addCartItem(cartID, model.SKU(751), 5)
+	s.addCartItem(cartID, model.SKU(760), 3)
+
+	// Act
+	err := s.cart.SaveForLater(context.Background(), cartID, model.SKU(751))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(s.itemExists(cartID, model.SKU(751)))
+	saved, err := s.cart.ListSaved(context.Background(), cartID)
+	s.Require().NoError(err)
+	s.Require().Equal([]model.CartItem{{SKU: 751, Count: 5}}, saved)
+
+	// Act
+	s.addCartItem(cartID, model.SKU(751), 1)
+	err = s.cart.MoveToCart(context.Background(), cartID, model.SKU(751))
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().Equal(6, s.getItemCount(cartID, model.SKU(751)))
+	saved, err = s.cart.ListSaved(context.Background(), cartID)
+	s.Require().NoError(err)
+	s.Require().Empty(saved)
+}
+
+// Test saving and moving items that are not there
+func (s *Suite) Test_SaveForLater_NotFound() {
+	// Arrange
+	cartID := s.createCart(model.UserID(15))
+
+	// Act
+	saveErr := s.cart.SaveForLater(context.Background(), cartID, model.SKU(751))
+	moveErr := s.cart.MoveToCart(context.Background(), cartID, model.SKU(751))
+
+	// Assert
+	s.Require().ErrorIs(saveErr, model.ErrCartItemNotFound)
+	s.Require().ErrorIs(moveErr, model.ErrSavedItemNotFound)
+}
+
+// Test saved items of a guest cart follow the merge
+func (s *Suite) Test_MergeCart_SavedItems() {
+	// Arrange
+	userCartID := s.createCart(model.UserID(15))
+	guestCartID, err := s.cart.CreateGuestCart(context.Background(), model.CartToken("guest-token"))
+	s.Require().NoError(err)
+	s.addCartItem(userCartID, model.SKU(751), 2)
+	s.addCartItem(guestCartID, model.SKU(751), 3)
+	s.addCartItem(guestCartID, model.SKU(760), 4)
+	s.Require().NoError(s.cart.SaveForLater(context.Background(), userCartID, model.SKU(751)))
+	s.Require().NoError(s.cart.SaveForLater(context.Background(), guestCartID, model.SKU(751)))
+	s.Require().NoError(s.cart.SaveForLater(context.Background(), guestCartID, model.SKU(760)))
+
+	// Act
+	err = s.cart.MergeCart(context.Background(), guestCartID, userCartID, mergeLines())
+
+	// Assert
+	s.Require().NoError(err)
+	saved, err := s.cart.ListSaved(context.Background(), userCartID)
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]model.CartItem{{SKU: 751, Count: 5}, {SKU: 760, Count: 4}}, saved)
+	saved, err = s.cart.ListSaved(context.Background(), guestCartID)
+	s.Require().NoError(err)
+	s.Require().Empty(saved)
+}
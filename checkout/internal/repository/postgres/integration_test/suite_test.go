@@ -15,8 +15,11 @@ import (
 // Group integration tests and data for it
 type Suite struct {
 	suite.Suite
-	pg   *pgxpool.Pool
-	cart *postgres.CartRepository
+	pg        *pgxpool.Pool
+	cart      *postgres.CartRepository
+	promotion *postgres.PromotionRepository
+	address   *postgres.AddressRepository
+	outbox    *postgres.OutboxRepository
 }
 
 // Starting point for tests
@@ -32,7 +35,10 @@ func (s *Suite) SetupSuite() {
 	s.pg, err = pgxpool.Connect(context.Background(), cfg.Postgres.TestDBConnectionString)
 	s.Require().NoError(err)
 
-	s.cart = postgres.New(s.pg)
+	s.cart = postgres.New(s.pg, postgres.WithCartEvents())
+	s.promotion = postgres.NewPromotionRepository(s.pg)
+	s.address = postgres.NewAddressRepository(s.pg)
+	s.outbox = postgres.NewOutboxRepository(s.pg)
 }
 
 // Clean db tables before each test
@@ -40,8 +46,20 @@ func (s *Suite) SetupTest() {
 	query := "TRUNCATE TABLE "
 	_, err := s.pg.Exec(context.Background(), query+tableNameCartItem)
 	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameSavedItem)
+	s.Require().NoError(err)
 	_, err = s.pg.Exec(context.Background(), query+tableNameCart)
 	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNamePromotionUsage)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNamePromotion)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameDeliveryAddress)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameCartEventOutbox)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNamePurchaseCompletion)
+	s.Require().NoError(err)
 }
 
 // Tear down environment for integration tests after all tests
@@ -49,7 +67,19 @@ func (s *Suite) TearDownSuite() {
 	query := "TRUNCATE TABLE "
 	_, err := s.pg.Exec(context.Background(), query+tableNameCartItem)
 	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameSavedItem)
+	s.Require().NoError(err)
 	_, err = s.pg.Exec(context.Background(), query+tableNameCart)
 	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNamePromotionUsage)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNamePromotion)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameDeliveryAddress)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameCartEventOutbox)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNamePurchaseCompletion)
+	s.Require().NoError(err)
 	s.pg.Close()
 }
@@ -0,0 +1,154 @@
+package postgres
+
+import (
+	"context"
+	"encoding/json"
+	"route256/checkout/internal/converter/repository"
+	"route256/checkout/internal/model"
+	"route256/checkout/internal/pkg/tracer"
+	"route256/checkout/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNameCartEventOutbox = "cart_event_outbox"
+)
+
+// Define outbox of cart events waiting to be published
+type OutboxRepository struct {
+	db *pgxpool.Pool
+}
+
+// Create a new outbox repository instance
+func NewOutboxRepository(db *pgxpool.Pool) *OutboxRepository {
+	return &OutboxRepository{db: db}
+}
+
+// Save events to be published later
+func (r *OutboxRepository) AddCartEvents(ctx context.Context, events []model.CartEvent) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/outbox/add_cart_events")
+	defer span.Finish()
+
+	err := insertCartEvents(ctx, r.db, events)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	return nil
+}
+
+// Get events waiting to be published, the oldest first
+func (r *OutboxRepository) ListCartEvents(ctx context.Context, limit uint64) ([]model.CartEvent, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/outbox/list_cart_events")
+	defer span.Finish()
+
+	query := psql.Select("*").From(tableNameCartEventOutbox).OrderBy("id").Limit(limit)
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	var result []schema.CartEvent
+	err = pgxscan.Select(ctx, r.db, &result, rawSQL, args...)
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
+	}
+
+	return repository.ToCartEvents(result), nil
+}
+
+// Delete published events
+func (r *OutboxRepository) DeleteCartEvents(ctx context.Context, ids []int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/outbox/delete_cart_events")
+	defer span.Finish()
+
+	if len(ids) == 0 {
+		return nil
+	}
+
+	query, args, err := psql.Delete(tableNameCartEventOutbox).Where(sq.Eq{"id": ids}).ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete query"))
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to delete cart events"))
+	}
+
+	return nil
+}
+
+// Insert events into the outbox, the user of an event without one is taken from its cart
+func insertCartEvents(ctx context.Context, db execer, events []model.CartEvent) error {
+	if len(events) == 0 {
+		return nil
+	}
+
+	query := psql.
+		Insert(tableNameCartEventOutbox).
+		Columns("event_type", "user_id", "cart_id", "sku", "count", "order_id", "trace_context")
+	for _, event := range events {
+		var traceContext []byte
+		if len(event.TraceContext) > 0 {
+			var err error
+			traceContext, err = json.Marshal(event.TraceContext)
+			if err != nil {
+				return errors.Wrap(err, "marshal trace context")
+			}
+		}
+
+		var user interface{} = event.UserID
+		if event.UserID == 0 {
+			// Guest carts have no user, their events keep zero
+			user = sq.Expr("COALESCE((SELECT user_id FROM "+tableNameCart+" WHERE id = ?), 0)", event.CartID)
+		}
+		query = query.Values(string(event.Type), user, event.CartID, event.SKU, event.Count, event.OrderID, traceContext)
+	}
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build insert query")
+	}
+
+	_, err = db.Exec(ctx, rawSQL, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to insert cart events")
+	}
+
+	return nil
+}
+
+// Save events about the cart change within the transaction of the change with the trace context of the request,
+// does nothing when events are not enabled
+func (r *CartRepository) recordCartEvents(ctx context.Context, tx pgx.Tx, events ...model.CartEvent) error {
+	if !r.events || len(events) == 0 {
+		return nil
+	}
+
+	traceContext := tracer.InjectTextMap(ctx)
+	for i := range events {
+		events[i].TraceContext = traceContext
+	}
+
+	return insertCartEvents(ctx, tx, events)
+}
+
+// Build the event about the change of the item count, no event for an unchanged count
+func itemCountEvent(cart model.UserCartID, sku model.SKU, before, after int64) []model.CartEvent {
+	switch {
+	case after > before:
+		return []model.CartEvent{{Type: model.CartItemAdded, CartID: cart, SKU: uint32(sku), Count: uint32(after - before)}}
+	case after < before:
+		return []model.CartEvent{{Type: model.CartItemRemoved, CartID: cart, SKU: uint32(sku), Count: uint32(before - after)}}
+	default:
+		return nil
+	}
+}
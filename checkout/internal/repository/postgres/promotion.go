@@ -0,0 +1,184 @@
+package postgres
+
+import (
+	"context"
+	"route256/checkout/internal/converter/repository"
+	"route256/checkout/internal/model"
+	"route256/checkout/internal/pkg/tracer"
+	"route256/checkout/internal/repository/schema"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNamePromotion      = "promotion"
+	tableNamePromotionUsage = "promotion_usage"
+)
+
+// A reserved use without an order is not counted after this time,
+// the purchase that reserved it has failed without releasing it
+const promotionReservationTTL = 10 * time.Minute
+
+// Describe the part of a pool or transaction used for single row queries
+type rowQuerier interface {
+	QueryRow(ctx context.Context, sql string, args ...interface{}) pgx.Row
+}
+
+// Define Promotion repository
+type PromotionRepository struct {
+	db *pgxpool.Pool
+}
+
+// Create a new Promotion repository instance
+func NewPromotionRepository(db *pgxpool.Pool) *PromotionRepository {
+	return &PromotionRepository{db: db}
+}
+
+// Get promotion definition by its code
+func (r *PromotionRepository) GetPromotionByCode(ctx context.Context, code model.PromoCode) (model.Promotion, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/promotion/get_promotion_by_code")
+	defer span.Finish()
+
+	query := psql.Select("*").From(tableNamePromotion).Where(sq.Eq{"code": code})
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return model.Promotion{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	var result []schema.Promotion
+
+	err = pgxscan.Select(ctx, r.db, &result, rawSQL, args...)
+	if err != nil {
+		return model.Promotion{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
+	}
+	if len(result) == 0 {
+		return model.Promotion{}, model.ErrPromotionNotFound
+	}
+
+	return repository.ToPromotion(result[0]), nil
+}
+
+// Get the number of orders the user has placed or is placing with the promotion
+func (r *PromotionRepository) CountPromotionUsage(ctx context.Context, promotionID int64, user model.UserID) (uint32, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/promotion/count_promotion_usage")
+	defer span.Finish()
+
+	used, err := r.countPromotionUsage(ctx, r.db, promotionID, user)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, err)
+	}
+
+	return used, nil
+}
+
+// Reserve a use of the promotion for the order the user is placing if the user has used it less than limit times,
+// zero limit is unlimited. Uses of the promotion are reserved one at a time,
+// so concurrent purchases can not both take the last use. Return the id of the reserved use
+// or model.ErrPromotionUsageLimit when the limit is reached
+func (r *PromotionRepository) ReservePromotionUsage(ctx context.Context, promotionID int64, user model.UserID, limit uint32) (int64, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/promotion/reserve_promotion_usage")
+	defer span.Finish()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	lockQuery, args, err := psql.Select("id").From(tableNamePromotion).Where(sq.Eq{"id": promotionID}).Suffix("FOR UPDATE").ToSql()
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build lock query"))
+	}
+
+	var id int64
+	err = tx.QueryRow(ctx, lockQuery, args...).Scan(&id)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, tracer.MarkSpanWithError(ctx, model.ErrPromotionNotFound)
+	}
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "lock promotion"))
+	}
+
+	if limit > 0 {
+		used, err := r.countPromotionUsage(ctx, tx, promotionID, user)
+		if err != nil {
+			return 0, tracer.MarkSpanWithError(ctx, err)
+		}
+		if used >= limit {
+			return 0, tracer.MarkSpanWithError(ctx, model.ErrPromotionUsageLimit)
+		}
+	}
+
+	insertQuery, args, err := psql.
+		Insert(tableNamePromotionUsage).
+		Columns("promotion_id", "user_id").
+		Values(promotionID, user).
+		Suffix("RETURNING id").
+		ToSql()
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build insert query"))
+	}
+
+	var usageID int64
+	err = tx.QueryRow(ctx, insertQuery, args...).Scan(&usageID)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to reserve promotion usage"))
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return usageID, nil
+}
+
+// Give back the reserved use of the promotion when the order was not placed
+func (r *PromotionRepository) ReleasePromotionUsage(ctx context.Context, usageID int64) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/promotion/release_promotion_usage")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Delete(tableNamePromotionUsage).
+		Where(sq.Eq{"id": usageID, "order_id": nil}).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete query"))
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to release promotion usage"))
+	}
+
+	return nil
+}
+
+// Count placed orders and recent reservations of the promotion by the user
+func (r *PromotionRepository) countPromotionUsage(ctx context.Context, db rowQuerier, promotionID int64, user model.UserID) (uint32, error) {
+	query := psql.
+		Select("count(*)").
+		From(tableNamePromotionUsage).
+		Where(sq.Eq{"promotion_id": promotionID, "user_id": user}).
+		Where(sq.Or{sq.NotEq{"order_id": nil}, sq.Gt{"used_at": time.Now().Add(-promotionReservationTTL)}})
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build query")
+	}
+
+	var result uint32
+	err = db.QueryRow(ctx, rawSQL, args...).Scan(&result)
+	if err != nil {
+		return 0, errors.Wrap(err, "exec count query")
+	}
+
+	return result, nil
+}
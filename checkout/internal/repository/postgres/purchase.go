@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"context"
+	"route256/checkout/internal/model"
+	"route256/checkout/internal/pkg/tracer"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNamePurchaseCompletion = "purchase_completion"
+)
+
+// Record the order placed from the cart in one transaction: events about the purchased items,
+// the order of the reserved promo code use and the detach of the code from the cart.
+// The order id is the idempotency key, an order recorded before is skipped
+func (r *CartRepository) CompletePurchase(ctx context.Context, order model.PlacedOrder) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/complete_purchase")
+	defer span.Finish()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	query, args, err := psql.
+		Insert(tableNamePurchaseCompletion).
+		Columns("order_id", "cart_id").
+		Values(order.OrderID, order.CartID).
+		Suffix("ON CONFLICT (order_id) DO NOTHING").
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build insert query"))
+	}
+
+	tag, err := tx.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to insert purchase completion"))
+	}
+	if tag.RowsAffected() == 0 {
+		return nil
+	}
+
+	events := make([]model.CartEvent, 0, len(order.Items))
+	for _, item := range order.Items {
+		events = append(events, model.CartEvent{Type: model.CartPurchased, UserID: order.UserID, CartID: order.CartID, SKU: item.SKU, Count: uint32(item.Count), OrderID: order.OrderID})
+	}
+	err = r.recordCartEvents(ctx, tx, events...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	if order.PromoCode != "" {
+		err = confirmPromotionUsage(ctx, tx, order.UsageID, order.OrderID)
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, err)
+		}
+
+		err = detachPromoCode(ctx, tx, order.CartID, order.PromoCode)
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, err)
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return nil
+}
+
+// Remember the order placed with the reserved use of the promotion
+func confirmPromotionUsage(ctx context.Context, db execer, usageID int64, order model.OrderID) error {
+	query, args, err := psql.
+		Update(tableNamePromotionUsage).
+		Set("order_id", order).
+		Set("used_at", sq.Expr("now()")).
+		Where(sq.Eq{"id": usageID}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build confirm usage query")
+	}
+
+	_, err = db.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to confirm promotion usage")
+	}
+
+	return nil
+}
+
+// Detach the used promo code from the cart, a code attached after the order is kept
+func detachPromoCode(ctx context.Context, db execer, cart model.UserCartID, code model.PromoCode) error {
+	query, args, err := psql.
+		Update(tableNameCart).
+		Set("promo_code", nil).
+		Where(sq.Eq{"id": cart, "promo_code": string(code)}).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build detach promo code query")
+	}
+
+	_, err = db.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "failed to detach promo code")
+	}
+
+	return touchCart(ctx, db, cart)
+}
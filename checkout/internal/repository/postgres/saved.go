@@ -0,0 +1,171 @@
+package postgres
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"route256/checkout/internal/converter/repository"
+	"route256/checkout/internal/model"
+	"route256/checkout/internal/pkg/tracer"
+	"route256/checkout/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNameSavedItem = "saved_item"
+)
+
+// Move the whole line of the item from the cart to the saved for later list in one transaction,
+// units already saved are summed up to the largest count of a line
+func (r *CartRepository) SaveForLater(ctx context.Context, cart model.UserCartID, sku model.SKU) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/save_for_later")
+	defer span.Finish()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	count, err := takeItem(ctx, tx, tableNameCartItem, cart, sku)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+	if count == 0 {
+		return model.ErrCartItemNotFound
+	}
+
+	query, args, err := psql.
+		Insert(tableNameSavedItem).
+		Columns("cart_id", "sku", "count").
+		Values(cart, sku, count).
+		Suffix(fmt.Sprintf("ON CONFLICT (cart_id, sku) DO UPDATE SET count = LEAST(%s.count + EXCLUDED.count, %d), saved_at = now()", tableNameSavedItem, math.MaxUint16)).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build upsert query"))
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to save item"))
+	}
+
+	err = touchCart(ctx, tx, cart)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = r.recordCartEvents(ctx, tx, itemCountEvent(cart, sku, int64(count), 0)...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return nil
+}
+
+// Move the saved item back to the cart in one transaction,
+// units already in the cart are summed up to the largest count of a line
+func (r *CartRepository) MoveToCart(ctx context.Context, cart model.UserCartID, sku model.SKU) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/move_to_cart")
+	defer span.Finish()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	count, err := takeItem(ctx, tx, tableNameSavedItem, cart, sku)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+	if count == 0 {
+		return model.ErrSavedItemNotFound
+	}
+
+	query, args, err := psql.
+		Insert(tableNameCartItem).
+		Columns("cart_id", "sku", "count").
+		Values(cart, sku, count).
+		Suffix(fmt.Sprintf("ON CONFLICT (cart_id, sku) DO UPDATE SET count = LEAST(%s.count + EXCLUDED.count, %d), updated_at = now()", tableNameCartItem, math.MaxUint16)).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build upsert query"))
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to move item to cart"))
+	}
+
+	err = touchCart(ctx, tx, cart)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = r.recordCartEvents(ctx, tx, model.CartEvent{Type: model.CartItemAdded, CartID: cart, SKU: uint32(sku), Count: uint32(count)})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, err)
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return nil
+}
+
+// Get items saved for later, the latest saved first
+func (r *CartRepository) ListSaved(ctx context.Context, cart model.UserCartID) ([]model.CartItem, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/list_saved")
+	defer span.Finish()
+
+	query := psql.Select("*").From(tableNameSavedItem).Where(sq.Eq{"cart_id": cart}).OrderBy("saved_at DESC", "sku")
+
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	var result []schema.SavedItem
+	err = pgxscan.Select(ctx, r.db, &result, rawSQL, args...)
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
+	}
+
+	return repository.SavedToCartItems(result), nil
+}
+
+// Delete the line of the item from the table and return its count, zero if there was no line
+func takeItem(ctx context.Context, tx pgx.Tx, table string, cart model.UserCartID, sku model.SKU) (uint16, error) {
+	query, args, err := psql.
+		Delete(table).
+		Where(sq.Eq{"cart_id": cart, "sku": sku}).
+		Suffix("RETURNING count").
+		ToSql()
+	if err != nil {
+		return 0, errors.Wrap(err, "build delete query")
+	}
+
+	var count uint16
+	err = tx.QueryRow(ctx, query, args...).Scan(&count)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, nil
+	}
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to take item")
+	}
+
+	return count, nil
+}
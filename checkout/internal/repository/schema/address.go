@@ -0,0 +1,14 @@
+// Delivery address table definition
+package schema
+
+// Describe delivery address table in postgres db
+type DeliveryAddress struct {
+	ID         int64  `db:"id"`
+	UserID     int64  `db:"user_id"`
+	City       string `db:"city"`
+	Street     string `db:"street"`
+	House      string `db:"house"`
+	Apartment  string `db:"apartment"`
+	PostalCode string `db:"postal_code"`
+	Comment    string `db:"comment"`
+}
@@ -1,8 +1,14 @@
 // Cart table definition
 package schema
 
+import "time"
+
 // Describe cart table in postgres db
 type Cart struct {
-	ID     int64 `db:"id"`
-	UserID int64 `db:"user_id"`
+	ID         int64      `db:"id"`
+	UserID     *int64     `db:"user_id"`
+	Token      *string    `db:"token"`
+	UpdatedAt  time.Time  `db:"updated_at"`
+	RemindedAt *time.Time `db:"reminded_at"`
+	PromoCode  *string    `db:"promo_code"`
 }
@@ -0,0 +1,17 @@
+// Cart event outbox table definition
+package schema
+
+import "time"
+
+// Describe cart event outbox table in postgres db
+type CartEvent struct {
+	ID           int64     `db:"id"`
+	EventType    string    `db:"event_type"`
+	UserID       int64     `db:"user_id"`
+	CartID       int64     `db:"cart_id"`
+	SKU          uint32    `db:"sku"`
+	Count        uint32    `db:"count"`
+	OrderID      int64     `db:"order_id"`
+	TraceContext []byte    `db:"trace_context"`
+	CreatedAt    time.Time `db:"created_at"`
+}
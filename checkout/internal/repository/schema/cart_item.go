@@ -1,9 +1,12 @@
 // Cart item table definition
 package schema
 
+import "time"
+
 // Describe cart item table in postgres
 type CartItem struct {
-	CartID int64  `db:"cart_id"`
-	SKU    uint32 `db:"sku"`
-	Count  uint16 `db:"count"`
+	CartID    int64     `db:"cart_id"`
+	SKU       uint32    `db:"sku"`
+	Count     uint16    `db:"count"`
+	UpdatedAt time.Time `db:"updated_at"`
 }
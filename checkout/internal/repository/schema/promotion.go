@@ -0,0 +1,19 @@
+// Promotion table definition
+package schema
+
+import "time"
+
+// Describe promotion table in postgres db
+type Promotion struct {
+	ID           int64      `db:"id"`
+	Code         string     `db:"code"`
+	Kind         string     `db:"kind"`
+	Value        uint32     `db:"value"`
+	SKU          uint32     `db:"sku"`
+	BuyCount     uint16     `db:"buy_count"`
+	PayCount     uint16     `db:"pay_count"`
+	MinCartTotal uint32     `db:"min_cart_total"`
+	UsageLimit   uint32     `db:"usage_limit"`
+	ValidFrom    *time.Time `db:"valid_from"`
+	ValidTo      *time.Time `db:"valid_to"`
+}
@@ -0,0 +1,12 @@
+// Saved item table definition
+package schema
+
+import "time"
+
+// Describe saved for later item table in postgres
+type SavedItem struct {
+	CartID  int64     `db:"cart_id"`
+	SKU     uint32    `db:"sku"`
+	Count   uint16    `db:"count"`
+	SavedAt time.Time `db:"saved_at"`
+}
@@ -0,0 +1,87 @@
+package sender
+
+import (
+	"encoding/json"
+	"fmt"
+	"route256/checkout/internal/model"
+	"route256/libs/kafka"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+// Describe cart event for analytics
+type CartEventMessage struct {
+	ID        int64     `json:"id"`
+	Type      string    `json:"type"`
+	UserID    int64     `json:"user_id"`
+	CartID    int64     `json:"cart_id"`
+	SKU       uint32    `json:"sku,omitempty"`
+	Count     uint32    `json:"count,omitempty"`
+	OrderID   int64     `json:"order_id,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// Define kafka sender of cart events
+type CartEventSender struct {
+	producer *kafka.Producer
+	topic    string
+}
+
+// Create new kafka sender of cart events
+func NewCartEventSender(producer *kafka.Producer, topic string) *CartEventSender {
+	return &CartEventSender{
+		producer: producer,
+		topic:    topic,
+	}
+}
+
+// Send pack of cart events to Kafka
+func (s *CartEventSender) SendCartEvents(events []model.CartEvent) error {
+	messages := make([]*sarama.ProducerMessage, 0, len(events))
+	for _, event := range events {
+		message, err := s.buildMessage(event)
+		if err != nil {
+			return errors.Wrap(err, "fail build message")
+		}
+		messages = append(messages, message)
+	}
+
+	err := s.producer.SendSyncMessages(messages)
+	if err != nil {
+		return errors.Wrap(err, "fail send messages")
+	}
+
+	return nil
+}
+
+// Create kafka message from cart event, events of one cart go to the same partition
+// and the trace context is passed in headers
+func (s *CartEventSender) buildMessage(event model.CartEvent) (*sarama.ProducerMessage, error) {
+	msg, err := json.Marshal(CartEventMessage{
+		ID:        event.ID,
+		Type:      string(event.Type),
+		UserID:    int64(event.UserID),
+		CartID:    int64(event.CartID),
+		SKU:       event.SKU,
+		Count:     event.Count,
+		OrderID:   int64(event.OrderID),
+		CreatedAt: event.CreatedAt,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "send message marshal error")
+	}
+
+	headers := make([]sarama.RecordHeader, 0, len(event.TraceContext))
+	for key, value := range event.TraceContext {
+		headers = append(headers, sarama.RecordHeader{Key: []byte(key), Value: []byte(value)})
+	}
+
+	return &sarama.ProducerMessage{
+		Topic:   s.topic,
+		Value:   sarama.ByteEncoder(msg),
+		Key:     sarama.StringEncoder(fmt.Sprint(event.CartID)),
+		Headers: headers,
+	}, nil
+}
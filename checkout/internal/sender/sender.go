@@ -0,0 +1,83 @@
+// Kafka sender
+package sender
+
+import (
+	"encoding/json"
+	"fmt"
+	"route256/checkout/internal/model"
+	"route256/libs/kafka"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+)
+
+// Describe abandoned cart event for the notifications service
+type AbandonedCartMessage struct {
+	// One reminder is sent per version of the cart
+	EventID   string            `json:"event_id"`
+	UserID    int64             `json:"user_id"`
+	CartID    int64             `json:"cart_id"`
+	UpdatedAt time.Time         `json:"updated_at"`
+	Items     []CartItemMessage `json:"items"`
+}
+
+// Describe an item of the abandoned cart
+type CartItemMessage struct {
+	SKU   uint32 `json:"sku"`
+	Count uint16 `json:"count"`
+}
+
+// Define kafka sender
+type KafkaSender struct {
+	producer *kafka.Producer
+	topic    string
+}
+
+// Create new kafka sender
+func NewKafkaSender(producer *kafka.Producer, topic string) *KafkaSender {
+	return &KafkaSender{
+		producer: producer,
+		topic:    topic,
+	}
+}
+
+// Send abandoned cart event to Kafka
+func (s *KafkaSender) SendAbandonedCart(cart model.AbandonedCart) error {
+	kafkaMsg, err := s.buildMessage(cart)
+	if err != nil {
+		return errors.Wrap(err, "fail build message")
+	}
+
+	_, _, err = s.producer.SendSyncMessage(kafkaMsg)
+	if err != nil {
+		return errors.Wrap(err, "fail send message")
+	}
+
+	return nil
+}
+
+// Create kafka message from abandoned cart, messages of one user go to the same partition
+func (s *KafkaSender) buildMessage(cart model.AbandonedCart) (*sarama.ProducerMessage, error) {
+	message := AbandonedCartMessage{
+		EventID:   fmt.Sprintf("abandoned_cart:%d:%d", cart.ID, cart.UpdatedAt.UnixMicro()),
+		UserID:    int64(cart.UserID),
+		CartID:    int64(cart.ID),
+		UpdatedAt: cart.UpdatedAt,
+		Items:     make([]CartItemMessage, len(cart.Items)),
+	}
+	for i, item := range cart.Items {
+		message.Items[i] = CartItemMessage{SKU: item.SKU, Count: item.Count}
+	}
+
+	msg, err := json.Marshal(message)
+	if err != nil {
+		return nil, errors.Wrap(err, "send message marshal error")
+	}
+
+	return &sarama.ProducerMessage{
+		Topic: s.topic,
+		Value: sarama.ByteEncoder(msg),
+		Key:   sarama.StringEncoder(fmt.Sprint(message.UserID)),
+	}, nil
+}
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cart ALTER COLUMN user_id DROP NOT NULL;
+ALTER TABLE cart ADD COLUMN token TEXT UNIQUE;
+ALTER TABLE cart ADD CONSTRAINT cart_owner_check CHECK (user_id IS NOT NULL OR token IS NOT NULL);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM cart_item WHERE cart_id IN (SELECT id FROM cart WHERE user_id IS NULL);
+DELETE FROM cart WHERE user_id IS NULL;
+ALTER TABLE cart DROP CONSTRAINT IF EXISTS cart_owner_check;
+ALTER TABLE cart DROP COLUMN IF EXISTS token;
+ALTER TABLE cart ALTER COLUMN user_id SET NOT NULL;
+-- +goose StatementEnd
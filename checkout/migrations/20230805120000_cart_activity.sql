@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE cart ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+ALTER TABLE cart ADD COLUMN reminded_at TIMESTAMPTZ;
+ALTER TABLE cart_item ADD COLUMN updated_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE INDEX IF NOT EXISTS cart_updated_at_idx ON cart (updated_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS cart_updated_at_idx;
+ALTER TABLE cart_item DROP COLUMN IF EXISTS updated_at;
+ALTER TABLE cart DROP COLUMN IF EXISTS reminded_at;
+ALTER TABLE cart DROP COLUMN IF EXISTS updated_at;
+-- +goose StatementEnd
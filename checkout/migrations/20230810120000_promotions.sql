@@ -0,0 +1,35 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS promotion (
+    id SERIAL PRIMARY KEY,
+    code TEXT NOT NULL UNIQUE,
+    kind TEXT NOT NULL CHECK (kind IN ('percent', 'fixed', 'n_for_m')),
+    "value" BIGINT NOT NULL DEFAULT 0,
+    sku BIGINT NOT NULL DEFAULT 0,
+    buy_count INT NOT NULL DEFAULT 0,
+    pay_count INT NOT NULL DEFAULT 0,
+    min_cart_total BIGINT NOT NULL DEFAULT 0,
+    usage_limit INT NOT NULL DEFAULT 0,
+    valid_from TIMESTAMPTZ,
+    valid_to TIMESTAMPTZ,
+    CHECK (kind <> 'n_for_m' OR (sku > 0 AND buy_count > pay_count AND pay_count >= 0))
+);
+
+CREATE TABLE IF NOT EXISTS promotion_usage (
+    promotion_id BIGINT NOT NULL,
+    user_id BIGINT NOT NULL,
+    order_id BIGINT NOT NULL,
+    used_at TIMESTAMPTZ NOT NULL DEFAULT now(),
+    PRIMARY KEY (promotion_id, order_id)
+);
+CREATE INDEX IF NOT EXISTS promotion_usage_user_idx ON promotion_usage (promotion_id, user_id);
+
+ALTER TABLE cart ADD COLUMN promo_code TEXT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE cart DROP COLUMN IF EXISTS promo_code;
+DROP TABLE IF EXISTS promotion_usage;
+DROP TABLE IF EXISTS promotion;
+-- +goose StatementEnd
@@ -0,0 +1,19 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS cart_event_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_type TEXT NOT NULL,
+    user_id BIGINT NOT NULL DEFAULT 0,
+    cart_id BIGINT NOT NULL,
+    sku BIGINT NOT NULL DEFAULT 0,
+    "count" BIGINT NOT NULL DEFAULT 0,
+    order_id BIGINT NOT NULL DEFAULT 0,
+    trace_context JSONB,
+    created_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS cart_event_outbox;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- A use is reserved before the order is created and gets its order afterwards
+ALTER TABLE promotion_usage DROP CONSTRAINT IF EXISTS promotion_usage_pkey;
+ALTER TABLE promotion_usage ADD COLUMN id BIGSERIAL PRIMARY KEY;
+ALTER TABLE promotion_usage ALTER COLUMN order_id DROP NOT NULL;
+CREATE UNIQUE INDEX IF NOT EXISTS promotion_usage_order_idx ON promotion_usage (promotion_id, order_id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM promotion_usage WHERE order_id IS NULL;
+DROP INDEX IF EXISTS promotion_usage_order_idx;
+ALTER TABLE promotion_usage DROP COLUMN IF EXISTS id;
+ALTER TABLE promotion_usage ALTER COLUMN order_id SET NOT NULL;
+ALTER TABLE promotion_usage ADD PRIMARY KEY (promotion_id, order_id);
+-- +goose StatementEnd
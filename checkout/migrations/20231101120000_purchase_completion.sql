@@ -0,0 +1,14 @@
+-- +goose Up
+-- +goose StatementBegin
+-- Orders recorded against their carts, repeated attempts to record an order are skipped
+CREATE TABLE IF NOT EXISTS purchase_completion (
+    order_id BIGINT PRIMARY KEY,
+    cart_id BIGINT NOT NULL,
+    completed_at TIMESTAMPTZ NOT NULL DEFAULT now()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS purchase_completion;
+-- +goose StatementEnd
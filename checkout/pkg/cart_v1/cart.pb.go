@@ -23,21 +23,183 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+type MergePolicy int32
+
+const (
+	MergePolicy_MERGE_POLICY_UNSPECIFIED  MergePolicy = 0
+	MergePolicy_MERGE_POLICY_SUM          MergePolicy = 1
+	MergePolicy_MERGE_POLICY_MAX          MergePolicy = 2
+	MergePolicy_MERGE_POLICY_PREFER_GUEST MergePolicy = 3
+)
+
+// Enum value maps for MergePolicy.
+var (
+	MergePolicy_name = map[int32]string{
+		0: "MERGE_POLICY_UNSPECIFIED",
+		1: "MERGE_POLICY_SUM",
+		2: "MERGE_POLICY_MAX",
+		3: "MERGE_POLICY_PREFER_GUEST",
+	}
+	MergePolicy_value = map[string]int32{
+		"MERGE_POLICY_UNSPECIFIED":  0,
+		"MERGE_POLICY_SUM":          1,
+		"MERGE_POLICY_MAX":          2,
+		"MERGE_POLICY_PREFER_GUEST": 3,
+	}
+)
+
+func (x MergePolicy) Enum() *MergePolicy {
+	p := new(MergePolicy)
+	*p = x
+	return p
+}
+
+func (x MergePolicy) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (MergePolicy) Descriptor() protoreflect.EnumDescriptor {
+	return file_cart_proto_enumTypes[0].Descriptor()
+}
+
+func (MergePolicy) Type() protoreflect.EnumType {
+	return &file_cart_proto_enumTypes[0]
+}
+
+func (x MergePolicy) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use MergePolicy.Descriptor instead.
+func (MergePolicy) EnumDescriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{0}
+}
+
+type ShippingMethod int32
+
+const (
+	ShippingMethod_SHIPPING_METHOD_UNSPECIFIED      ShippingMethod = 0
+	ShippingMethod_SHIPPING_METHOD_COURIER          ShippingMethod = 1
+	ShippingMethod_SHIPPING_METHOD_PICKUP_POINT     ShippingMethod = 2
+	ShippingMethod_SHIPPING_METHOD_WAREHOUSE_PICKUP ShippingMethod = 3
+)
+
+// Enum value maps for ShippingMethod.
+var (
+	ShippingMethod_name = map[int32]string{
+		0: "SHIPPING_METHOD_UNSPECIFIED",
+		1: "SHIPPING_METHOD_COURIER",
+		2: "SHIPPING_METHOD_PICKUP_POINT",
+		3: "SHIPPING_METHOD_WAREHOUSE_PICKUP",
+	}
+	ShippingMethod_value = map[string]int32{
+		"SHIPPING_METHOD_UNSPECIFIED":      0,
+		"SHIPPING_METHOD_COURIER":          1,
+		"SHIPPING_METHOD_PICKUP_POINT":     2,
+		"SHIPPING_METHOD_WAREHOUSE_PICKUP": 3,
+	}
+)
+
+func (x ShippingMethod) Enum() *ShippingMethod {
+	p := new(ShippingMethod)
+	*p = x
+	return p
+}
+
+func (x ShippingMethod) String() string {
+	return protoimpl.X.EnumStringOf(x.Descriptor(), protoreflect.EnumNumber(x))
+}
+
+func (ShippingMethod) Descriptor() protoreflect.EnumDescriptor {
+	return file_cart_proto_enumTypes[1].Descriptor()
+}
+
+func (ShippingMethod) Type() protoreflect.EnumType {
+	return &file_cart_proto_enumTypes[1]
+}
+
+func (x ShippingMethod) Number() protoreflect.EnumNumber {
+	return protoreflect.EnumNumber(x)
+}
+
+// Deprecated: Use ShippingMethod.Descriptor instead.
+func (ShippingMethod) EnumDescriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{1}
+}
+
+// Amount in minor units of the currency, e.g. kopecks for RUB
+type Money struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Amount int64 `protobuf:"varint,1,opt,name=amount,proto3" json:"amount,omitempty"`
+	// ISO 4217 currency code
+	Currency string `protobuf:"bytes,2,opt,name=currency,proto3" json:"currency,omitempty"`
+}
+
+func (x *Money) Reset() {
+	*x = Money{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[0]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *Money) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Money) ProtoMessage() {}
+
+func (x *Money) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[0]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Money.ProtoReflect.Descriptor instead.
+func (*Money) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Money) GetAmount() int64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Money) GetCurrency() string {
+	if x != nil {
+		return x.Currency
+	}
+	return ""
+}
+
 type CartGoodInfo struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Sku   uint32 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
-	Count uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
-	Name  string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
-	Price uint32 `protobuf:"varint,4,opt,name=price,proto3" json:"price,omitempty"`
+	Sku      uint32 `protobuf:"varint,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count    uint32 `protobuf:"varint,2,opt,name=count,proto3" json:"count,omitempty"`
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Price    *Money `protobuf:"bytes,6,opt,name=price,proto3" json:"price,omitempty"`
+	Discount *Money `protobuf:"bytes,7,opt,name=discount,proto3" json:"discount,omitempty"`
 }
 
 func (x *CartGoodInfo) Reset() {
 	*x = CartGoodInfo{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[0]
+		mi := &file_cart_proto_msgTypes[1]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -50,7 +212,7 @@ func (x *CartGoodInfo) String() string {
 func (*CartGoodInfo) ProtoMessage() {}
 
 func (x *CartGoodInfo) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[0]
+	mi := &file_cart_proto_msgTypes[1]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -63,7 +225,7 @@ func (x *CartGoodInfo) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use CartGoodInfo.ProtoReflect.Descriptor instead.
 func (*CartGoodInfo) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{0}
+	return file_cart_proto_rawDescGZIP(), []int{1}
 }
 
 func (x *CartGoodInfo) GetSku() uint32 {
@@ -87,11 +249,18 @@ func (x *CartGoodInfo) GetName() string {
 	return ""
 }
 
-func (x *CartGoodInfo) GetPrice() uint32 {
+func (x *CartGoodInfo) GetPrice() *Money {
 	if x != nil {
 		return x.Price
 	}
-	return 0
+	return nil
+}
+
+func (x *CartGoodInfo) GetDiscount() *Money {
+	if x != nil {
+		return x.Discount
+	}
+	return nil
 }
 
 type AddToCartRequest struct {
@@ -99,15 +268,18 @@ type AddToCartRequest struct {
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User  int64  `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
-	Sku   uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
-	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Types that are assignable to Owner:
+	//	*AddToCartRequest_User
+	//	*AddToCartRequest_CartToken
+	Owner isAddToCartRequest_Owner `protobuf_oneof:"owner"`
+	Sku   uint32                   `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count uint32                   `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *AddToCartRequest) Reset() {
 	*x = AddToCartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[1]
+		mi := &file_cart_proto_msgTypes[2]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -120,7 +292,7 @@ func (x *AddToCartRequest) String() string {
 func (*AddToCartRequest) ProtoMessage() {}
 
 func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[1]
+	mi := &file_cart_proto_msgTypes[2]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -133,16 +305,30 @@ func (x *AddToCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use AddToCartRequest.ProtoReflect.Descriptor instead.
 func (*AddToCartRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{1}
+	return file_cart_proto_rawDescGZIP(), []int{2}
+}
+
+func (m *AddToCartRequest) GetOwner() isAddToCartRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
 }
 
 func (x *AddToCartRequest) GetUser() int64 {
-	if x != nil {
+	if x, ok := x.GetOwner().(*AddToCartRequest_User); ok {
 		return x.User
 	}
 	return 0
 }
 
+func (x *AddToCartRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*AddToCartRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
 func (x *AddToCartRequest) GetSku() uint32 {
 	if x != nil {
 		return x.Sku
@@ -157,20 +343,39 @@ func (x *AddToCartRequest) GetCount() uint32 {
 	return 0
 }
 
+type isAddToCartRequest_Owner interface {
+	isAddToCartRequest_Owner()
+}
+
+type AddToCartRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type AddToCartRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,4,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*AddToCartRequest_User) isAddToCartRequest_Owner() {}
+
+func (*AddToCartRequest_CartToken) isAddToCartRequest_Owner() {}
+
 type DeleteFromCartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User  int64  `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
-	Sku   uint32 `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
-	Count uint32 `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
+	// Types that are assignable to Owner:
+	//	*DeleteFromCartRequest_User
+	//	*DeleteFromCartRequest_CartToken
+	Owner isDeleteFromCartRequest_Owner `protobuf_oneof:"owner"`
+	Sku   uint32                        `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count uint32                        `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
 func (x *DeleteFromCartRequest) Reset() {
 	*x = DeleteFromCartRequest{}
 	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[2]
+		mi := &file_cart_proto_msgTypes[3]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		ms.StoreMessageInfo(mi)
 	}
@@ -183,7 +388,7 @@ func (x *DeleteFromCartRequest) String() string {
 func (*DeleteFromCartRequest) ProtoMessage() {}
 
 func (x *DeleteFromCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[2]
+	mi := &file_cart_proto_msgTypes[3]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -196,16 +401,30 @@ func (x *DeleteFromCartRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use DeleteFromCartRequest.ProtoReflect.Descriptor instead.
 func (*DeleteFromCartRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{2}
+	return file_cart_proto_rawDescGZIP(), []int{3}
+}
+
+func (m *DeleteFromCartRequest) GetOwner() isDeleteFromCartRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
 }
 
 func (x *DeleteFromCartRequest) GetUser() int64 {
-	if x != nil {
+	if x, ok := x.GetOwner().(*DeleteFromCartRequest_User); ok {
 		return x.User
 	}
 	return 0
 }
 
+func (x *DeleteFromCartRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*DeleteFromCartRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
 func (x *DeleteFromCartRequest) GetSku() uint32 {
 	if x != nil {
 		return x.Sku
@@ -220,64 +439,37 @@ func (x *DeleteFromCartRequest) GetCount() uint32 {
 	return 0
 }
 
-type ListCartRequest struct {
-	state         protoimpl.MessageState
-	sizeCache     protoimpl.SizeCache
-	unknownFields protoimpl.UnknownFields
-
-	User int64 `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
-}
-
-func (x *ListCartRequest) Reset() {
-	*x = ListCartRequest{}
-	if protoimpl.UnsafeEnabled {
-		mi := &file_cart_proto_msgTypes[3]
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		ms.StoreMessageInfo(mi)
-	}
+type isDeleteFromCartRequest_Owner interface {
+	isDeleteFromCartRequest_Owner()
 }
 
-func (x *ListCartRequest) String() string {
-	return protoimpl.X.MessageStringOf(x)
+type DeleteFromCartRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
 }
 
-func (*ListCartRequest) ProtoMessage() {}
-
-func (x *ListCartRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_cart_proto_msgTypes[3]
-	if protoimpl.UnsafeEnabled && x != nil {
-		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
-		if ms.LoadMessageInfo() == nil {
-			ms.StoreMessageInfo(mi)
-		}
-		return ms
-	}
-	return mi.MessageOf(x)
+type DeleteFromCartRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,4,opt,name=cartToken,proto3,oneof"`
 }
 
-// Deprecated: Use ListCartRequest.ProtoReflect.Descriptor instead.
-func (*ListCartRequest) Descriptor() ([]byte, []int) {
-	return file_cart_proto_rawDescGZIP(), []int{3}
-}
+func (*DeleteFromCartRequest_User) isDeleteFromCartRequest_Owner() {}
 
-func (x *ListCartRequest) GetUser() int64 {
-	if x != nil {
-		return x.User
-	}
-	return 0
-}
+func (*DeleteFromCartRequest_CartToken) isDeleteFromCartRequest_Owner() {}
 
-type ListCartResponse struct {
+type SetCartItemCountRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	Items      []*CartGoodInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
-	TotalPrice uint32          `protobuf:"varint,2,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	// Types that are assignable to Owner:
+	//	*SetCartItemCountRequest_User
+	//	*SetCartItemCountRequest_CartToken
+	Owner isSetCartItemCountRequest_Owner `protobuf_oneof:"owner"`
+	Sku   uint32                          `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Count uint32                          `protobuf:"varint,3,opt,name=count,proto3" json:"count,omitempty"`
 }
 
-func (x *ListCartResponse) Reset() {
-	*x = ListCartResponse{}
+func (x *SetCartItemCountRequest) Reset() {
+	*x = SetCartItemCountRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cart_proto_msgTypes[4]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -285,13 +477,13 @@ func (x *ListCartResponse) Reset() {
 	}
 }
 
-func (x *ListCartResponse) String() string {
+func (x *SetCartItemCountRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*ListCartResponse) ProtoMessage() {}
+func (*SetCartItemCountRequest) ProtoMessage() {}
 
-func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
+func (x *SetCartItemCountRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cart_proto_msgTypes[4]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -303,35 +495,76 @@ func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use ListCartResponse.ProtoReflect.Descriptor instead.
-func (*ListCartResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use SetCartItemCountRequest.ProtoReflect.Descriptor instead.
+func (*SetCartItemCountRequest) Descriptor() ([]byte, []int) {
 	return file_cart_proto_rawDescGZIP(), []int{4}
 }
 
-func (x *ListCartResponse) GetItems() []*CartGoodInfo {
-	if x != nil {
-		return x.Items
+func (m *SetCartItemCountRequest) GetOwner() isSetCartItemCountRequest_Owner {
+	if m != nil {
+		return m.Owner
 	}
 	return nil
 }
 
-func (x *ListCartResponse) GetTotalPrice() uint32 {
+func (x *SetCartItemCountRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*SetCartItemCountRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *SetCartItemCountRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*SetCartItemCountRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *SetCartItemCountRequest) GetSku() uint32 {
 	if x != nil {
-		return x.TotalPrice
+		return x.Sku
 	}
 	return 0
 }
 
-type PurchaseRequest struct {
+func (x *SetCartItemCountRequest) GetCount() uint32 {
+	if x != nil {
+		return x.Count
+	}
+	return 0
+}
+
+type isSetCartItemCountRequest_Owner interface {
+	isSetCartItemCountRequest_Owner()
+}
+
+type SetCartItemCountRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type SetCartItemCountRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,4,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*SetCartItemCountRequest_User) isSetCartItemCountRequest_Owner() {}
+
+func (*SetCartItemCountRequest_CartToken) isSetCartItemCountRequest_Owner() {}
+
+type RemoveCartItemRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	User int64 `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	// Types that are assignable to Owner:
+	//	*RemoveCartItemRequest_User
+	//	*RemoveCartItemRequest_CartToken
+	Owner isRemoveCartItemRequest_Owner `protobuf_oneof:"owner"`
+	Sku   uint32                        `protobuf:"varint,2,opt,name=sku,proto3" json:"sku,omitempty"`
 }
 
-func (x *PurchaseRequest) Reset() {
-	*x = PurchaseRequest{}
+func (x *RemoveCartItemRequest) Reset() {
+	*x = RemoveCartItemRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cart_proto_msgTypes[5]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -339,13 +572,13 @@ func (x *PurchaseRequest) Reset() {
 	}
 }
 
-func (x *PurchaseRequest) String() string {
+func (x *RemoveCartItemRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurchaseRequest) ProtoMessage() {}
+func (*RemoveCartItemRequest) ProtoMessage() {}
 
-func (x *PurchaseRequest) ProtoReflect() protoreflect.Message {
+func (x *RemoveCartItemRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cart_proto_msgTypes[5]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -357,28 +590,68 @@ func (x *PurchaseRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurchaseRequest.ProtoReflect.Descriptor instead.
-func (*PurchaseRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use RemoveCartItemRequest.ProtoReflect.Descriptor instead.
+func (*RemoveCartItemRequest) Descriptor() ([]byte, []int) {
 	return file_cart_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *PurchaseRequest) GetUser() int64 {
-	if x != nil {
+func (m *RemoveCartItemRequest) GetOwner() isRemoveCartItemRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *RemoveCartItemRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*RemoveCartItemRequest_User); ok {
 		return x.User
 	}
 	return 0
 }
 
-type PurchaseResponse struct {
+func (x *RemoveCartItemRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*RemoveCartItemRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *RemoveCartItemRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+type isRemoveCartItemRequest_Owner interface {
+	isRemoveCartItemRequest_Owner()
+}
+
+type RemoveCartItemRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type RemoveCartItemRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,3,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*RemoveCartItemRequest_User) isRemoveCartItemRequest_Owner() {}
+
+func (*RemoveCartItemRequest_CartToken) isRemoveCartItemRequest_Owner() {}
+
+type ClearCartRequest struct {
 	state         protoimpl.MessageState
 	sizeCache     protoimpl.SizeCache
 	unknownFields protoimpl.UnknownFields
 
-	OrderID int64 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+	// Types that are assignable to Owner:
+	//	*ClearCartRequest_User
+	//	*ClearCartRequest_CartToken
+	Owner isClearCartRequest_Owner `protobuf_oneof:"owner"`
 }
 
-func (x *PurchaseResponse) Reset() {
-	*x = PurchaseResponse{}
+func (x *ClearCartRequest) Reset() {
+	*x = ClearCartRequest{}
 	if protoimpl.UnsafeEnabled {
 		mi := &file_cart_proto_msgTypes[6]
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -386,13 +659,13 @@ func (x *PurchaseResponse) Reset() {
 	}
 }
 
-func (x *PurchaseResponse) String() string {
+func (x *ClearCartRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*PurchaseResponse) ProtoMessage() {}
+func (*ClearCartRequest) ProtoMessage() {}
 
-func (x *PurchaseResponse) ProtoReflect() protoreflect.Message {
+func (x *ClearCartRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_cart_proto_msgTypes[6]
 	if protoimpl.UnsafeEnabled && x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -404,18 +677,1380 @@ func (x *PurchaseResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use PurchaseResponse.ProtoReflect.Descriptor instead.
-func (*PurchaseResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ClearCartRequest.ProtoReflect.Descriptor instead.
+func (*ClearCartRequest) Descriptor() ([]byte, []int) {
 	return file_cart_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *PurchaseResponse) GetOrderID() int64 {
-	if x != nil {
-		return x.OrderID
+func (m *ClearCartRequest) GetOwner() isClearCartRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *ClearCartRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*ClearCartRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *ClearCartRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*ClearCartRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
+type isClearCartRequest_Owner interface {
+	isClearCartRequest_Owner()
+}
+
+type ClearCartRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type ClearCartRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,2,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*ClearCartRequest_User) isClearCartRequest_Owner() {}
+
+func (*ClearCartRequest_CartToken) isClearCartRequest_Owner() {}
+
+type ListCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*ListCartRequest_User
+	//	*ListCartRequest_CartToken
+	Owner isListCartRequest_Owner `protobuf_oneof:"owner"`
+}
+
+func (x *ListCartRequest) Reset() {
+	*x = ListCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[7]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartRequest) ProtoMessage() {}
+
+func (x *ListCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[7]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartRequest.ProtoReflect.Descriptor instead.
+func (*ListCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{7}
+}
+
+func (m *ListCartRequest) GetOwner() isListCartRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *ListCartRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*ListCartRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *ListCartRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*ListCartRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
+type isListCartRequest_Owner interface {
+	isListCartRequest_Owner()
+}
+
+type ListCartRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type ListCartRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,2,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*ListCartRequest_User) isListCartRequest_Owner() {}
+
+func (*ListCartRequest_CartToken) isListCartRequest_Owner() {}
+
+type ListCartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items         []*CartGoodInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	TotalPrice    *Money          `protobuf:"bytes,7,opt,name=totalPrice,proto3" json:"totalPrice,omitempty"`
+	PromoCode     string          `protobuf:"bytes,3,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+	PromoApplied  bool            `protobuf:"varint,4,opt,name=promoApplied,proto3" json:"promoApplied,omitempty"`
+	CartDiscount  *Money          `protobuf:"bytes,8,opt,name=cartDiscount,proto3" json:"cartDiscount,omitempty"`
+	TotalDiscount *Money          `protobuf:"bytes,9,opt,name=totalDiscount,proto3" json:"totalDiscount,omitempty"`
+}
+
+func (x *ListCartResponse) Reset() {
+	*x = ListCartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[8]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListCartResponse) ProtoMessage() {}
+
+func (x *ListCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[8]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListCartResponse.ProtoReflect.Descriptor instead.
+func (*ListCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ListCartResponse) GetItems() []*CartGoodInfo {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ListCartResponse) GetTotalPrice() *Money {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return nil
+}
+
+func (x *ListCartResponse) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+func (x *ListCartResponse) GetPromoApplied() bool {
+	if x != nil {
+		return x.PromoApplied
+	}
+	return false
+}
+
+func (x *ListCartResponse) GetCartDiscount() *Money {
+	if x != nil {
+		return x.CartDiscount
+	}
+	return nil
+}
+
+func (x *ListCartResponse) GetTotalDiscount() *Money {
+	if x != nil {
+		return x.TotalDiscount
+	}
+	return nil
+}
+
+type CreateGuestCartResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	CartToken string `protobuf:"bytes,1,opt,name=cartToken,proto3" json:"cartToken,omitempty"`
+}
+
+func (x *CreateGuestCartResponse) Reset() {
+	*x = CreateGuestCartResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[9]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateGuestCartResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateGuestCartResponse) ProtoMessage() {}
+
+func (x *CreateGuestCartResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[9]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateGuestCartResponse.ProtoReflect.Descriptor instead.
+func (*CreateGuestCartResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *CreateGuestCartResponse) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+type MergeCartsRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      int64       `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	CartToken string      `protobuf:"bytes,2,opt,name=cartToken,proto3" json:"cartToken,omitempty"`
+	Policy    MergePolicy `protobuf:"varint,3,opt,name=policy,proto3,enum=cart.MergePolicy" json:"policy,omitempty"`
+}
+
+func (x *MergeCartsRequest) Reset() {
+	*x = MergeCartsRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[10]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MergeCartsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MergeCartsRequest) ProtoMessage() {}
+
+func (x *MergeCartsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[10]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MergeCartsRequest.ProtoReflect.Descriptor instead.
+func (*MergeCartsRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *MergeCartsRequest) GetUser() int64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *MergeCartsRequest) GetCartToken() string {
+	if x != nil {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *MergeCartsRequest) GetPolicy() MergePolicy {
+	if x != nil {
+		return x.Policy
+	}
+	return MergePolicy_MERGE_POLICY_UNSPECIFIED
+}
+
+type ApplyPromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*ApplyPromoCodeRequest_User
+	//	*ApplyPromoCodeRequest_CartToken
+	Owner     isApplyPromoCodeRequest_Owner `protobuf_oneof:"owner"`
+	PromoCode string                        `protobuf:"bytes,3,opt,name=promoCode,proto3" json:"promoCode,omitempty"`
+}
+
+func (x *ApplyPromoCodeRequest) Reset() {
+	*x = ApplyPromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[11]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ApplyPromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ApplyPromoCodeRequest) ProtoMessage() {}
+
+func (x *ApplyPromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[11]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ApplyPromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*ApplyPromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{11}
+}
+
+func (m *ApplyPromoCodeRequest) GetOwner() isApplyPromoCodeRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *ApplyPromoCodeRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*ApplyPromoCodeRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *ApplyPromoCodeRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*ApplyPromoCodeRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *ApplyPromoCodeRequest) GetPromoCode() string {
+	if x != nil {
+		return x.PromoCode
+	}
+	return ""
+}
+
+type isApplyPromoCodeRequest_Owner interface {
+	isApplyPromoCodeRequest_Owner()
+}
+
+type ApplyPromoCodeRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type ApplyPromoCodeRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,2,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*ApplyPromoCodeRequest_User) isApplyPromoCodeRequest_Owner() {}
+
+func (*ApplyPromoCodeRequest_CartToken) isApplyPromoCodeRequest_Owner() {}
+
+type RemovePromoCodeRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*RemovePromoCodeRequest_User
+	//	*RemovePromoCodeRequest_CartToken
+	Owner isRemovePromoCodeRequest_Owner `protobuf_oneof:"owner"`
+}
+
+func (x *RemovePromoCodeRequest) Reset() {
+	*x = RemovePromoCodeRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[12]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *RemovePromoCodeRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemovePromoCodeRequest) ProtoMessage() {}
+
+func (x *RemovePromoCodeRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[12]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemovePromoCodeRequest.ProtoReflect.Descriptor instead.
+func (*RemovePromoCodeRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{12}
+}
+
+func (m *RemovePromoCodeRequest) GetOwner() isRemovePromoCodeRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *RemovePromoCodeRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*RemovePromoCodeRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *RemovePromoCodeRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*RemovePromoCodeRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
+type isRemovePromoCodeRequest_Owner interface {
+	isRemovePromoCodeRequest_Owner()
+}
+
+type RemovePromoCodeRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type RemovePromoCodeRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,2,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*RemovePromoCodeRequest_User) isRemovePromoCodeRequest_Owner() {}
+
+func (*RemovePromoCodeRequest_CartToken) isRemovePromoCodeRequest_Owner() {}
+
+type DeliveryAddress struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Id         int64  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	City       string `protobuf:"bytes,2,opt,name=city,proto3" json:"city,omitempty"`
+	Street     string `protobuf:"bytes,3,opt,name=street,proto3" json:"street,omitempty"`
+	House      string `protobuf:"bytes,4,opt,name=house,proto3" json:"house,omitempty"`
+	Apartment  string `protobuf:"bytes,5,opt,name=apartment,proto3" json:"apartment,omitempty"`
+	PostalCode string `protobuf:"bytes,6,opt,name=postalCode,proto3" json:"postalCode,omitempty"`
+	Comment    string `protobuf:"bytes,7,opt,name=comment,proto3" json:"comment,omitempty"`
+}
+
+func (x *DeliveryAddress) Reset() {
+	*x = DeliveryAddress{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[13]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeliveryAddress) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeliveryAddress) ProtoMessage() {}
+
+func (x *DeliveryAddress) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[13]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeliveryAddress.ProtoReflect.Descriptor instead.
+func (*DeliveryAddress) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *DeliveryAddress) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *DeliveryAddress) GetCity() string {
+	if x != nil {
+		return x.City
+	}
+	return ""
+}
+
+func (x *DeliveryAddress) GetStreet() string {
+	if x != nil {
+		return x.Street
+	}
+	return ""
+}
+
+func (x *DeliveryAddress) GetHouse() string {
+	if x != nil {
+		return x.House
+	}
+	return ""
+}
+
+func (x *DeliveryAddress) GetApartment() string {
+	if x != nil {
+		return x.Apartment
+	}
+	return ""
+}
+
+func (x *DeliveryAddress) GetPostalCode() string {
+	if x != nil {
+		return x.PostalCode
+	}
+	return ""
+}
+
+func (x *DeliveryAddress) GetComment() string {
+	if x != nil {
+		return x.Comment
+	}
+	return ""
+}
+
+type CreateAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User    int64            `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	Address *DeliveryAddress `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *CreateAddressRequest) Reset() {
+	*x = CreateAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[14]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddressRequest) ProtoMessage() {}
+
+func (x *CreateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[14]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddressRequest.ProtoReflect.Descriptor instead.
+func (*CreateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateAddressRequest) GetUser() int64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *CreateAddressRequest) GetAddress() *DeliveryAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type CreateAddressResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	AddressID int64 `protobuf:"varint,1,opt,name=addressID,proto3" json:"addressID,omitempty"`
+}
+
+func (x *CreateAddressResponse) Reset() {
+	*x = CreateAddressResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[15]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *CreateAddressResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateAddressResponse) ProtoMessage() {}
+
+func (x *CreateAddressResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[15]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateAddressResponse.ProtoReflect.Descriptor instead.
+func (*CreateAddressResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateAddressResponse) GetAddressID() int64 {
+	if x != nil {
+		return x.AddressID
+	}
+	return 0
+}
+
+type ListAddressesRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User int64 `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+}
+
+func (x *ListAddressesRequest) Reset() {
+	*x = ListAddressesRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[16]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAddressesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesRequest) ProtoMessage() {}
+
+func (x *ListAddressesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[16]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesRequest.ProtoReflect.Descriptor instead.
+func (*ListAddressesRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *ListAddressesRequest) GetUser() int64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+type ListAddressesResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Addresses []*DeliveryAddress `protobuf:"bytes,1,rep,name=addresses,proto3" json:"addresses,omitempty"`
+}
+
+func (x *ListAddressesResponse) Reset() {
+	*x = ListAddressesResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[17]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListAddressesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListAddressesResponse) ProtoMessage() {}
+
+func (x *ListAddressesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[17]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListAddressesResponse.ProtoReflect.Descriptor instead.
+func (*ListAddressesResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *ListAddressesResponse) GetAddresses() []*DeliveryAddress {
+	if x != nil {
+		return x.Addresses
+	}
+	return nil
+}
+
+type UpdateAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      int64            `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	AddressID int64            `protobuf:"varint,2,opt,name=addressID,proto3" json:"addressID,omitempty"`
+	Address   *DeliveryAddress `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (x *UpdateAddressRequest) Reset() {
+	*x = UpdateAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[18]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *UpdateAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateAddressRequest) ProtoMessage() {}
+
+func (x *UpdateAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[18]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateAddressRequest.ProtoReflect.Descriptor instead.
+func (*UpdateAddressRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *UpdateAddressRequest) GetUser() int64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *UpdateAddressRequest) GetAddressID() int64 {
+	if x != nil {
+		return x.AddressID
+	}
+	return 0
+}
+
+func (x *UpdateAddressRequest) GetAddress() *DeliveryAddress {
+	if x != nil {
+		return x.Address
+	}
+	return nil
+}
+
+type DeleteAddressRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User      int64 `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	AddressID int64 `protobuf:"varint,2,opt,name=addressID,proto3" json:"addressID,omitempty"`
+}
+
+func (x *DeleteAddressRequest) Reset() {
+	*x = DeleteAddressRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[19]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *DeleteAddressRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteAddressRequest) ProtoMessage() {}
+
+func (x *DeleteAddressRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[19]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteAddressRequest.ProtoReflect.Descriptor instead.
+func (*DeleteAddressRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *DeleteAddressRequest) GetUser() int64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *DeleteAddressRequest) GetAddressID() int64 {
+	if x != nil {
+		return x.AddressID
+	}
+	return 0
+}
+
+type PurchaseRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	User           int64          `protobuf:"varint,1,opt,name=user,proto3" json:"user,omitempty"`
+	AddressID      int64          `protobuf:"varint,2,opt,name=addressID,proto3" json:"addressID,omitempty"`
+	ShippingMethod ShippingMethod `protobuf:"varint,3,opt,name=shippingMethod,proto3,enum=cart.ShippingMethod" json:"shippingMethod,omitempty"`
+}
+
+func (x *PurchaseRequest) Reset() {
+	*x = PurchaseRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[20]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseRequest) ProtoMessage() {}
+
+func (x *PurchaseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[20]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseRequest.ProtoReflect.Descriptor instead.
+func (*PurchaseRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *PurchaseRequest) GetUser() int64 {
+	if x != nil {
+		return x.User
+	}
+	return 0
+}
+
+func (x *PurchaseRequest) GetAddressID() int64 {
+	if x != nil {
+		return x.AddressID
+	}
+	return 0
+}
+
+func (x *PurchaseRequest) GetShippingMethod() ShippingMethod {
+	if x != nil {
+		return x.ShippingMethod
+	}
+	return ShippingMethod_SHIPPING_METHOD_UNSPECIFIED
+}
+
+type PurchaseResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	OrderID int64 `protobuf:"varint,1,opt,name=orderID,proto3" json:"orderID,omitempty"`
+}
+
+func (x *PurchaseResponse) Reset() {
+	*x = PurchaseResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[21]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *PurchaseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PurchaseResponse) ProtoMessage() {}
+
+func (x *PurchaseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[21]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PurchaseResponse.ProtoReflect.Descriptor instead.
+func (*PurchaseResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *PurchaseResponse) GetOrderID() int64 {
+	if x != nil {
+		return x.OrderID
+	}
+	return 0
+}
+
+type SaveForLaterRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*SaveForLaterRequest_User
+	//	*SaveForLaterRequest_CartToken
+	Owner isSaveForLaterRequest_Owner `protobuf_oneof:"owner"`
+	Sku   uint32                      `protobuf:"varint,3,opt,name=sku,proto3" json:"sku,omitempty"`
+}
+
+func (x *SaveForLaterRequest) Reset() {
+	*x = SaveForLaterRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[22]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SaveForLaterRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SaveForLaterRequest) ProtoMessage() {}
+
+func (x *SaveForLaterRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[22]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SaveForLaterRequest.ProtoReflect.Descriptor instead.
+func (*SaveForLaterRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{22}
+}
+
+func (m *SaveForLaterRequest) GetOwner() isSaveForLaterRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *SaveForLaterRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*SaveForLaterRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *SaveForLaterRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*SaveForLaterRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *SaveForLaterRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+type isSaveForLaterRequest_Owner interface {
+	isSaveForLaterRequest_Owner()
+}
+
+type SaveForLaterRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type SaveForLaterRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,2,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*SaveForLaterRequest_User) isSaveForLaterRequest_Owner() {}
+
+func (*SaveForLaterRequest_CartToken) isSaveForLaterRequest_Owner() {}
+
+type MoveToCartRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*MoveToCartRequest_User
+	//	*MoveToCartRequest_CartToken
+	Owner isMoveToCartRequest_Owner `protobuf_oneof:"owner"`
+	Sku   uint32                    `protobuf:"varint,3,opt,name=sku,proto3" json:"sku,omitempty"`
+}
+
+func (x *MoveToCartRequest) Reset() {
+	*x = MoveToCartRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[23]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *MoveToCartRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MoveToCartRequest) ProtoMessage() {}
+
+func (x *MoveToCartRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[23]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MoveToCartRequest.ProtoReflect.Descriptor instead.
+func (*MoveToCartRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{23}
+}
+
+func (m *MoveToCartRequest) GetOwner() isMoveToCartRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *MoveToCartRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*MoveToCartRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *MoveToCartRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*MoveToCartRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
+func (x *MoveToCartRequest) GetSku() uint32 {
+	if x != nil {
+		return x.Sku
+	}
+	return 0
+}
+
+type isMoveToCartRequest_Owner interface {
+	isMoveToCartRequest_Owner()
+}
+
+type MoveToCartRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type MoveToCartRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,2,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*MoveToCartRequest_User) isMoveToCartRequest_Owner() {}
+
+func (*MoveToCartRequest_CartToken) isMoveToCartRequest_Owner() {}
+
+type ListSavedRequest struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	// Types that are assignable to Owner:
+	//	*ListSavedRequest_User
+	//	*ListSavedRequest_CartToken
+	Owner isListSavedRequest_Owner `protobuf_oneof:"owner"`
+}
+
+func (x *ListSavedRequest) Reset() {
+	*x = ListSavedRequest{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[24]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSavedRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedRequest) ProtoMessage() {}
+
+func (x *ListSavedRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[24]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedRequest.ProtoReflect.Descriptor instead.
+func (*ListSavedRequest) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{24}
+}
+
+func (m *ListSavedRequest) GetOwner() isListSavedRequest_Owner {
+	if m != nil {
+		return m.Owner
+	}
+	return nil
+}
+
+func (x *ListSavedRequest) GetUser() int64 {
+	if x, ok := x.GetOwner().(*ListSavedRequest_User); ok {
+		return x.User
+	}
+	return 0
+}
+
+func (x *ListSavedRequest) GetCartToken() string {
+	if x, ok := x.GetOwner().(*ListSavedRequest_CartToken); ok {
+		return x.CartToken
+	}
+	return ""
+}
+
+type isListSavedRequest_Owner interface {
+	isListSavedRequest_Owner()
+}
+
+type ListSavedRequest_User struct {
+	User int64 `protobuf:"varint,1,opt,name=user,proto3,oneof"`
+}
+
+type ListSavedRequest_CartToken struct {
+	CartToken string `protobuf:"bytes,2,opt,name=cartToken,proto3,oneof"`
+}
+
+func (*ListSavedRequest_User) isListSavedRequest_Owner() {}
+
+func (*ListSavedRequest_CartToken) isListSavedRequest_Owner() {}
+
+type SavedItem struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Item *CartGoodInfo `protobuf:"bytes,1,opt,name=item,proto3" json:"item,omitempty"`
+	// Units available in all warehouses
+	InStock uint64 `protobuf:"varint,2,opt,name=inStock,proto3" json:"inStock,omitempty"`
+	// All saved units can be moved back to the cart
+	Available bool `protobuf:"varint,3,opt,name=available,proto3" json:"available,omitempty"`
+}
+
+func (x *SavedItem) Reset() {
+	*x = SavedItem{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[25]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *SavedItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SavedItem) ProtoMessage() {}
+
+func (x *SavedItem) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[25]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SavedItem.ProtoReflect.Descriptor instead.
+func (*SavedItem) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *SavedItem) GetItem() *CartGoodInfo {
+	if x != nil {
+		return x.Item
+	}
+	return nil
+}
+
+func (x *SavedItem) GetInStock() uint64 {
+	if x != nil {
+		return x.InStock
 	}
 	return 0
 }
 
+func (x *SavedItem) GetAvailable() bool {
+	if x != nil {
+		return x.Available
+	}
+	return false
+}
+
+type ListSavedResponse struct {
+	state         protoimpl.MessageState
+	sizeCache     protoimpl.SizeCache
+	unknownFields protoimpl.UnknownFields
+
+	Items []*SavedItem `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+}
+
+func (x *ListSavedResponse) Reset() {
+	*x = ListSavedResponse{}
+	if protoimpl.UnsafeEnabled {
+		mi := &file_cart_proto_msgTypes[26]
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		ms.StoreMessageInfo(mi)
+	}
+}
+
+func (x *ListSavedResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListSavedResponse) ProtoMessage() {}
+
+func (x *ListSavedResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_cart_proto_msgTypes[26]
+	if protoimpl.UnsafeEnabled && x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListSavedResponse.ProtoReflect.Descriptor instead.
+func (*ListSavedResponse) Descriptor() ([]byte, []int) {
+	return file_cart_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *ListSavedResponse) GetItems() []*SavedItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
 var File_cart_proto protoreflect.FileDescriptor
 
 var file_cart_proto_rawDesc = []byte{
@@ -425,69 +2060,348 @@ var file_cart_proto_rawDesc = []byte{
 	0x1a, 0x1b, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2f, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75,
 	0x66, 0x2f, 0x65, 0x6d, 0x70, 0x74, 0x79, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x1a, 0x17, 0x76,
 	0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65, 0x2f, 0x76, 0x61, 0x6c, 0x69, 0x64, 0x61, 0x74, 0x65,
-	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x84, 0x01, 0x0a, 0x0c, 0x43, 0x61, 0x72, 0x74, 0x47,
-	0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x01,
-	0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73,
-	0x6b, 0x75, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28,
-	0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e,
-	0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x03, 0x52, 0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x1d,
-	0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa,
-	0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x22, 0x69, 0x0a,
-	0x10, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x19,
-	0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x22, 0x45, 0x0a, 0x05, 0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x12,
+	0x16, 0x0a, 0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52,
+	0x06, 0x61, 0x6d, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x24, 0x0a, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65,
+	0x6e, 0x63, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03,
+	0x98, 0x01, 0x03, 0x52, 0x08, 0x63, 0x75, 0x72, 0x72, 0x65, 0x6e, 0x63, 0x79, 0x22, 0xc7, 0x01,
+	0x0a, 0x0c, 0x43, 0x61, 0x72, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x12, 0x19,
+	0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04,
 	0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75,
-	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20,
-	0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x15, 0x44, 0x65, 0x6c, 0x65,
-	0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
-	0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
-	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x19,
+	0x6e, 0x74, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20,
+	0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x6e, 0x61, 0x6d, 0x65,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x03, 0x52,
+	0x04, 0x6e, 0x61, 0x6d, 0x65, 0x12, 0x2b, 0x0a, 0x05, 0x70, 0x72, 0x69, 0x63, 0x65, 0x18, 0x06,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x05, 0x70, 0x72, 0x69,
+	0x63, 0x65, 0x12, 0x27, 0x0a, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x07,
+	0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4d, 0x6f, 0x6e, 0x65,
+	0x79, 0x52, 0x08, 0x64, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x04, 0x10,
+	0x05, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06, 0x22, 0xa2, 0x01, 0x0a, 0x10, 0x41, 0x64, 0x64, 0x54,
+	0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x09, 0x63,
+	0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28,
+	0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12,
+	0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0c,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xa7, 0x01, 0x0a,
+	0x15, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19,
 	0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04,
 	0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x1d, 0x0a, 0x05, 0x63, 0x6f, 0x75,
 	0x6e, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20,
-	0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x22, 0x2e, 0x0a, 0x0f, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75,
-	0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02,
-	0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x5c, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74,
-	0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05,
-	0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61,
-	0x72, 0x74, 0x2e, 0x43, 0x61, 0x72, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52,
-	0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x12, 0x1e, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50,
-	0x72, 0x69, 0x63, 0x65, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61,
-	0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x22, 0x2e, 0x0a, 0x0f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65,
-	0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
-	0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x22, 0x2c, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
-	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72,
-	0x64, 0x65, 0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64,
-	0x65, 0x72, 0x49, 0x44, 0x32, 0xe6, 0x02, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x74, 0x12, 0x52, 0x0a,
-	0x09, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x63, 0x61, 0x72,
-	0x74, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x00, 0x52, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65,
+	0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xab, 0x01, 0x0a, 0x17, 0x53, 0x65, 0x74, 0x43, 0x61,
+	0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65,
+	0x72, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x04,
+	0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52,
+	0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b,
+	0x75, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00,
+	0x52, 0x03, 0x73, 0x6b, 0x75, 0x12, 0x1f, 0x0a, 0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x18, 0x03,
+	0x20, 0x01, 0x28, 0x0d, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x2a, 0x04, 0x18, 0xff, 0xff, 0x03, 0x52,
+	0x05, 0x63, 0x6f, 0x75, 0x6e, 0x74, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12,
+	0x03, 0xf8, 0x42, 0x01, 0x22, 0x88, 0x01, 0x0a, 0x15, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a,
+	0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09,
+	0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73, 0x6b,
+	0x75, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22,
+	0x68, 0x0a, 0x10, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00,
+	0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0c, 0x0a, 0x05, 0x6f,
+	0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x67, 0x0a, 0x0f, 0x4c, 0x69, 0x73,
+	0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x09, 0x63,
+	0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8,
+	0x42, 0x01, 0x22, 0xa1, 0x02, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52,
+	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x28, 0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73,
+	0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x61,
+	0x72, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x05, 0x69, 0x74, 0x65, 0x6d,
+	0x73, 0x12, 0x2b, 0x0a, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x18,
+	0x07, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4d, 0x6f, 0x6e,
+	0x65, 0x79, 0x52, 0x0a, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x50, 0x72, 0x69, 0x63, 0x65, 0x12, 0x1c,
+	0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28,
+	0x09, 0x52, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x22, 0x0a, 0x0c,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64, 0x18, 0x04, 0x20, 0x01,
+	0x28, 0x08, 0x52, 0x0c, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x41, 0x70, 0x70, 0x6c, 0x69, 0x65, 0x64,
+	0x12, 0x2f, 0x0a, 0x0c, 0x63, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e, 0x74,
+	0x18, 0x08, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4d, 0x6f,
+	0x6e, 0x65, 0x79, 0x52, 0x0c, 0x63, 0x61, 0x72, 0x74, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75, 0x6e,
+	0x74, 0x12, 0x31, 0x0a, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63, 0x6f, 0x75,
+	0x6e, 0x74, 0x18, 0x09, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x0b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e,
+	0x4d, 0x6f, 0x6e, 0x65, 0x79, 0x52, 0x0d, 0x74, 0x6f, 0x74, 0x61, 0x6c, 0x44, 0x69, 0x73, 0x63,
+	0x6f, 0x75, 0x6e, 0x74, 0x4a, 0x04, 0x08, 0x02, 0x10, 0x03, 0x4a, 0x04, 0x08, 0x05, 0x10, 0x06,
+	0x4a, 0x04, 0x08, 0x06, 0x10, 0x07, 0x22, 0x37, 0x0a, 0x17, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x12, 0x1c, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x09, 0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x22,
+	0x8c, 0x01, 0x0a, 0x11, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65,
+	0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20,
+	0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x12, 0x25, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18,
+	0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x52, 0x09,
+	0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x33, 0x0a, 0x06, 0x70, 0x6f, 0x6c,
+	0x69, 0x63, 0x79, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0e, 0x32, 0x11, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x42, 0x08, 0xfa, 0x42,
+	0x05, 0x82, 0x01, 0x02, 0x10, 0x01, 0x52, 0x06, 0x70, 0x6f, 0x6c, 0x69, 0x63, 0x79, 0x22, 0x96,
+	0x01, 0x0a, 0x15, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64,
+	0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48,
+	0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54,
+	0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72,
+	0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e,
+	0x12, 0x27, 0x0a, 0x09, 0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x03, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x09, 0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x40, 0x52, 0x09,
+	0x70, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x6e, 0x0a, 0x16, 0x52, 0x65, 0x6d, 0x6f, 0x76,
+	0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x09,
+	0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e,
+	0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0xfa, 0x01, 0x0a, 0x0f, 0x44, 0x65, 0x6c, 0x69,
+	0x76, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x0e, 0x0a, 0x02, 0x69,
+	0x64, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x02, 0x69, 0x64, 0x12, 0x1e, 0x0a, 0x04, 0x63,
+	0x69, 0x74, 0x79, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x72, 0x05,
+	0x10, 0x01, 0x18, 0x80, 0x01, 0x52, 0x04, 0x63, 0x69, 0x74, 0x79, 0x12, 0x22, 0x0a, 0x06, 0x73,
+	0x74, 0x72, 0x65, 0x65, 0x74, 0x18, 0x03, 0x20, 0x01, 0x28, 0x09, 0x42, 0x0a, 0xfa, 0x42, 0x07,
+	0x72, 0x05, 0x10, 0x01, 0x18, 0x80, 0x02, 0x52, 0x06, 0x73, 0x74, 0x72, 0x65, 0x65, 0x74, 0x12,
+	0x1f, 0x0a, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65, 0x18, 0x04, 0x20, 0x01, 0x28, 0x09, 0x42, 0x09,
+	0xfa, 0x42, 0x06, 0x72, 0x04, 0x10, 0x01, 0x18, 0x20, 0x52, 0x05, 0x68, 0x6f, 0x75, 0x73, 0x65,
+	0x12, 0x25, 0x0a, 0x09, 0x61, 0x70, 0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x05, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x18, 0x20, 0x52, 0x09, 0x61, 0x70,
+	0x61, 0x72, 0x74, 0x6d, 0x65, 0x6e, 0x74, 0x12, 0x27, 0x0a, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61,
+	0x6c, 0x43, 0x6f, 0x64, 0x65, 0x18, 0x06, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04,
+	0x72, 0x02, 0x18, 0x10, 0x52, 0x0a, 0x70, 0x6f, 0x73, 0x74, 0x61, 0x6c, 0x43, 0x6f, 0x64, 0x65,
+	0x12, 0x22, 0x0a, 0x07, 0x63, 0x6f, 0x6d, 0x6d, 0x65, 0x6e, 0x74, 0x18, 0x07, 0x20, 0x01, 0x28,
+	0x09, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x72, 0x03, 0x18, 0x80, 0x04, 0x52, 0x07, 0x63, 0x6f, 0x6d,
+	0x6d, 0x65, 0x6e, 0x74, 0x22, 0x6e, 0x0a, 0x14, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22,
+	0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x39, 0x0a, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x18, 0x02, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63, 0x61, 0x72,
+	0x74, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73,
+	0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x22, 0x35, 0x0a, 0x15, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x1c, 0x0a,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x44, 0x22, 0x33, 0x0a, 0x14, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x71, 0x75,
+	0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28,
+	0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x22, 0x4c, 0x0a, 0x15, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65,
+	0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x33, 0x0a, 0x09, 0x61, 0x64, 0x64,
+	0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x22, 0x95,
+	0x01, 0x0a, 0x14, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18,
+	0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04,
+	0x75, 0x73, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49,
+	0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00,
+	0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x44, 0x12, 0x39, 0x0a, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x15, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x69, 0x76, 0x65, 0x72, 0x79, 0x41, 0x64, 0x64, 0x72,
+	0x65, 0x73, 0x73, 0x42, 0x08, 0xfa, 0x42, 0x05, 0x8a, 0x01, 0x02, 0x10, 0x01, 0x52, 0x07, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x22, 0x5a, 0x0a, 0x14, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b,
+	0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x09, 0x61,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x44, 0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07,
+	0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x49, 0x44, 0x22, 0x9f, 0x01, 0x0a, 0x0f, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1b, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52, 0x04, 0x75,
+	0x73, 0x65, 0x72, 0x12, 0x25, 0x0a, 0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x44,
+	0x18, 0x02, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x52,
+	0x09, 0x61, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x49, 0x44, 0x12, 0x48, 0x0a, 0x0e, 0x73, 0x68,
+	0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x18, 0x03, 0x20, 0x01,
+	0x28, 0x0e, 0x32, 0x14, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69,
+	0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64, 0x42, 0x0a, 0xfa, 0x42, 0x07, 0x82, 0x01, 0x04,
+	0x10, 0x01, 0x20, 0x00, 0x52, 0x0e, 0x73, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x65,
+	0x74, 0x68, 0x6f, 0x64, 0x22, 0x2c, 0x0a, 0x10, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x18, 0x0a, 0x07, 0x6f, 0x72, 0x64, 0x65,
+	0x72, 0x49, 0x44, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x52, 0x07, 0x6f, 0x72, 0x64, 0x65, 0x72,
+	0x49, 0x44, 0x22, 0x86, 0x01, 0x0a, 0x13, 0x53, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x4c, 0x61,
+	0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73,
+	0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20,
+	0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x61, 0x72,
+	0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42,
+	0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75, 0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52, 0x03, 0x73, 0x6b, 0x75, 0x42, 0x0c, 0x0a,
+	0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x84, 0x01, 0x0a, 0x11,
+	0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73,
+	0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01, 0x20, 0x01, 0x28, 0x03, 0x42,
+	0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52, 0x04, 0x75, 0x73, 0x65, 0x72,
+	0x12, 0x27, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x18, 0x02, 0x20,
+	0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10, 0x01, 0x48, 0x00, 0x52, 0x09,
+	0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x12, 0x19, 0x0a, 0x03, 0x73, 0x6b, 0x75,
+	0x18, 0x03, 0x20, 0x01, 0x28, 0x0d, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x2a, 0x02, 0x20, 0x00, 0x52,
+	0x03, 0x73, 0x6b, 0x75, 0x42, 0x0c, 0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8,
+	0x42, 0x01, 0x22, 0x68, 0x0a, 0x10, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x52,
+	0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x1d, 0x0a, 0x04, 0x75, 0x73, 0x65, 0x72, 0x18, 0x01,
+	0x20, 0x01, 0x28, 0x03, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x22, 0x02, 0x20, 0x00, 0x48, 0x00, 0x52,
+	0x04, 0x75, 0x73, 0x65, 0x72, 0x12, 0x27, 0x0a, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b,
+	0x65, 0x6e, 0x18, 0x02, 0x20, 0x01, 0x28, 0x09, 0x42, 0x07, 0xfa, 0x42, 0x04, 0x72, 0x02, 0x10,
+	0x01, 0x48, 0x00, 0x52, 0x09, 0x63, 0x61, 0x72, 0x74, 0x54, 0x6f, 0x6b, 0x65, 0x6e, 0x42, 0x0c,
+	0x0a, 0x05, 0x6f, 0x77, 0x6e, 0x65, 0x72, 0x12, 0x03, 0xf8, 0x42, 0x01, 0x22, 0x6b, 0x0a, 0x09,
+	0x53, 0x61, 0x76, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x12, 0x26, 0x0a, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x18, 0x01, 0x20, 0x01, 0x28, 0x0b, 0x32, 0x12, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43,
+	0x61, 0x72, 0x74, 0x47, 0x6f, 0x6f, 0x64, 0x49, 0x6e, 0x66, 0x6f, 0x52, 0x04, 0x69, 0x74, 0x65,
+	0x6d, 0x12, 0x18, 0x0a, 0x07, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x18, 0x02, 0x20, 0x01,
+	0x28, 0x04, 0x52, 0x07, 0x69, 0x6e, 0x53, 0x74, 0x6f, 0x63, 0x6b, 0x12, 0x1c, 0x0a, 0x09, 0x61,
+	0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x18, 0x03, 0x20, 0x01, 0x28, 0x08, 0x52, 0x09,
+	0x61, 0x76, 0x61, 0x69, 0x6c, 0x61, 0x62, 0x6c, 0x65, 0x22, 0x3a, 0x0a, 0x11, 0x4c, 0x69, 0x73,
+	0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x12, 0x25,
+	0x0a, 0x05, 0x69, 0x74, 0x65, 0x6d, 0x73, 0x18, 0x01, 0x20, 0x03, 0x28, 0x0b, 0x32, 0x0f, 0x2e,
+	0x63, 0x61, 0x72, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x64, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x05,
+	0x69, 0x74, 0x65, 0x6d, 0x73, 0x2a, 0x76, 0x0a, 0x0b, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x50, 0x6f,
+	0x6c, 0x69, 0x63, 0x79, 0x12, 0x1c, 0x0a, 0x18, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x50, 0x4f,
+	0x4c, 0x49, 0x43, 0x59, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44,
+	0x10, 0x00, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49,
+	0x43, 0x59, 0x5f, 0x53, 0x55, 0x4d, 0x10, 0x01, 0x12, 0x14, 0x0a, 0x10, 0x4d, 0x45, 0x52, 0x47,
+	0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x4d, 0x41, 0x58, 0x10, 0x02, 0x12, 0x1d,
+	0x0a, 0x19, 0x4d, 0x45, 0x52, 0x47, 0x45, 0x5f, 0x50, 0x4f, 0x4c, 0x49, 0x43, 0x59, 0x5f, 0x50,
+	0x52, 0x45, 0x46, 0x45, 0x52, 0x5f, 0x47, 0x55, 0x45, 0x53, 0x54, 0x10, 0x03, 0x2a, 0x96, 0x01,
+	0x0a, 0x0e, 0x53, 0x68, 0x69, 0x70, 0x70, 0x69, 0x6e, 0x67, 0x4d, 0x65, 0x74, 0x68, 0x6f, 0x64,
+	0x12, 0x1f, 0x0a, 0x1b, 0x53, 0x48, 0x49, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x55, 0x4e, 0x53, 0x50, 0x45, 0x43, 0x49, 0x46, 0x49, 0x45, 0x44, 0x10,
+	0x00, 0x12, 0x1b, 0x0a, 0x17, 0x53, 0x48, 0x49, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45,
+	0x54, 0x48, 0x4f, 0x44, 0x5f, 0x43, 0x4f, 0x55, 0x52, 0x49, 0x45, 0x52, 0x10, 0x01, 0x12, 0x20,
+	0x0a, 0x1c, 0x53, 0x48, 0x49, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x54, 0x48, 0x4f,
+	0x44, 0x5f, 0x50, 0x49, 0x43, 0x4b, 0x55, 0x50, 0x5f, 0x50, 0x4f, 0x49, 0x4e, 0x54, 0x10, 0x02,
+	0x12, 0x24, 0x0a, 0x20, 0x53, 0x48, 0x49, 0x50, 0x50, 0x49, 0x4e, 0x47, 0x5f, 0x4d, 0x45, 0x54,
+	0x48, 0x4f, 0x44, 0x5f, 0x57, 0x41, 0x52, 0x45, 0x48, 0x4f, 0x55, 0x53, 0x45, 0x5f, 0x50, 0x49,
+	0x43, 0x4b, 0x55, 0x50, 0x10, 0x03, 0x32, 0xa0, 0x0d, 0x0a, 0x04, 0x43, 0x61, 0x72, 0x74, 0x12,
+	0x52, 0x0a, 0x09, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x12, 0x16, 0x2e, 0x63,
+	0x61, 0x72, 0x74, 0x2e, 0x41, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x15, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x61, 0x64, 0x64, 0x54, 0x6f, 0x43,
+	0x61, 0x72, 0x74, 0x12, 0x61, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f,
+	0x6d, 0x43, 0x61, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c,
+	0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65,
 	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74,
-	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93,
-	0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x61, 0x64, 0x64, 0x54, 0x6f, 0x43, 0x61, 0x72,
-	0x74, 0x12, 0x61, 0x0a, 0x0e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43,
-	0x61, 0x72, 0x74, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74,
-	0x65, 0x46, 0x72, 0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
+	0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72,
+	0x6f, 0x6d, 0x43, 0x61, 0x72, 0x74, 0x12, 0x67, 0x0a, 0x10, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72,
+	0x74, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12, 0x1d, 0x2e, 0x63, 0x61, 0x72,
+	0x74, 0x2e, 0x53, 0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75,
+	0x6e, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67,
+	0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74,
+	0x79, 0x22, 0x1c, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x16, 0x3a, 0x01, 0x2a, 0x22, 0x11, 0x2f, 0x73,
+	0x65, 0x74, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x43, 0x6f, 0x75, 0x6e, 0x74, 0x12,
+	0x61, 0x0a, 0x0e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74, 0x65,
+	0x6d, 0x12, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43,
+	0x61, 0x72, 0x74, 0x49, 0x74, 0x65, 0x6d, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16,
+	0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66,
+	0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01,
+	0x2a, 0x22, 0x0f, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x43, 0x61, 0x72, 0x74, 0x49, 0x74,
+	0x65, 0x6d, 0x12, 0x52, 0x0a, 0x09, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x12,
+	0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x6c, 0x65, 0x61, 0x72, 0x43, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65,
+	0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22,
+	0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f, 0x63, 0x6c, 0x65,
+	0x61, 0x72, 0x43, 0x61, 0x72, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x72, 0x74, 0x12, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61,
+	0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73,
+	0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x6c,
+	0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x65,
+	0x0a, 0x0f, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72,
+	0x74, 0x12, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f,
+	0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x1a, 0x1d, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x65, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74,
+	0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x63, 0x72, 0x65, 0x61, 0x74, 0x65, 0x47, 0x75, 0x65, 0x73,
+	0x74, 0x43, 0x61, 0x72, 0x74, 0x12, 0x55, 0x0a, 0x0a, 0x4d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x61,
+	0x72, 0x74, 0x73, 0x12, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4d, 0x65, 0x72, 0x67, 0x65,
+	0x43, 0x61, 0x72, 0x74, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x16, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22,
+	0x0b, 0x2f, 0x6d, 0x65, 0x72, 0x67, 0x65, 0x43, 0x61, 0x72, 0x74, 0x73, 0x12, 0x61, 0x0a, 0x0e,
+	0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x1b,
+	0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x41, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f,
+	0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14, 0x3a, 0x01, 0x2a, 0x22, 0x0f,
+	0x2f, 0x61, 0x70, 0x70, 0x6c, 0x79, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12,
+	0x64, 0x0a, 0x0f, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f,
+	0x64, 0x65, 0x12, 0x1c, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x52, 0x65, 0x6d, 0x6f, 0x76, 0x65,
+	0x50, 0x72, 0x6f, 0x6d, 0x6f, 0x43, 0x6f, 0x64, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74,
 	0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62,
-	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1a, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x14,
-	0x3a, 0x01, 0x2a, 0x22, 0x0f, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x46, 0x72, 0x6f, 0x6d,
-	0x43, 0x61, 0x72, 0x74, 0x12, 0x56, 0x0a, 0x08, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74,
-	0x12, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74,
-	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4c,
-	0x69, 0x73, 0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
-	0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15, 0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x6c, 0x69, 0x73,
-	0x74, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x12, 0x4f, 0x0a, 0x08,
-	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e,
-	0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a,
-	0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52,
-	0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0e, 0x3a,
-	0x01, 0x2a, 0x22, 0x09, 0x2f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x42, 0x1f, 0x5a,
-	0x1d, 0x72, 0x6f, 0x75, 0x74, 0x65, 0x32, 0x35, 0x36, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f,
-	0x75, 0x74, 0x2f, 0x70, 0x6b, 0x67, 0x2f, 0x63, 0x61, 0x72, 0x74, 0x5f, 0x76, 0x31, 0x62, 0x06,
-	0x70, 0x72, 0x6f, 0x74, 0x6f, 0x33,
+	0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x1b, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x15,
+	0x3a, 0x01, 0x2a, 0x22, 0x10, 0x2f, 0x72, 0x65, 0x6d, 0x6f, 0x76, 0x65, 0x50, 0x72, 0x6f, 0x6d,
+	0x6f, 0x43, 0x6f, 0x64, 0x65, 0x12, 0x63, 0x0a, 0x0d, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65, 0x41,
+	0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x72,
+	0x65, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x43, 0x72, 0x65, 0x61, 0x74, 0x65,
+	0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22,
+	0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22, 0x0e, 0x2f, 0x63, 0x72, 0x65,
+	0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12, 0x63, 0x0a, 0x0d, 0x4c, 0x69,
+	0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12, 0x1a, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73,
+	0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x1b, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4c,
+	0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x52, 0x65, 0x73, 0x70,
+	0x6f, 0x6e, 0x73, 0x65, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22,
+	0x0e, 0x2f, 0x6c, 0x69, 0x73, 0x74, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x65, 0x73, 0x12,
+	0x5e, 0x0a, 0x0d, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x55, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22,
+	0x0e, 0x2f, 0x75, 0x70, 0x64, 0x61, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x5e, 0x0a, 0x0d, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73,
+	0x12, 0x1a, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x44, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64,
+	0x64, 0x72, 0x65, 0x73, 0x73, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67,
+	0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45,
+	0x6d, 0x70, 0x74, 0x79, 0x22, 0x19, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x13, 0x3a, 0x01, 0x2a, 0x22,
+	0x0e, 0x2f, 0x64, 0x65, 0x6c, 0x65, 0x74, 0x65, 0x41, 0x64, 0x64, 0x72, 0x65, 0x73, 0x73, 0x12,
+	0x4f, 0x0a, 0x08, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x12, 0x15, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65, 0x52, 0x65, 0x71, 0x75, 0x65,
+	0x73, 0x74, 0x1a, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x50, 0x75, 0x72, 0x63, 0x68, 0x61,
+	0x73, 0x65, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e, 0x73, 0x65, 0x22, 0x14, 0x82, 0xd3, 0xe4, 0x93,
+	0x02, 0x0e, 0x3a, 0x01, 0x2a, 0x22, 0x09, 0x2f, 0x70, 0x75, 0x72, 0x63, 0x68, 0x61, 0x73, 0x65,
+	0x12, 0x5b, 0x0a, 0x0c, 0x53, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x4c, 0x61, 0x74, 0x65, 0x72,
+	0x12, 0x19, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x53, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x4c,
+	0x61, 0x74, 0x65, 0x72, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f,
+	0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72, 0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d,
+	0x70, 0x74, 0x79, 0x22, 0x18, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x12, 0x3a, 0x01, 0x2a, 0x22, 0x0d,
+	0x2f, 0x73, 0x61, 0x76, 0x65, 0x46, 0x6f, 0x72, 0x4c, 0x61, 0x74, 0x65, 0x72, 0x12, 0x55, 0x0a,
+	0x0a, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x12, 0x17, 0x2e, 0x63, 0x61,
+	0x72, 0x74, 0x2e, 0x4d, 0x6f, 0x76, 0x65, 0x54, 0x6f, 0x43, 0x61, 0x72, 0x74, 0x52, 0x65, 0x71,
+	0x75, 0x65, 0x73, 0x74, 0x1a, 0x16, 0x2e, 0x67, 0x6f, 0x6f, 0x67, 0x6c, 0x65, 0x2e, 0x70, 0x72,
+	0x6f, 0x74, 0x6f, 0x62, 0x75, 0x66, 0x2e, 0x45, 0x6d, 0x70, 0x74, 0x79, 0x22, 0x16, 0x82, 0xd3,
+	0xe4, 0x93, 0x02, 0x10, 0x3a, 0x01, 0x2a, 0x22, 0x0b, 0x2f, 0x6d, 0x6f, 0x76, 0x65, 0x54, 0x6f,
+	0x43, 0x61, 0x72, 0x74, 0x12, 0x53, 0x0a, 0x09, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65,
+	0x64, 0x12, 0x16, 0x2e, 0x63, 0x61, 0x72, 0x74, 0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76,
+	0x65, 0x64, 0x52, 0x65, 0x71, 0x75, 0x65, 0x73, 0x74, 0x1a, 0x17, 0x2e, 0x63, 0x61, 0x72, 0x74,
+	0x2e, 0x4c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x52, 0x65, 0x73, 0x70, 0x6f, 0x6e,
+	0x73, 0x65, 0x22, 0x15, 0x82, 0xd3, 0xe4, 0x93, 0x02, 0x0f, 0x3a, 0x01, 0x2a, 0x22, 0x0a, 0x2f,
+	0x6c, 0x69, 0x73, 0x74, 0x53, 0x61, 0x76, 0x65, 0x64, 0x42, 0x1f, 0x5a, 0x1d, 0x72, 0x6f, 0x75,
+	0x74, 0x65, 0x32, 0x35, 0x36, 0x2f, 0x63, 0x68, 0x65, 0x63, 0x6b, 0x6f, 0x75, 0x74, 0x2f, 0x70,
+	0x6b, 0x67, 0x2f, 0x63, 0x61, 0x72, 0x74, 0x5f, 0x76, 0x31, 0x62, 0x06, 0x70, 0x72, 0x6f, 0x74,
+	0x6f, 0x33,
 }
 
 var (
@@ -502,32 +2416,95 @@ func file_cart_proto_rawDescGZIP() []byte {
 	return file_cart_proto_rawDescData
 }
 
-var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_cart_proto_enumTypes = make([]protoimpl.EnumInfo, 2)
+var file_cart_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_cart_proto_goTypes = []interface{}{
-	(*CartGoodInfo)(nil),          // 0: cart.CartGoodInfo
-	(*AddToCartRequest)(nil),      // 1: cart.AddToCartRequest
-	(*DeleteFromCartRequest)(nil), // 2: cart.DeleteFromCartRequest
-	(*ListCartRequest)(nil),       // 3: cart.ListCartRequest
-	(*ListCartResponse)(nil),      // 4: cart.ListCartResponse
-	(*PurchaseRequest)(nil),       // 5: cart.PurchaseRequest
-	(*PurchaseResponse)(nil),      // 6: cart.PurchaseResponse
-	(*emptypb.Empty)(nil),         // 7: google.protobuf.Empty
+	(MergePolicy)(0),                // 0: cart.MergePolicy
+	(ShippingMethod)(0),             // 1: cart.ShippingMethod
+	(*Money)(nil),                   // 2: cart.Money
+	(*CartGoodInfo)(nil),            // 3: cart.CartGoodInfo
+	(*AddToCartRequest)(nil),        // 4: cart.AddToCartRequest
+	(*DeleteFromCartRequest)(nil),   // 5: cart.DeleteFromCartRequest
+	(*SetCartItemCountRequest)(nil), // 6: cart.SetCartItemCountRequest
+	(*RemoveCartItemRequest)(nil),   // 7: cart.RemoveCartItemRequest
+	(*ClearCartRequest)(nil),        // 8: cart.ClearCartRequest
+	(*ListCartRequest)(nil),         // 9: cart.ListCartRequest
+	(*ListCartResponse)(nil),        // 10: cart.ListCartResponse
+	(*CreateGuestCartResponse)(nil), // 11: cart.CreateGuestCartResponse
+	(*MergeCartsRequest)(nil),       // 12: cart.MergeCartsRequest
+	(*ApplyPromoCodeRequest)(nil),   // 13: cart.ApplyPromoCodeRequest
+	(*RemovePromoCodeRequest)(nil),  // 14: cart.RemovePromoCodeRequest
+	(*DeliveryAddress)(nil),         // 15: cart.DeliveryAddress
+	(*CreateAddressRequest)(nil),    // 16: cart.CreateAddressRequest
+	(*CreateAddressResponse)(nil),   // 17: cart.CreateAddressResponse
+	(*ListAddressesRequest)(nil),    // 18: cart.ListAddressesRequest
+	(*ListAddressesResponse)(nil),   // 19: cart.ListAddressesResponse
+	(*UpdateAddressRequest)(nil),    // 20: cart.UpdateAddressRequest
+	(*DeleteAddressRequest)(nil),    // 21: cart.DeleteAddressRequest
+	(*PurchaseRequest)(nil),         // 22: cart.PurchaseRequest
+	(*PurchaseResponse)(nil),        // 23: cart.PurchaseResponse
+	(*SaveForLaterRequest)(nil),     // 24: cart.SaveForLaterRequest
+	(*MoveToCartRequest)(nil),       // 25: cart.MoveToCartRequest
+	(*ListSavedRequest)(nil),        // 26: cart.ListSavedRequest
+	(*SavedItem)(nil),               // 27: cart.SavedItem
+	(*ListSavedResponse)(nil),       // 28: cart.ListSavedResponse
+	(*emptypb.Empty)(nil),           // 29: google.protobuf.Empty
 }
 var file_cart_proto_depIdxs = []int32{
-	0, // 0: cart.ListCartResponse.items:type_name -> cart.CartGoodInfo
-	1, // 1: cart.Cart.AddToCart:input_type -> cart.AddToCartRequest
-	2, // 2: cart.Cart.DeleteFromCart:input_type -> cart.DeleteFromCartRequest
-	3, // 3: cart.Cart.ListCart:input_type -> cart.ListCartRequest
-	5, // 4: cart.Cart.Purchase:input_type -> cart.PurchaseRequest
-	7, // 5: cart.Cart.AddToCart:output_type -> google.protobuf.Empty
-	7, // 6: cart.Cart.DeleteFromCart:output_type -> google.protobuf.Empty
-	4, // 7: cart.Cart.ListCart:output_type -> cart.ListCartResponse
-	6, // 8: cart.Cart.Purchase:output_type -> cart.PurchaseResponse
-	5, // [5:9] is the sub-list for method output_type
-	1, // [1:5] is the sub-list for method input_type
-	1, // [1:1] is the sub-list for extension type_name
-	1, // [1:1] is the sub-list for extension extendee
-	0, // [0:1] is the sub-list for field type_name
+	2,  // 0: cart.CartGoodInfo.price:type_name -> cart.Money
+	2,  // 1: cart.CartGoodInfo.discount:type_name -> cart.Money
+	3,  // 2: cart.ListCartResponse.items:type_name -> cart.CartGoodInfo
+	2,  // 3: cart.ListCartResponse.totalPrice:type_name -> cart.Money
+	2,  // 4: cart.ListCartResponse.cartDiscount:type_name -> cart.Money
+	2,  // 5: cart.ListCartResponse.totalDiscount:type_name -> cart.Money
+	0,  // 6: cart.MergeCartsRequest.policy:type_name -> cart.MergePolicy
+	15, // 7: cart.CreateAddressRequest.address:type_name -> cart.DeliveryAddress
+	15, // 8: cart.ListAddressesResponse.addresses:type_name -> cart.DeliveryAddress
+	15, // 9: cart.UpdateAddressRequest.address:type_name -> cart.DeliveryAddress
+	1,  // 10: cart.PurchaseRequest.shippingMethod:type_name -> cart.ShippingMethod
+	3,  // 11: cart.SavedItem.item:type_name -> cart.CartGoodInfo
+	27, // 12: cart.ListSavedResponse.items:type_name -> cart.SavedItem
+	4,  // 13: cart.Cart.AddToCart:input_type -> cart.AddToCartRequest
+	5,  // 14: cart.Cart.DeleteFromCart:input_type -> cart.DeleteFromCartRequest
+	6,  // 15: cart.Cart.SetCartItemCount:input_type -> cart.SetCartItemCountRequest
+	7,  // 16: cart.Cart.RemoveCartItem:input_type -> cart.RemoveCartItemRequest
+	8,  // 17: cart.Cart.ClearCart:input_type -> cart.ClearCartRequest
+	9,  // 18: cart.Cart.ListCart:input_type -> cart.ListCartRequest
+	29, // 19: cart.Cart.CreateGuestCart:input_type -> google.protobuf.Empty
+	12, // 20: cart.Cart.MergeCarts:input_type -> cart.MergeCartsRequest
+	13, // 21: cart.Cart.ApplyPromoCode:input_type -> cart.ApplyPromoCodeRequest
+	14, // 22: cart.Cart.RemovePromoCode:input_type -> cart.RemovePromoCodeRequest
+	16, // 23: cart.Cart.CreateAddress:input_type -> cart.CreateAddressRequest
+	18, // 24: cart.Cart.ListAddresses:input_type -> cart.ListAddressesRequest
+	20, // 25: cart.Cart.UpdateAddress:input_type -> cart.UpdateAddressRequest
+	21, // 26: cart.Cart.DeleteAddress:input_type -> cart.DeleteAddressRequest
+	22, // 27: cart.Cart.Purchase:input_type -> cart.PurchaseRequest
+	24, // 28: cart.Cart.SaveForLater:input_type -> cart.SaveForLaterRequest
+	25, // 29: cart.Cart.MoveToCart:input_type -> cart.MoveToCartRequest
+	26, // 30: cart.Cart.ListSaved:input_type -> cart.ListSavedRequest
+	29, // 31: cart.Cart.AddToCart:output_type -> google.protobuf.Empty
+	29, // 32: cart.Cart.DeleteFromCart:output_type -> google.protobuf.Empty
+	29, // 33: cart.Cart.SetCartItemCount:output_type -> google.protobuf.Empty
+	29, // 34: cart.Cart.RemoveCartItem:output_type -> google.protobuf.Empty
+	29, // 35: cart.Cart.ClearCart:output_type -> google.protobuf.Empty
+	10, // 36: cart.Cart.ListCart:output_type -> cart.ListCartResponse
+	11, // 37: cart.Cart.CreateGuestCart:output_type -> cart.CreateGuestCartResponse
+	29, // 38: cart.Cart.MergeCarts:output_type -> google.protobuf.Empty
+	29, // 39: cart.Cart.ApplyPromoCode:output_type -> google.protobuf.Empty
+	29, // 40: cart.Cart.RemovePromoCode:output_type -> google.protobuf.Empty
+	17, // 41: cart.Cart.CreateAddress:output_type -> cart.CreateAddressResponse
+	19, // 42: cart.Cart.ListAddresses:output_type -> cart.ListAddressesResponse
+	29, // 43: cart.Cart.UpdateAddress:output_type -> google.protobuf.Empty
+	29, // 44: cart.Cart.DeleteAddress:output_type -> google.protobuf.Empty
+	23, // 45: cart.Cart.Purchase:output_type -> cart.PurchaseResponse
+	29, // 46: cart.Cart.SaveForLater:output_type -> google.protobuf.Empty
+	29, // 47: cart.Cart.MoveToCart:output_type -> google.protobuf.Empty
+	28, // 48: cart.Cart.ListSaved:output_type -> cart.ListSavedResponse
+	31, // [31:49] is the sub-list for method output_type
+	13, // [13:31] is the sub-list for method input_type
+	13, // [13:13] is the sub-list for extension type_name
+	13, // [13:13] is the sub-list for extension extendee
+	0,  // [0:13] is the sub-list for field type_name
 }
 
 func init() { file_cart_proto_init() }
@@ -537,7 +2514,7 @@ func file_cart_proto_init() {
 	}
 	if !protoimpl.UnsafeEnabled {
 		file_cart_proto_msgTypes[0].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*CartGoodInfo); i {
+			switch v := v.(*Money); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -549,7 +2526,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[1].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*AddToCartRequest); i {
+			switch v := v.(*CartGoodInfo); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -561,7 +2538,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[2].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*DeleteFromCartRequest); i {
+			switch v := v.(*AddToCartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -573,7 +2550,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[3].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCartRequest); i {
+			switch v := v.(*DeleteFromCartRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -585,7 +2562,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[4].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*ListCartResponse); i {
+			switch v := v.(*SetCartItemCountRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -597,7 +2574,7 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[5].Exporter = func(v interface{}, i int) interface{} {
-			switch v := v.(*PurchaseRequest); i {
+			switch v := v.(*RemoveCartItemRequest); i {
 			case 0:
 				return &v.state
 			case 1:
@@ -609,6 +2586,186 @@ func file_cart_proto_init() {
 			}
 		}
 		file_cart_proto_msgTypes[6].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ClearCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[7].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[8].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListCartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[9].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateGuestCartResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[10].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MergeCartsRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[11].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ApplyPromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[12].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*RemovePromoCodeRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[13].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeliveryAddress); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[14].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[15].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*CreateAddressResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[16].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAddressesRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[17].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListAddressesResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[18].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*UpdateAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[19].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*DeleteAddressRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[20].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*PurchaseRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[21].Exporter = func(v interface{}, i int) interface{} {
 			switch v := v.(*PurchaseResponse); i {
 			case 0:
 				return &v.state
@@ -620,19 +2777,124 @@ func file_cart_proto_init() {
 				return nil
 			}
 		}
+		file_cart_proto_msgTypes[22].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SaveForLaterRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[23].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*MoveToCartRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[24].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedRequest); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[25].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*SavedItem); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+		file_cart_proto_msgTypes[26].Exporter = func(v interface{}, i int) interface{} {
+			switch v := v.(*ListSavedResponse); i {
+			case 0:
+				return &v.state
+			case 1:
+				return &v.sizeCache
+			case 2:
+				return &v.unknownFields
+			default:
+				return nil
+			}
+		}
+	}
+	file_cart_proto_msgTypes[2].OneofWrappers = []interface{}{
+		(*AddToCartRequest_User)(nil),
+		(*AddToCartRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[3].OneofWrappers = []interface{}{
+		(*DeleteFromCartRequest_User)(nil),
+		(*DeleteFromCartRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[4].OneofWrappers = []interface{}{
+		(*SetCartItemCountRequest_User)(nil),
+		(*SetCartItemCountRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[5].OneofWrappers = []interface{}{
+		(*RemoveCartItemRequest_User)(nil),
+		(*RemoveCartItemRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[6].OneofWrappers = []interface{}{
+		(*ClearCartRequest_User)(nil),
+		(*ClearCartRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[7].OneofWrappers = []interface{}{
+		(*ListCartRequest_User)(nil),
+		(*ListCartRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[11].OneofWrappers = []interface{}{
+		(*ApplyPromoCodeRequest_User)(nil),
+		(*ApplyPromoCodeRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[12].OneofWrappers = []interface{}{
+		(*RemovePromoCodeRequest_User)(nil),
+		(*RemovePromoCodeRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[22].OneofWrappers = []interface{}{
+		(*SaveForLaterRequest_User)(nil),
+		(*SaveForLaterRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[23].OneofWrappers = []interface{}{
+		(*MoveToCartRequest_User)(nil),
+		(*MoveToCartRequest_CartToken)(nil),
+	}
+	file_cart_proto_msgTypes[24].OneofWrappers = []interface{}{
+		(*ListSavedRequest_User)(nil),
+		(*ListSavedRequest_CartToken)(nil),
 	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: file_cart_proto_rawDesc,
-			NumEnums:      0,
-			NumMessages:   7,
+			NumEnums:      2,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
 		GoTypes:           file_cart_proto_goTypes,
 		DependencyIndexes: file_cart_proto_depIdxs,
+		EnumInfos:         file_cart_proto_enumTypes,
 		MessageInfos:      file_cart_proto_msgTypes,
 	}.Build()
 	File_cart_proto = out.File
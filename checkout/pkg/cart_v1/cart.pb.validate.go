@@ -35,6 +35,118 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Amount
+
+	if utf8.RuneCountInString(m.GetCurrency()) != 3 {
+		err := MoneyValidationError{
+			field:  "Currency",
+			reason: "value length must be 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string { return "MoneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
+
 // Validate checks the field values on CartGoodInfo with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -90,10 +202,10 @@ func (m *CartGoodInfo) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetPrice() <= 0 {
+	if m.GetPrice() == nil {
 		err := CartGoodInfoValidationError{
 			field:  "Price",
-			reason: "value must be greater than 0",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -101,6 +213,64 @@ func (m *CartGoodInfo) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CartGoodInfoValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CartGoodInfoValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CartGoodInfoValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CartGoodInfoValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CartGoodInfoValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CartGoodInfoValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return CartGoodInfoMultiError(errors)
 	}
@@ -200,9 +370,9 @@ func (m *AddToCartRequest) validate(all bool) error {
 
 	var errors []error
 
-	if m.GetUser() <= 0 {
+	if m.GetSku() <= 0 {
 		err := AddToCartRequestValidationError{
-			field:  "User",
+			field:  "Sku",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -211,9 +381,9 @@ func (m *AddToCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetSku() <= 0 {
+	if m.GetCount() <= 0 {
 		err := AddToCartRequestValidationError{
-			field:  "Sku",
+			field:  "Count",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -222,10 +392,63 @@ func (m *AddToCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetCount() <= 0 {
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *AddToCartRequest_User:
+		if v == nil {
+			err := AddToCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := AddToCartRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *AddToCartRequest_CartToken:
+		if v == nil {
+			err := AddToCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := AddToCartRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
 		err := AddToCartRequestValidationError{
-			field:  "Count",
-			reason: "value must be greater than 0",
+			field:  "Owner",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -333,9 +556,9 @@ func (m *DeleteFromCartRequest) validate(all bool) error {
 
 	var errors []error
 
-	if m.GetUser() <= 0 {
+	if m.GetSku() <= 0 {
 		err := DeleteFromCartRequestValidationError{
-			field:  "User",
+			field:  "Sku",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -344,9 +567,9 @@ func (m *DeleteFromCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetSku() <= 0 {
+	if m.GetCount() <= 0 {
 		err := DeleteFromCartRequestValidationError{
-			field:  "Sku",
+			field:  "Count",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -355,10 +578,63 @@ func (m *DeleteFromCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if m.GetCount() <= 0 {
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *DeleteFromCartRequest_User:
+		if v == nil {
+			err := DeleteFromCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := DeleteFromCartRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *DeleteFromCartRequest_CartToken:
+		if v == nil {
+			err := DeleteFromCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := DeleteFromCartRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
 		err := DeleteFromCartRequestValidationError{
-			field:  "Count",
-			reason: "value must be greater than 0",
+			field:  "Owner",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -446,31 +722,31 @@ var _ interface {
 	ErrorName() string
 } = DeleteFromCartRequestValidationError{}
 
-// Validate checks the field values on ListCartRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListCartRequest) Validate() error {
+// Validate checks the field values on SetCartItemCountRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetCartItemCountRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCartRequest with the rules
-// defined in the proto definition for this message. If any rules are
+// ValidateAll checks the field values on SetCartItemCountRequest with the
+// rules defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCartRequestMultiError, or nil if none found.
-func (m *ListCartRequest) ValidateAll() error {
+// SetCartItemCountRequestMultiError, or nil if none found.
+func (m *SetCartItemCountRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCartRequest) validate(all bool) error {
+func (m *SetCartItemCountRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUser() <= 0 {
-		err := ListCartRequestValidationError{
-			field:  "User",
+	if m.GetSku() <= 0 {
+		err := SetCartItemCountRequestValidationError{
+			field:  "Sku",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -479,20 +755,95 @@ func (m *ListCartRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetCount() > 65535 {
+		err := SetCartItemCountRequestValidationError{
+			field:  "Count",
+			reason: "value must be less than or equal to 65535",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *SetCartItemCountRequest_User:
+		if v == nil {
+			err := SetCartItemCountRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := SetCartItemCountRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *SetCartItemCountRequest_CartToken:
+		if v == nil {
+			err := SetCartItemCountRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := SetCartItemCountRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := SetCartItemCountRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return ListCartRequestMultiError(errors)
+		return SetCartItemCountRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCartRequestMultiError is an error wrapping multiple validation errors
-// returned by ListCartRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListCartRequestMultiError []error
+// SetCartItemCountRequestMultiError is an error wrapping multiple validation
+// errors returned by SetCartItemCountRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetCartItemCountRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCartRequestMultiError) Error() string {
+func (m SetCartItemCountRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -501,11 +852,11 @@ func (m ListCartRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCartRequestMultiError) AllErrors() []error { return m }
+func (m SetCartItemCountRequestMultiError) AllErrors() []error { return m }
 
-// ListCartRequestValidationError is the validation error returned by
-// ListCartRequest.Validate if the designated constraints aren't met.
-type ListCartRequestValidationError struct {
+// SetCartItemCountRequestValidationError is the validation error returned by
+// SetCartItemCountRequest.Validate if the designated constraints aren't met.
+type SetCartItemCountRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -513,22 +864,24 @@ type ListCartRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCartRequestValidationError) Field() string { return e.field }
+func (e SetCartItemCountRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCartRequestValidationError) Reason() string { return e.reason }
+func (e SetCartItemCountRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCartRequestValidationError) Cause() error { return e.cause }
+func (e SetCartItemCountRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCartRequestValidationError) Key() bool { return e.key }
+func (e SetCartItemCountRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCartRequestValidationError) ErrorName() string { return "ListCartRequestValidationError" }
+func (e SetCartItemCountRequestValidationError) ErrorName() string {
+	return "SetCartItemCountRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListCartRequestValidationError) Error() string {
+func (e SetCartItemCountRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -540,14 +893,14 @@ func (e ListCartRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCartRequest.%s: %s%s",
+		"invalid %sSetCartItemCountRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCartRequestValidationError{}
+var _ error = SetCartItemCountRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -555,80 +908,2820 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCartRequestValidationError{}
+} = SetCartItemCountRequestValidationError{}
 
-// Validate checks the field values on ListCartResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListCartResponse) Validate() error {
+// Validate checks the field values on RemoveCartItemRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemoveCartItemRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListCartResponse with the rules
+// ValidateAll checks the field values on RemoveCartItemRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListCartResponseMultiError, or nil if none found.
-func (m *ListCartResponse) ValidateAll() error {
+// RemoveCartItemRequestMultiError, or nil if none found.
+func (m *RemoveCartItemRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListCartResponse) validate(all bool) error {
+func (m *RemoveCartItemRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
+	if m.GetSku() <= 0 {
+		err := RemoveCartItemRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *RemoveCartItemRequest_User:
+		if v == nil {
+			err := RemoveCartItemRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := RemoveCartItemRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *RemoveCartItemRequest_CartToken:
+		if v == nil {
+			err := RemoveCartItemRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := RemoveCartItemRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := RemoveCartItemRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemoveCartItemRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemoveCartItemRequestMultiError is an error wrapping multiple validation
+// errors returned by RemoveCartItemRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemoveCartItemRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemoveCartItemRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemoveCartItemRequestMultiError) AllErrors() []error { return m }
+
+// RemoveCartItemRequestValidationError is the validation error returned by
+// RemoveCartItemRequest.Validate if the designated constraints aren't met.
+type RemoveCartItemRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemoveCartItemRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemoveCartItemRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemoveCartItemRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemoveCartItemRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemoveCartItemRequestValidationError) ErrorName() string {
+	return "RemoveCartItemRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemoveCartItemRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemoveCartItemRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemoveCartItemRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemoveCartItemRequestValidationError{}
+
+// Validate checks the field values on ClearCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ClearCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ClearCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ClearCartRequestMultiError, or nil if none found.
+func (m *ClearCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ClearCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *ClearCartRequest_User:
+		if v == nil {
+			err := ClearCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := ClearCartRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ClearCartRequest_CartToken:
+		if v == nil {
+			err := ClearCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := ClearCartRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := ClearCartRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ClearCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ClearCartRequestMultiError is an error wrapping multiple validation errors
+// returned by ClearCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ClearCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ClearCartRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ClearCartRequestMultiError) AllErrors() []error { return m }
+
+// ClearCartRequestValidationError is the validation error returned by
+// ClearCartRequest.Validate if the designated constraints aren't met.
+type ClearCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ClearCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ClearCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ClearCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ClearCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ClearCartRequestValidationError) ErrorName() string { return "ClearCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ClearCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sClearCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ClearCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ClearCartRequestValidationError{}
+
+// Validate checks the field values on ListCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCartRequestMultiError, or nil if none found.
+func (m *ListCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *ListCartRequest_User:
+		if v == nil {
+			err := ListCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := ListCartRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ListCartRequest_CartToken:
+		if v == nil {
+			err := ListCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := ListCartRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := ListCartRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListCartRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCartRequestMultiError is an error wrapping multiple validation errors
+// returned by ListCartRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListCartRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCartRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCartRequestMultiError) AllErrors() []error { return m }
+
+// ListCartRequestValidationError is the validation error returned by
+// ListCartRequest.Validate if the designated constraints aren't met.
+type ListCartRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCartRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCartRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCartRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCartRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCartRequestValidationError) ErrorName() string { return "ListCartRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCartRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCartRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCartRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCartRequestValidationError{}
+
+// Validate checks the field values on ListCartResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListCartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListCartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListCartResponseMultiError, or nil if none found.
+func (m *ListCartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListCartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListCartResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
 					errors = append(errors, ListCartResponseValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListCartResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCartResponseValidationError{
+					field:  "TotalPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCartResponseValidationError{
+					field:  "TotalPrice",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCartResponseValidationError{
+				field:  "TotalPrice",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for PromoCode
+
+	// no validation rules for PromoApplied
+
+	if all {
+		switch v := interface{}(m.GetCartDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCartResponseValidationError{
+					field:  "CartDiscount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCartResponseValidationError{
+					field:  "CartDiscount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCartDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCartResponseValidationError{
+				field:  "CartDiscount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotalDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListCartResponseValidationError{
+					field:  "TotalDiscount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListCartResponseValidationError{
+					field:  "TotalDiscount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotalDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListCartResponseValidationError{
+				field:  "TotalDiscount",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListCartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListCartResponseMultiError is an error wrapping multiple validation errors
+// returned by ListCartResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListCartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListCartResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListCartResponseMultiError) AllErrors() []error { return m }
+
+// ListCartResponseValidationError is the validation error returned by
+// ListCartResponse.Validate if the designated constraints aren't met.
+type ListCartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListCartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListCartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListCartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListCartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListCartResponseValidationError) ErrorName() string { return "ListCartResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListCartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListCartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListCartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListCartResponseValidationError{}
+
+// Validate checks the field values on CreateGuestCartResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateGuestCartResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateGuestCartResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateGuestCartResponseMultiError, or nil if none found.
+func (m *CreateGuestCartResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateGuestCartResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for CartToken
+
+	if len(errors) > 0 {
+		return CreateGuestCartResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateGuestCartResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateGuestCartResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateGuestCartResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateGuestCartResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateGuestCartResponseMultiError) AllErrors() []error { return m }
+
+// CreateGuestCartResponseValidationError is the validation error returned by
+// CreateGuestCartResponse.Validate if the designated constraints aren't met.
+type CreateGuestCartResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateGuestCartResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateGuestCartResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateGuestCartResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateGuestCartResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateGuestCartResponseValidationError) ErrorName() string {
+	return "CreateGuestCartResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateGuestCartResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateGuestCartResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateGuestCartResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateGuestCartResponseValidationError{}
+
+// Validate checks the field values on MergeCartsRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MergeCartsRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MergeCartsRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MergeCartsRequestMultiError, or nil if none found.
+func (m *MergeCartsRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MergeCartsRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := MergeCartsRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+		err := MergeCartsRequestValidationError{
+			field:  "CartToken",
+			reason: "value length must be at least 1 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := MergePolicy_name[int32(m.GetPolicy())]; !ok {
+		err := MergeCartsRequestValidationError{
+			field:  "Policy",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return MergeCartsRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// MergeCartsRequestMultiError is an error wrapping multiple validation errors
+// returned by MergeCartsRequest.ValidateAll() if the designated constraints
+// aren't met.
+type MergeCartsRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MergeCartsRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MergeCartsRequestMultiError) AllErrors() []error { return m }
+
+// MergeCartsRequestValidationError is the validation error returned by
+// MergeCartsRequest.Validate if the designated constraints aren't met.
+type MergeCartsRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MergeCartsRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MergeCartsRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MergeCartsRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MergeCartsRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MergeCartsRequestValidationError) ErrorName() string {
+	return "MergeCartsRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e MergeCartsRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMergeCartsRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MergeCartsRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MergeCartsRequestValidationError{}
+
+// Validate checks the field values on ApplyPromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ApplyPromoCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ApplyPromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ApplyPromoCodeRequestMultiError, or nil if none found.
+func (m *ApplyPromoCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ApplyPromoCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := utf8.RuneCountInString(m.GetPromoCode()); l < 1 || l > 64 {
+		err := ApplyPromoCodeRequestValidationError{
+			field:  "PromoCode",
+			reason: "value length must be between 1 and 64 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *ApplyPromoCodeRequest_User:
+		if v == nil {
+			err := ApplyPromoCodeRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := ApplyPromoCodeRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ApplyPromoCodeRequest_CartToken:
+		if v == nil {
+			err := ApplyPromoCodeRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := ApplyPromoCodeRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := ApplyPromoCodeRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ApplyPromoCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ApplyPromoCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by ApplyPromoCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ApplyPromoCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ApplyPromoCodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ApplyPromoCodeRequestMultiError) AllErrors() []error { return m }
+
+// ApplyPromoCodeRequestValidationError is the validation error returned by
+// ApplyPromoCodeRequest.Validate if the designated constraints aren't met.
+type ApplyPromoCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ApplyPromoCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ApplyPromoCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ApplyPromoCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ApplyPromoCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ApplyPromoCodeRequestValidationError) ErrorName() string {
+	return "ApplyPromoCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ApplyPromoCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sApplyPromoCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ApplyPromoCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ApplyPromoCodeRequestValidationError{}
+
+// Validate checks the field values on RemovePromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *RemovePromoCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on RemovePromoCodeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// RemovePromoCodeRequestMultiError, or nil if none found.
+func (m *RemovePromoCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *RemovePromoCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *RemovePromoCodeRequest_User:
+		if v == nil {
+			err := RemovePromoCodeRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := RemovePromoCodeRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *RemovePromoCodeRequest_CartToken:
+		if v == nil {
+			err := RemovePromoCodeRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := RemovePromoCodeRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := RemovePromoCodeRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return RemovePromoCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// RemovePromoCodeRequestMultiError is an error wrapping multiple validation
+// errors returned by RemovePromoCodeRequest.ValidateAll() if the designated
+// constraints aren't met.
+type RemovePromoCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m RemovePromoCodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m RemovePromoCodeRequestMultiError) AllErrors() []error { return m }
+
+// RemovePromoCodeRequestValidationError is the validation error returned by
+// RemovePromoCodeRequest.Validate if the designated constraints aren't met.
+type RemovePromoCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e RemovePromoCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e RemovePromoCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e RemovePromoCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e RemovePromoCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e RemovePromoCodeRequestValidationError) ErrorName() string {
+	return "RemovePromoCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e RemovePromoCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sRemovePromoCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = RemovePromoCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = RemovePromoCodeRequestValidationError{}
+
+// Validate checks the field values on DeliveryAddress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeliveryAddress) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeliveryAddress with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeliveryAddressMultiError, or nil if none found.
+func (m *DeliveryAddress) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeliveryAddress) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	if l := utf8.RuneCountInString(m.GetCity()); l < 1 || l > 128 {
+		err := DeliveryAddressValidationError{
+			field:  "City",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetStreet()); l < 1 || l > 256 {
+		err := DeliveryAddressValidationError{
+			field:  "Street",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetHouse()); l < 1 || l > 32 {
+		err := DeliveryAddressValidationError{
+			field:  "House",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetApartment()) > 32 {
+		err := DeliveryAddressValidationError{
+			field:  "Apartment",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPostalCode()) > 16 {
+		err := DeliveryAddressValidationError{
+			field:  "PostalCode",
+			reason: "value length must be at most 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 512 {
+		err := DeliveryAddressValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeliveryAddressMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeliveryAddressMultiError is an error wrapping multiple validation errors
+// returned by DeliveryAddress.ValidateAll() if the designated constraints
+// aren't met.
+type DeliveryAddressMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeliveryAddressMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeliveryAddressMultiError) AllErrors() []error { return m }
+
+// DeliveryAddressValidationError is the validation error returned by
+// DeliveryAddress.Validate if the designated constraints aren't met.
+type DeliveryAddressValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeliveryAddressValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeliveryAddressValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeliveryAddressValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeliveryAddressValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeliveryAddressValidationError) ErrorName() string { return "DeliveryAddressValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeliveryAddressValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeliveryAddress.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeliveryAddressValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeliveryAddressValidationError{}
+
+// Validate checks the field values on CreateAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAddressRequestMultiError, or nil if none found.
+func (m *CreateAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := CreateAddressRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAddress() == nil {
+		err := CreateAddressRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateAddressRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateAddressRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateAddressRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return CreateAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by CreateAddressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAddressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAddressRequestMultiError) AllErrors() []error { return m }
+
+// CreateAddressRequestValidationError is the validation error returned by
+// CreateAddressRequest.Validate if the designated constraints aren't met.
+type CreateAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAddressRequestValidationError) ErrorName() string {
+	return "CreateAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAddressRequestValidationError{}
+
+// Validate checks the field values on CreateAddressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateAddressResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateAddressResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateAddressResponseMultiError, or nil if none found.
+func (m *CreateAddressResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateAddressResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for AddressID
+
+	if len(errors) > 0 {
+		return CreateAddressResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateAddressResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateAddressResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateAddressResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateAddressResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateAddressResponseMultiError) AllErrors() []error { return m }
+
+// CreateAddressResponseValidationError is the validation error returned by
+// CreateAddressResponse.Validate if the designated constraints aren't met.
+type CreateAddressResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateAddressResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateAddressResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateAddressResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateAddressResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateAddressResponseValidationError) ErrorName() string {
+	return "CreateAddressResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateAddressResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateAddressResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateAddressResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateAddressResponseValidationError{}
+
+// Validate checks the field values on ListAddressesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAddressesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAddressesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAddressesRequestMultiError, or nil if none found.
+func (m *ListAddressesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAddressesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := ListAddressesRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListAddressesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAddressesRequestMultiError is an error wrapping multiple validation
+// errors returned by ListAddressesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListAddressesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAddressesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAddressesRequestMultiError) AllErrors() []error { return m }
+
+// ListAddressesRequestValidationError is the validation error returned by
+// ListAddressesRequest.Validate if the designated constraints aren't met.
+type ListAddressesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAddressesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAddressesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAddressesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAddressesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAddressesRequestValidationError) ErrorName() string {
+	return "ListAddressesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAddressesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAddressesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAddressesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAddressesRequestValidationError{}
+
+// Validate checks the field values on ListAddressesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListAddressesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListAddressesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListAddressesResponseMultiError, or nil if none found.
+func (m *ListAddressesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListAddressesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetAddresses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListAddressesResponseValidationError{
+						field:  fmt.Sprintf("Addresses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListCartResponseValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
+					errors = append(errors, ListAddressesResponseValidationError{
+						field:  fmt.Sprintf("Addresses[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
-			if err := v.Validate(); err != nil {
-				return ListCartResponseValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
-					reason: "embedded message failed validation",
-					cause:  err,
-				}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListAddressesResponseValidationError{
+					field:  fmt.Sprintf("Addresses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListAddressesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListAddressesResponseMultiError is an error wrapping multiple validation
+// errors returned by ListAddressesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListAddressesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListAddressesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListAddressesResponseMultiError) AllErrors() []error { return m }
+
+// ListAddressesResponseValidationError is the validation error returned by
+// ListAddressesResponse.Validate if the designated constraints aren't met.
+type ListAddressesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListAddressesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListAddressesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListAddressesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListAddressesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListAddressesResponseValidationError) ErrorName() string {
+	return "ListAddressesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListAddressesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListAddressesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListAddressesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListAddressesResponseValidationError{}
+
+// Validate checks the field values on UpdateAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UpdateAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UpdateAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UpdateAddressRequestMultiError, or nil if none found.
+func (m *UpdateAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UpdateAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := UpdateAddressRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAddressID() <= 0 {
+		err := UpdateAddressRequestValidationError{
+			field:  "AddressID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAddress() == nil {
+		err := UpdateAddressRequestValidationError{
+			field:  "Address",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UpdateAddressRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UpdateAddressRequestValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UpdateAddressRequestValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UpdateAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UpdateAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by UpdateAddressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UpdateAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UpdateAddressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UpdateAddressRequestMultiError) AllErrors() []error { return m }
+
+// UpdateAddressRequestValidationError is the validation error returned by
+// UpdateAddressRequest.Validate if the designated constraints aren't met.
+type UpdateAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UpdateAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UpdateAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UpdateAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UpdateAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UpdateAddressRequestValidationError) ErrorName() string {
+	return "UpdateAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UpdateAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUpdateAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UpdateAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UpdateAddressRequestValidationError{}
+
+// Validate checks the field values on DeleteAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *DeleteAddressRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeleteAddressRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// DeleteAddressRequestMultiError, or nil if none found.
+func (m *DeleteAddressRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeleteAddressRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := DeleteAddressRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAddressID() <= 0 {
+		err := DeleteAddressRequestValidationError{
+			field:  "AddressID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return DeleteAddressRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeleteAddressRequestMultiError is an error wrapping multiple validation
+// errors returned by DeleteAddressRequest.ValidateAll() if the designated
+// constraints aren't met.
+type DeleteAddressRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeleteAddressRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeleteAddressRequestMultiError) AllErrors() []error { return m }
+
+// DeleteAddressRequestValidationError is the validation error returned by
+// DeleteAddressRequest.Validate if the designated constraints aren't met.
+type DeleteAddressRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeleteAddressRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeleteAddressRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeleteAddressRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeleteAddressRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeleteAddressRequestValidationError) ErrorName() string {
+	return "DeleteAddressRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e DeleteAddressRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeleteAddressRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeleteAddressRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeleteAddressRequestValidationError{}
+
+// Validate checks the field values on PurchaseRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurchaseRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurchaseRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurchaseRequestMultiError, or nil if none found.
+func (m *PurchaseRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurchaseRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := PurchaseRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAddressID() <= 0 {
+		err := PurchaseRequestValidationError{
+			field:  "AddressID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _PurchaseRequest_ShippingMethod_NotInLookup[m.GetShippingMethod()]; ok {
+		err := PurchaseRequestValidationError{
+			field:  "ShippingMethod",
+			reason: "value must not be in list [SHIPPING_METHOD_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ShippingMethod_name[int32(m.GetShippingMethod())]; !ok {
+		err := PurchaseRequestValidationError{
+			field:  "ShippingMethod",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PurchaseRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurchaseRequestMultiError is an error wrapping multiple validation errors
+// returned by PurchaseRequest.ValidateAll() if the designated constraints
+// aren't met.
+type PurchaseRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurchaseRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurchaseRequestMultiError) AllErrors() []error { return m }
+
+// PurchaseRequestValidationError is the validation error returned by
+// PurchaseRequest.Validate if the designated constraints aren't met.
+type PurchaseRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurchaseRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurchaseRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurchaseRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurchaseRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurchaseRequestValidationError) ErrorName() string { return "PurchaseRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurchaseRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurchaseRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurchaseRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurchaseRequestValidationError{}
+
+var _PurchaseRequest_ShippingMethod_NotInLookup = map[ShippingMethod]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on PurchaseResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *PurchaseResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurchaseResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PurchaseResponseMultiError, or nil if none found.
+func (m *PurchaseResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurchaseResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderID
+
+	if len(errors) > 0 {
+		return PurchaseResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurchaseResponseMultiError is an error wrapping multiple validation errors
+// returned by PurchaseResponse.ValidateAll() if the designated constraints
+// aren't met.
+type PurchaseResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurchaseResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurchaseResponseMultiError) AllErrors() []error { return m }
+
+// PurchaseResponseValidationError is the validation error returned by
+// PurchaseResponse.Validate if the designated constraints aren't met.
+type PurchaseResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurchaseResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurchaseResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurchaseResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurchaseResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurchaseResponseValidationError) ErrorName() string { return "PurchaseResponseValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurchaseResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurchaseResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurchaseResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurchaseResponseValidationError{}
+
+// Validate checks the field values on SaveForLaterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SaveForLaterRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SaveForLaterRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SaveForLaterRequestMultiError, or nil if none found.
+func (m *SaveForLaterRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SaveForLaterRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := SaveForLaterRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *SaveForLaterRequest_User:
+		if v == nil {
+			err := SaveForLaterRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := SaveForLaterRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *SaveForLaterRequest_CartToken:
+		if v == nil {
+			err := SaveForLaterRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := SaveForLaterRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := SaveForLaterRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SaveForLaterRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SaveForLaterRequestMultiError is an error wrapping multiple validation
+// errors returned by SaveForLaterRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SaveForLaterRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SaveForLaterRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SaveForLaterRequestMultiError) AllErrors() []error { return m }
+
+// SaveForLaterRequestValidationError is the validation error returned by
+// SaveForLaterRequest.Validate if the designated constraints aren't met.
+type SaveForLaterRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SaveForLaterRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SaveForLaterRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SaveForLaterRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SaveForLaterRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SaveForLaterRequestValidationError) ErrorName() string {
+	return "SaveForLaterRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SaveForLaterRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSaveForLaterRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SaveForLaterRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SaveForLaterRequestValidationError{}
+
+// Validate checks the field values on MoveToCartRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *MoveToCartRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on MoveToCartRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// MoveToCartRequestMultiError, or nil if none found.
+func (m *MoveToCartRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *MoveToCartRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := MoveToCartRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *MoveToCartRequest_User:
+		if v == nil {
+			err := MoveToCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
 			}
+			errors = append(errors, err)
 		}
+		oneofOwnerPresent = true
 
-	}
+		if m.GetUser() <= 0 {
+			err := MoveToCartRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *MoveToCartRequest_CartToken:
+		if v == nil {
+			err := MoveToCartRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := MoveToCartRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
 
-	// no validation rules for TotalPrice
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := MoveToCartRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return ListCartResponseMultiError(errors)
+		return MoveToCartRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListCartResponseMultiError is an error wrapping multiple validation errors
-// returned by ListCartResponse.ValidateAll() if the designated constraints
+// MoveToCartRequestMultiError is an error wrapping multiple validation errors
+// returned by MoveToCartRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListCartResponseMultiError []error
+type MoveToCartRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListCartResponseMultiError) Error() string {
+func (m MoveToCartRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -637,11 +3730,11 @@ func (m ListCartResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListCartResponseMultiError) AllErrors() []error { return m }
+func (m MoveToCartRequestMultiError) AllErrors() []error { return m }
 
-// ListCartResponseValidationError is the validation error returned by
-// ListCartResponse.Validate if the designated constraints aren't met.
-type ListCartResponseValidationError struct {
+// MoveToCartRequestValidationError is the validation error returned by
+// MoveToCartRequest.Validate if the designated constraints aren't met.
+type MoveToCartRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -649,22 +3742,24 @@ type ListCartResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListCartResponseValidationError) Field() string { return e.field }
+func (e MoveToCartRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListCartResponseValidationError) Reason() string { return e.reason }
+func (e MoveToCartRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListCartResponseValidationError) Cause() error { return e.cause }
+func (e MoveToCartRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListCartResponseValidationError) Key() bool { return e.key }
+func (e MoveToCartRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListCartResponseValidationError) ErrorName() string { return "ListCartResponseValidationError" }
+func (e MoveToCartRequestValidationError) ErrorName() string {
+	return "MoveToCartRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e ListCartResponseValidationError) Error() string {
+func (e MoveToCartRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -676,14 +3771,14 @@ func (e ListCartResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListCartResponse.%s: %s%s",
+		"invalid %sMoveToCartRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListCartResponseValidationError{}
+var _ error = MoveToCartRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -691,34 +3786,87 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListCartResponseValidationError{}
+} = MoveToCartRequestValidationError{}
 
-// Validate checks the field values on PurchaseRequest with the rules defined
+// Validate checks the field values on ListSavedRequest with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *PurchaseRequest) Validate() error {
+func (m *ListSavedRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PurchaseRequest with the rules
+// ValidateAll checks the field values on ListSavedRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PurchaseRequestMultiError, or nil if none found.
-func (m *PurchaseRequest) ValidateAll() error {
+// ListSavedRequestMultiError, or nil if none found.
+func (m *ListSavedRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PurchaseRequest) validate(all bool) error {
+func (m *ListSavedRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUser() <= 0 {
-		err := PurchaseRequestValidationError{
-			field:  "User",
-			reason: "value must be greater than 0",
+	oneofOwnerPresent := false
+	switch v := m.Owner.(type) {
+	case *ListSavedRequest_User:
+		if v == nil {
+			err := ListSavedRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if m.GetUser() <= 0 {
+			err := ListSavedRequestValidationError{
+				field:  "User",
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	case *ListSavedRequest_CartToken:
+		if v == nil {
+			err := ListSavedRequestValidationError{
+				field:  "Owner",
+				reason: "oneof value cannot be a typed-nil",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+		oneofOwnerPresent = true
+
+		if utf8.RuneCountInString(m.GetCartToken()) < 1 {
+			err := ListSavedRequestValidationError{
+				field:  "CartToken",
+				reason: "value length must be at least 1 runes",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	default:
+		_ = v // ensures v is used
+	}
+	if !oneofOwnerPresent {
+		err := ListSavedRequestValidationError{
+			field:  "Owner",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -727,19 +3875,19 @@ func (m *PurchaseRequest) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return PurchaseRequestMultiError(errors)
+		return ListSavedRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// PurchaseRequestMultiError is an error wrapping multiple validation errors
-// returned by PurchaseRequest.ValidateAll() if the designated constraints
+// ListSavedRequestMultiError is an error wrapping multiple validation errors
+// returned by ListSavedRequest.ValidateAll() if the designated constraints
 // aren't met.
-type PurchaseRequestMultiError []error
+type ListSavedRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PurchaseRequestMultiError) Error() string {
+func (m ListSavedRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -748,11 +3896,11 @@ func (m PurchaseRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PurchaseRequestMultiError) AllErrors() []error { return m }
+func (m ListSavedRequestMultiError) AllErrors() []error { return m }
 
-// PurchaseRequestValidationError is the validation error returned by
-// PurchaseRequest.Validate if the designated constraints aren't met.
-type PurchaseRequestValidationError struct {
+// ListSavedRequestValidationError is the validation error returned by
+// ListSavedRequest.Validate if the designated constraints aren't met.
+type ListSavedRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -760,22 +3908,22 @@ type PurchaseRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e PurchaseRequestValidationError) Field() string { return e.field }
+func (e ListSavedRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PurchaseRequestValidationError) Reason() string { return e.reason }
+func (e ListSavedRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PurchaseRequestValidationError) Cause() error { return e.cause }
+func (e ListSavedRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PurchaseRequestValidationError) Key() bool { return e.key }
+func (e ListSavedRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PurchaseRequestValidationError) ErrorName() string { return "PurchaseRequestValidationError" }
+func (e ListSavedRequestValidationError) ErrorName() string { return "ListSavedRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e PurchaseRequestValidationError) Error() string {
+func (e ListSavedRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -787,14 +3935,14 @@ func (e PurchaseRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPurchaseRequest.%s: %s%s",
+		"invalid %sListSavedRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PurchaseRequestValidationError{}
+var _ error = ListSavedRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -802,46 +3950,210 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PurchaseRequestValidationError{}
+} = ListSavedRequestValidationError{}
 
-// Validate checks the field values on PurchaseResponse with the rules defined
+// Validate checks the field values on SavedItem with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *SavedItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SavedItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in SavedItemMultiError, or nil
+// if none found.
+func (m *SavedItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SavedItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if all {
+		switch v := interface{}(m.GetItem()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, SavedItemValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, SavedItemValidationError{
+					field:  "Item",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetItem()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return SavedItemValidationError{
+				field:  "Item",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for InStock
+
+	// no validation rules for Available
+
+	if len(errors) > 0 {
+		return SavedItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// SavedItemMultiError is an error wrapping multiple validation errors returned
+// by SavedItem.ValidateAll() if the designated constraints aren't met.
+type SavedItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SavedItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SavedItemMultiError) AllErrors() []error { return m }
+
+// SavedItemValidationError is the validation error returned by
+// SavedItem.Validate if the designated constraints aren't met.
+type SavedItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SavedItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SavedItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SavedItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SavedItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SavedItemValidationError) ErrorName() string { return "SavedItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SavedItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSavedItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SavedItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SavedItemValidationError{}
+
+// Validate checks the field values on ListSavedResponse with the rules defined
 // in the proto definition for this message. If any rules are violated, the
 // first error encountered is returned, or nil if there are no violations.
-func (m *PurchaseResponse) Validate() error {
+func (m *ListSavedResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on PurchaseResponse with the rules
+// ValidateAll checks the field values on ListSavedResponse with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// PurchaseResponseMultiError, or nil if none found.
-func (m *PurchaseResponse) ValidateAll() error {
+// ListSavedResponseMultiError, or nil if none found.
+func (m *ListSavedResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *PurchaseResponse) validate(all bool) error {
+func (m *ListSavedResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderID
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListSavedResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListSavedResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListSavedResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return PurchaseResponseMultiError(errors)
+		return ListSavedResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// PurchaseResponseMultiError is an error wrapping multiple validation errors
-// returned by PurchaseResponse.ValidateAll() if the designated constraints
+// ListSavedResponseMultiError is an error wrapping multiple validation errors
+// returned by ListSavedResponse.ValidateAll() if the designated constraints
 // aren't met.
-type PurchaseResponseMultiError []error
+type ListSavedResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m PurchaseResponseMultiError) Error() string {
+func (m ListSavedResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -850,11 +4162,11 @@ func (m PurchaseResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m PurchaseResponseMultiError) AllErrors() []error { return m }
+func (m ListSavedResponseMultiError) AllErrors() []error { return m }
 
-// PurchaseResponseValidationError is the validation error returned by
-// PurchaseResponse.Validate if the designated constraints aren't met.
-type PurchaseResponseValidationError struct {
+// ListSavedResponseValidationError is the validation error returned by
+// ListSavedResponse.Validate if the designated constraints aren't met.
+type ListSavedResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -862,22 +4174,24 @@ type PurchaseResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e PurchaseResponseValidationError) Field() string { return e.field }
+func (e ListSavedResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e PurchaseResponseValidationError) Reason() string { return e.reason }
+func (e ListSavedResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e PurchaseResponseValidationError) Cause() error { return e.cause }
+func (e ListSavedResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e PurchaseResponseValidationError) Key() bool { return e.key }
+func (e ListSavedResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e PurchaseResponseValidationError) ErrorName() string { return "PurchaseResponseValidationError" }
+func (e ListSavedResponseValidationError) ErrorName() string {
+	return "ListSavedResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e PurchaseResponseValidationError) Error() string {
+func (e ListSavedResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -889,14 +4203,14 @@ func (e PurchaseResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sPurchaseResponse.%s: %s%s",
+		"invalid %sListSavedResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = PurchaseResponseValidationError{}
+var _ error = ListSavedResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -904,4 +4218,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = PurchaseResponseValidationError{}
+} = ListSavedResponseValidationError{}
@@ -20,10 +20,24 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Cart_AddToCart_FullMethodName      = "/cart.Cart/AddToCart"
-	Cart_DeleteFromCart_FullMethodName = "/cart.Cart/DeleteFromCart"
-	Cart_ListCart_FullMethodName       = "/cart.Cart/ListCart"
-	Cart_Purchase_FullMethodName       = "/cart.Cart/Purchase"
+	Cart_AddToCart_FullMethodName        = "/cart.Cart/AddToCart"
+	Cart_DeleteFromCart_FullMethodName   = "/cart.Cart/DeleteFromCart"
+	Cart_SetCartItemCount_FullMethodName = "/cart.Cart/SetCartItemCount"
+	Cart_RemoveCartItem_FullMethodName   = "/cart.Cart/RemoveCartItem"
+	Cart_ClearCart_FullMethodName        = "/cart.Cart/ClearCart"
+	Cart_ListCart_FullMethodName         = "/cart.Cart/ListCart"
+	Cart_CreateGuestCart_FullMethodName  = "/cart.Cart/CreateGuestCart"
+	Cart_MergeCarts_FullMethodName       = "/cart.Cart/MergeCarts"
+	Cart_ApplyPromoCode_FullMethodName   = "/cart.Cart/ApplyPromoCode"
+	Cart_RemovePromoCode_FullMethodName  = "/cart.Cart/RemovePromoCode"
+	Cart_CreateAddress_FullMethodName    = "/cart.Cart/CreateAddress"
+	Cart_ListAddresses_FullMethodName    = "/cart.Cart/ListAddresses"
+	Cart_UpdateAddress_FullMethodName    = "/cart.Cart/UpdateAddress"
+	Cart_DeleteAddress_FullMethodName    = "/cart.Cart/DeleteAddress"
+	Cart_Purchase_FullMethodName         = "/cart.Cart/Purchase"
+	Cart_SaveForLater_FullMethodName     = "/cart.Cart/SaveForLater"
+	Cart_MoveToCart_FullMethodName       = "/cart.Cart/MoveToCart"
+	Cart_ListSaved_FullMethodName        = "/cart.Cart/ListSaved"
 )
 
 // CartClient is the client API for Cart service.
@@ -32,8 +46,22 @@ const (
 type CartClient interface {
 	AddToCart(ctx context.Context, in *AddToCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	DeleteFromCart(ctx context.Context, in *DeleteFromCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	SetCartItemCount(ctx context.Context, in *SetCartItemCountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error)
+	CreateGuestCart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CreateGuestCartResponse, error)
+	MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ApplyPromoCode(ctx context.Context, in *ApplyPromoCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	RemovePromoCode(ctx context.Context, in *RemovePromoCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error)
+	ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error)
+	UpdateAddress(ctx context.Context, in *UpdateAddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	DeleteAddress(ctx context.Context, in *DeleteAddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Purchase(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*PurchaseResponse, error)
+	SaveForLater(ctx context.Context, in *SaveForLaterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+	ListSaved(ctx context.Context, in *ListSavedRequest, opts ...grpc.CallOption) (*ListSavedResponse, error)
 }
 
 type cartClient struct {
@@ -62,6 +90,33 @@ func (c *cartClient) DeleteFromCart(ctx context.Context, in *DeleteFromCartReque
 	return out, nil
 }
 
+func (c *cartClient) SetCartItemCount(ctx context.Context, in *SetCartItemCountRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_SetCartItemCount_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemoveCartItem(ctx context.Context, in *RemoveCartItemRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_RemoveCartItem_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ClearCart(ctx context.Context, in *ClearCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_ClearCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartClient) ListCart(ctx context.Context, in *ListCartRequest, opts ...grpc.CallOption) (*ListCartResponse, error) {
 	out := new(ListCartResponse)
 	err := c.cc.Invoke(ctx, Cart_ListCart_FullMethodName, in, out, opts...)
@@ -71,6 +126,78 @@ func (c *cartClient) ListCart(ctx context.Context, in *ListCartRequest, opts ...
 	return out, nil
 }
 
+func (c *cartClient) CreateGuestCart(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*CreateGuestCartResponse, error) {
+	out := new(CreateGuestCartResponse)
+	err := c.cc.Invoke(ctx, Cart_CreateGuestCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MergeCarts(ctx context.Context, in *MergeCartsRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_MergeCarts_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ApplyPromoCode(ctx context.Context, in *ApplyPromoCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_ApplyPromoCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) RemovePromoCode(ctx context.Context, in *RemovePromoCodeRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_RemovePromoCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) CreateAddress(ctx context.Context, in *CreateAddressRequest, opts ...grpc.CallOption) (*CreateAddressResponse, error) {
+	out := new(CreateAddressResponse)
+	err := c.cc.Invoke(ctx, Cart_CreateAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ListAddresses(ctx context.Context, in *ListAddressesRequest, opts ...grpc.CallOption) (*ListAddressesResponse, error) {
+	out := new(ListAddressesResponse)
+	err := c.cc.Invoke(ctx, Cart_ListAddresses_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) UpdateAddress(ctx context.Context, in *UpdateAddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_UpdateAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) DeleteAddress(ctx context.Context, in *DeleteAddressRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_DeleteAddress_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *cartClient) Purchase(ctx context.Context, in *PurchaseRequest, opts ...grpc.CallOption) (*PurchaseResponse, error) {
 	out := new(PurchaseResponse)
 	err := c.cc.Invoke(ctx, Cart_Purchase_FullMethodName, in, out, opts...)
@@ -80,14 +207,55 @@ func (c *cartClient) Purchase(ctx context.Context, in *PurchaseRequest, opts ...
 	return out, nil
 }
 
+func (c *cartClient) SaveForLater(ctx context.Context, in *SaveForLaterRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_SaveForLater_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) MoveToCart(ctx context.Context, in *MoveToCartRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Cart_MoveToCart_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *cartClient) ListSaved(ctx context.Context, in *ListSavedRequest, opts ...grpc.CallOption) (*ListSavedResponse, error) {
+	out := new(ListSavedResponse)
+	err := c.cc.Invoke(ctx, Cart_ListSaved_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // CartServer is the server API for Cart service.
 // All implementations must embed UnimplementedCartServer
 // for forward compatibility
 type CartServer interface {
 	AddToCart(context.Context, *AddToCartRequest) (*emptypb.Empty, error)
 	DeleteFromCart(context.Context, *DeleteFromCartRequest) (*emptypb.Empty, error)
+	SetCartItemCount(context.Context, *SetCartItemCountRequest) (*emptypb.Empty, error)
+	RemoveCartItem(context.Context, *RemoveCartItemRequest) (*emptypb.Empty, error)
+	ClearCart(context.Context, *ClearCartRequest) (*emptypb.Empty, error)
 	ListCart(context.Context, *ListCartRequest) (*ListCartResponse, error)
+	CreateGuestCart(context.Context, *emptypb.Empty) (*CreateGuestCartResponse, error)
+	MergeCarts(context.Context, *MergeCartsRequest) (*emptypb.Empty, error)
+	ApplyPromoCode(context.Context, *ApplyPromoCodeRequest) (*emptypb.Empty, error)
+	RemovePromoCode(context.Context, *RemovePromoCodeRequest) (*emptypb.Empty, error)
+	CreateAddress(context.Context, *CreateAddressRequest) (*CreateAddressResponse, error)
+	ListAddresses(context.Context, *ListAddressesRequest) (*ListAddressesResponse, error)
+	UpdateAddress(context.Context, *UpdateAddressRequest) (*emptypb.Empty, error)
+	DeleteAddress(context.Context, *DeleteAddressRequest) (*emptypb.Empty, error)
 	Purchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error)
+	SaveForLater(context.Context, *SaveForLaterRequest) (*emptypb.Empty, error)
+	MoveToCart(context.Context, *MoveToCartRequest) (*emptypb.Empty, error)
+	ListSaved(context.Context, *ListSavedRequest) (*ListSavedResponse, error)
 	mustEmbedUnimplementedCartServer()
 }
 
@@ -101,12 +269,54 @@ func (UnimplementedCartServer) AddToCart(context.Context, *AddToCartRequest) (*e
 func (UnimplementedCartServer) DeleteFromCart(context.Context, *DeleteFromCartRequest) (*emptypb.Empty, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DeleteFromCart not implemented")
 }
+func (UnimplementedCartServer) SetCartItemCount(context.Context, *SetCartItemCountRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetCartItemCount not implemented")
+}
+func (UnimplementedCartServer) RemoveCartItem(context.Context, *RemoveCartItemRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveCartItem not implemented")
+}
+func (UnimplementedCartServer) ClearCart(context.Context, *ClearCartRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClearCart not implemented")
+}
 func (UnimplementedCartServer) ListCart(context.Context, *ListCartRequest) (*ListCartResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method ListCart not implemented")
 }
+func (UnimplementedCartServer) CreateGuestCart(context.Context, *emptypb.Empty) (*CreateGuestCartResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateGuestCart not implemented")
+}
+func (UnimplementedCartServer) MergeCarts(context.Context, *MergeCartsRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MergeCarts not implemented")
+}
+func (UnimplementedCartServer) ApplyPromoCode(context.Context, *ApplyPromoCodeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ApplyPromoCode not implemented")
+}
+func (UnimplementedCartServer) RemovePromoCode(context.Context, *RemovePromoCodeRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemovePromoCode not implemented")
+}
+func (UnimplementedCartServer) CreateAddress(context.Context, *CreateAddressRequest) (*CreateAddressResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateAddress not implemented")
+}
+func (UnimplementedCartServer) ListAddresses(context.Context, *ListAddressesRequest) (*ListAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListAddresses not implemented")
+}
+func (UnimplementedCartServer) UpdateAddress(context.Context, *UpdateAddressRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateAddress not implemented")
+}
+func (UnimplementedCartServer) DeleteAddress(context.Context, *DeleteAddressRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteAddress not implemented")
+}
 func (UnimplementedCartServer) Purchase(context.Context, *PurchaseRequest) (*PurchaseResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Purchase not implemented")
 }
+func (UnimplementedCartServer) SaveForLater(context.Context, *SaveForLaterRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SaveForLater not implemented")
+}
+func (UnimplementedCartServer) MoveToCart(context.Context, *MoveToCartRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MoveToCart not implemented")
+}
+func (UnimplementedCartServer) ListSaved(context.Context, *ListSavedRequest) (*ListSavedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListSaved not implemented")
+}
 func (UnimplementedCartServer) mustEmbedUnimplementedCartServer() {}
 
 // UnsafeCartServer may be embedded to opt out of forward compatibility for this service.
@@ -156,6 +366,60 @@ func _Cart_DeleteFromCart_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cart_SetCartItemCount_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetCartItemCountRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).SetCartItemCount(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_SetCartItemCount_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).SetCartItemCount(ctx, req.(*SetCartItemCountRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemoveCartItem_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveCartItemRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemoveCartItem(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemoveCartItem_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemoveCartItem(ctx, req.(*RemoveCartItemRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ClearCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ClearCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ClearCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ClearCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ClearCart(ctx, req.(*ClearCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cart_ListCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(ListCartRequest)
 	if err := dec(in); err != nil {
@@ -174,6 +438,150 @@ func _Cart_ListCart_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cart_CreateGuestCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).CreateGuestCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_CreateGuestCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).CreateGuestCart(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MergeCarts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MergeCartsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MergeCarts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MergeCarts_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MergeCarts(ctx, req.(*MergeCartsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ApplyPromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ApplyPromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ApplyPromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ApplyPromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ApplyPromoCode(ctx, req.(*ApplyPromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_RemovePromoCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemovePromoCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).RemovePromoCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_RemovePromoCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).RemovePromoCode(ctx, req.(*RemovePromoCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_CreateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).CreateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_CreateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).CreateAddress(ctx, req.(*CreateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ListAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListAddressesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ListAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ListAddresses_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ListAddresses(ctx, req.(*ListAddressesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_UpdateAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).UpdateAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_UpdateAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).UpdateAddress(ctx, req.(*UpdateAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_DeleteAddress_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).DeleteAddress(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_DeleteAddress_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).DeleteAddress(ctx, req.(*DeleteAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Cart_Purchase_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(PurchaseRequest)
 	if err := dec(in); err != nil {
@@ -192,6 +600,60 @@ func _Cart_Purchase_Handler(srv interface{}, ctx context.Context, dec func(inter
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Cart_SaveForLater_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SaveForLaterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).SaveForLater(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_SaveForLater_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).SaveForLater(ctx, req.(*SaveForLaterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_MoveToCart_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MoveToCartRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).MoveToCart(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_MoveToCart_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).MoveToCart(ctx, req.(*MoveToCartRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Cart_ListSaved_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListSavedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(CartServer).ListSaved(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Cart_ListSaved_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(CartServer).ListSaved(ctx, req.(*ListSavedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Cart_ServiceDesc is the grpc.ServiceDesc for Cart service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -207,14 +669,70 @@ var Cart_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "DeleteFromCart",
 			Handler:    _Cart_DeleteFromCart_Handler,
 		},
+		{
+			MethodName: "SetCartItemCount",
+			Handler:    _Cart_SetCartItemCount_Handler,
+		},
+		{
+			MethodName: "RemoveCartItem",
+			Handler:    _Cart_RemoveCartItem_Handler,
+		},
+		{
+			MethodName: "ClearCart",
+			Handler:    _Cart_ClearCart_Handler,
+		},
 		{
 			MethodName: "ListCart",
 			Handler:    _Cart_ListCart_Handler,
 		},
+		{
+			MethodName: "CreateGuestCart",
+			Handler:    _Cart_CreateGuestCart_Handler,
+		},
+		{
+			MethodName: "MergeCarts",
+			Handler:    _Cart_MergeCarts_Handler,
+		},
+		{
+			MethodName: "ApplyPromoCode",
+			Handler:    _Cart_ApplyPromoCode_Handler,
+		},
+		{
+			MethodName: "RemovePromoCode",
+			Handler:    _Cart_RemovePromoCode_Handler,
+		},
+		{
+			MethodName: "CreateAddress",
+			Handler:    _Cart_CreateAddress_Handler,
+		},
+		{
+			MethodName: "ListAddresses",
+			Handler:    _Cart_ListAddresses_Handler,
+		},
+		{
+			MethodName: "UpdateAddress",
+			Handler:    _Cart_UpdateAddress_Handler,
+		},
+		{
+			MethodName: "DeleteAddress",
+			Handler:    _Cart_DeleteAddress_Handler,
+		},
 		{
 			MethodName: "Purchase",
 			Handler:    _Cart_Purchase_Handler,
 		},
+		{
+			MethodName: "SaveForLater",
+			Handler:    _Cart_SaveForLater_Handler,
+		},
+		{
+			MethodName: "MoveToCart",
+			Handler:    _Cart_MoveToCart_Handler,
+		},
+		{
+			MethodName: "ListSaved",
+			Handler:    _Cart_ListSaved_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "cart.proto",
@@ -373,6 +373,108 @@ var _ interface {
 	ErrorName() string
 } = StocksResponseValidationError{}
 
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Amount
+
+	// no validation rules for Currency
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string { return "MoneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
+
 // Validate checks the field values on OrderItem with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -399,6 +501,35 @@ func (m *OrderItem) validate(all bool) error {
 
 	// no validation rules for Count
 
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderItemValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return OrderItemMultiError(errors)
 	}
@@ -476,78 +607,73 @@ var _ interface {
 	ErrorName() string
 } = OrderItemValidationError{}
 
-// Validate checks the field values on CreateOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateOrderRequest) Validate() error {
+// Validate checks the field values on OrderPromotion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderPromotion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateOrderRequestMultiError, or nil if none found.
-func (m *CreateOrderRequest) ValidateAll() error {
+// ValidateAll checks the field values on OrderPromotion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderPromotionMultiError,
+// or nil if none found.
+func (m *OrderPromotion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateOrderRequest) validate(all bool) error {
+func (m *OrderPromotion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for User
-
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
+	// no validation rules for Code
 
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateOrderRequestValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateOrderRequestValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderPromotionValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return CreateOrderRequestValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				errors = append(errors, OrderPromotionValidationError{
+					field:  "Discount",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderPromotionValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return CreateOrderRequestMultiError(errors)
+		return OrderPromotionMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateOrderRequest.ValidateAll() if the designated constraints
+// OrderPromotionMultiError is an error wrapping multiple validation errors
+// returned by OrderPromotion.ValidateAll() if the designated constraints
 // aren't met.
-type CreateOrderRequestMultiError []error
+type OrderPromotionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateOrderRequestMultiError) Error() string {
+func (m OrderPromotionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -556,11 +682,11 @@ func (m CreateOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateOrderRequestMultiError) AllErrors() []error { return m }
+func (m OrderPromotionMultiError) AllErrors() []error { return m }
 
-// CreateOrderRequestValidationError is the validation error returned by
-// CreateOrderRequest.Validate if the designated constraints aren't met.
-type CreateOrderRequestValidationError struct {
+// OrderPromotionValidationError is the validation error returned by
+// OrderPromotion.Validate if the designated constraints aren't met.
+type OrderPromotionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -568,24 +694,22 @@ type CreateOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateOrderRequestValidationError) Field() string { return e.field }
+func (e OrderPromotionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateOrderRequestValidationError) Reason() string { return e.reason }
+func (e OrderPromotionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateOrderRequestValidationError) Cause() error { return e.cause }
+func (e OrderPromotionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateOrderRequestValidationError) Key() bool { return e.key }
+func (e OrderPromotionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateOrderRequestValidationError) ErrorName() string {
-	return "CreateOrderRequestValidationError"
-}
+func (e OrderPromotionValidationError) ErrorName() string { return "OrderPromotionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateOrderRequestValidationError) Error() string {
+func (e OrderPromotionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -597,14 +721,14 @@ func (e CreateOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateOrderRequest.%s: %s%s",
+		"invalid %sOrderPromotion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateOrderRequestValidationError{}
+var _ error = OrderPromotionValidationError{}
 
 var _ interface {
 	Field() string
@@ -612,46 +736,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateOrderRequestValidationError{}
+} = OrderPromotionValidationError{}
 
-// Validate checks the field values on CreateOrderResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateOrderResponse) Validate() error {
+// Validate checks the field values on DeliveryAddress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeliveryAddress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateOrderResponse with the rules
+// ValidateAll checks the field values on DeliveryAddress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateOrderResponseMultiError, or nil if none found.
-func (m *CreateOrderResponse) ValidateAll() error {
+// DeliveryAddressMultiError, or nil if none found.
+func (m *DeliveryAddress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateOrderResponse) validate(all bool) error {
+func (m *DeliveryAddress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderID
+	// no validation rules for City
+
+	// no validation rules for Street
+
+	// no validation rules for House
+
+	// no validation rules for Apartment
+
+	// no validation rules for PostalCode
+
+	// no validation rules for Comment
 
 	if len(errors) > 0 {
-		return CreateOrderResponseMultiError(errors)
+		return DeliveryAddressMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateOrderResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateOrderResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateOrderResponseMultiError []error
+// DeliveryAddressMultiError is an error wrapping multiple validation errors
+// returned by DeliveryAddress.ValidateAll() if the designated constraints
+// aren't met.
+type DeliveryAddressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateOrderResponseMultiError) Error() string {
+func (m DeliveryAddressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -660,11 +794,11 @@ func (m CreateOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateOrderResponseMultiError) AllErrors() []error { return m }
+func (m DeliveryAddressMultiError) AllErrors() []error { return m }
 
-// CreateOrderResponseValidationError is the validation error returned by
-// CreateOrderResponse.Validate if the designated constraints aren't met.
-type CreateOrderResponseValidationError struct {
+// DeliveryAddressValidationError is the validation error returned by
+// DeliveryAddress.Validate if the designated constraints aren't met.
+type DeliveryAddressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -672,24 +806,22 @@ type CreateOrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateOrderResponseValidationError) Field() string { return e.field }
+func (e DeliveryAddressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateOrderResponseValidationError) Reason() string { return e.reason }
+func (e DeliveryAddressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateOrderResponseValidationError) Cause() error { return e.cause }
+func (e DeliveryAddressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateOrderResponseValidationError) Key() bool { return e.key }
+func (e DeliveryAddressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateOrderResponseValidationError) ErrorName() string {
-	return "CreateOrderResponseValidationError"
-}
+func (e DeliveryAddressValidationError) ErrorName() string { return "DeliveryAddressValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateOrderResponseValidationError) Error() string {
+func (e DeliveryAddressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -701,14 +833,14 @@ func (e CreateOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateOrderResponse.%s: %s%s",
+		"invalid %sDeliveryAddress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateOrderResponseValidationError{}
+var _ error = DeliveryAddressValidationError{}
 
 var _ interface {
 	Field() string
@@ -716,4 +848,973 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateOrderResponseValidationError{}
+} = DeliveryAddressValidationError{}
+
+// Validate checks the field values on Shipping with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Shipping) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Shipping with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShippingMultiError, or nil
+// if none found.
+func (m *Shipping) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Shipping) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Method
+
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShippingValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShippingValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShippingValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ShippingMultiError(errors)
+	}
+
+	return nil
+}
+
+// ShippingMultiError is an error wrapping multiple validation errors returned
+// by Shipping.ValidateAll() if the designated constraints aren't met.
+type ShippingMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ShippingMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ShippingMultiError) AllErrors() []error { return m }
+
+// ShippingValidationError is the validation error returned by
+// Shipping.Validate if the designated constraints aren't met.
+type ShippingValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ShippingValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ShippingValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ShippingValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ShippingValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ShippingValidationError) ErrorName() string { return "ShippingValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ShippingValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sShipping.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ShippingValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ShippingValidationError{}
+
+// Validate checks the field values on CreateOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOrderRequestMultiError, or nil if none found.
+func (m *CreateOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateOrderRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateOrderRequestValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateOrderRequestValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPromotion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "Promotion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "Promotion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPromotion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrderRequestValidationError{
+				field:  "Promotion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetShipping()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "Shipping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "Shipping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShipping()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrderRequestValidationError{
+				field:  "Shipping",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPurchaseCaps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateOrderRequestValidationError{
+						field:  fmt.Sprintf("PurchaseCaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateOrderRequestValidationError{
+						field:  fmt.Sprintf("PurchaseCaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateOrderRequestValidationError{
+					field:  fmt.Sprintf("PurchaseCaps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return CreateOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CreateOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrderRequestMultiError) AllErrors() []error { return m }
+
+// CreateOrderRequestValidationError is the validation error returned by
+// CreateOrderRequest.Validate if the designated constraints aren't met.
+type CreateOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrderRequestValidationError) ErrorName() string {
+	return "CreateOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrderRequestValidationError{}
+
+// Validate checks the field values on PurchaseCap with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PurchaseCap) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurchaseCap with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PurchaseCapMultiError, or
+// nil if none found.
+func (m *PurchaseCap) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurchaseCap) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sku
+
+	// no validation rules for Limit
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, PurchaseCapValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, PurchaseCapValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return PurchaseCapValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return PurchaseCapMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurchaseCapMultiError is an error wrapping multiple validation errors
+// returned by PurchaseCap.ValidateAll() if the designated constraints aren't met.
+type PurchaseCapMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurchaseCapMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurchaseCapMultiError) AllErrors() []error { return m }
+
+// PurchaseCapValidationError is the validation error returned by
+// PurchaseCap.Validate if the designated constraints aren't met.
+type PurchaseCapValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurchaseCapValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurchaseCapValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurchaseCapValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurchaseCapValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurchaseCapValidationError) ErrorName() string { return "PurchaseCapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurchaseCapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurchaseCap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurchaseCapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurchaseCapValidationError{}
+
+// Validate checks the field values on CreateOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOrderResponseMultiError, or nil if none found.
+func (m *CreateOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderID
+
+	if len(errors) > 0 {
+		return CreateOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrderResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateOrderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrderResponseMultiError) AllErrors() []error { return m }
+
+// CreateOrderResponseValidationError is the validation error returned by
+// CreateOrderResponse.Validate if the designated constraints aren't met.
+type CreateOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrderResponseValidationError) ErrorName() string {
+	return "CreateOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrderResponseValidationError{}
+
+// Validate checks the field values on UserPurchasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserPurchasesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPurchasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPurchasesRequestMultiError, or nil if none found.
+func (m *UserPurchasesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPurchasesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	if all {
+		switch v := interface{}(m.GetSince()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, UserPurchasesRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, UserPurchasesRequestValidationError{
+					field:  "Since",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetSince()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return UserPurchasesRequestValidationError{
+				field:  "Since",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return UserPurchasesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPurchasesRequestMultiError is an error wrapping multiple validation
+// errors returned by UserPurchasesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserPurchasesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPurchasesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPurchasesRequestMultiError) AllErrors() []error { return m }
+
+// UserPurchasesRequestValidationError is the validation error returned by
+// UserPurchasesRequest.Validate if the designated constraints aren't met.
+type UserPurchasesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPurchasesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPurchasesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPurchasesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPurchasesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPurchasesRequestValidationError) ErrorName() string {
+	return "UserPurchasesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserPurchasesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPurchasesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPurchasesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPurchasesRequestValidationError{}
+
+// Validate checks the field values on PurchasedItem with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PurchasedItem) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PurchasedItem with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PurchasedItemMultiError, or
+// nil if none found.
+func (m *PurchasedItem) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PurchasedItem) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Sku
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return PurchasedItemMultiError(errors)
+	}
+
+	return nil
+}
+
+// PurchasedItemMultiError is an error wrapping multiple validation errors
+// returned by PurchasedItem.ValidateAll() if the designated constraints
+// aren't met.
+type PurchasedItemMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PurchasedItemMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PurchasedItemMultiError) AllErrors() []error { return m }
+
+// PurchasedItemValidationError is the validation error returned by
+// PurchasedItem.Validate if the designated constraints aren't met.
+type PurchasedItemValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PurchasedItemValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PurchasedItemValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PurchasedItemValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PurchasedItemValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PurchasedItemValidationError) ErrorName() string { return "PurchasedItemValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurchasedItemValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurchasedItem.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurchasedItemValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurchasedItemValidationError{}
+
+// Validate checks the field values on UserPurchasesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserPurchasesResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on UserPurchasesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPurchasesResponseMultiError, or nil if none found.
+func (m *UserPurchasesResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *UserPurchasesResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, UserPurchasesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, UserPurchasesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return UserPurchasesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return UserPurchasesResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// UserPurchasesResponseMultiError is an error wrapping multiple validation
+// errors returned by UserPurchasesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserPurchasesResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m UserPurchasesResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m UserPurchasesResponseMultiError) AllErrors() []error { return m }
+
+// UserPurchasesResponseValidationError is the validation error returned by
+// UserPurchasesResponse.Validate if the designated constraints aren't met.
+type UserPurchasesResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e UserPurchasesResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e UserPurchasesResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e UserPurchasesResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e UserPurchasesResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e UserPurchasesResponseValidationError) ErrorName() string {
+	return "UserPurchasesResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e UserPurchasesResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sUserPurchasesResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = UserPurchasesResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = UserPurchasesResponseValidationError{}
@@ -19,8 +19,9 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Loms_Stocks_FullMethodName      = "/loms.Loms/Stocks"
-	Loms_CreateOrder_FullMethodName = "/loms.Loms/CreateOrder"
+	Loms_Stocks_FullMethodName        = "/loms.Loms/Stocks"
+	Loms_CreateOrder_FullMethodName   = "/loms.Loms/CreateOrder"
+	Loms_UserPurchases_FullMethodName = "/loms.Loms/UserPurchases"
 )
 
 // LomsClient is the client API for Loms service.
@@ -29,6 +30,7 @@ const (
 type LomsClient interface {
 	Stocks(ctx context.Context, in *StocksRequest, opts ...grpc.CallOption) (*StocksResponse, error)
 	CreateOrder(ctx context.Context, in *CreateOrderRequest, opts ...grpc.CallOption) (*CreateOrderResponse, error)
+	UserPurchases(ctx context.Context, in *UserPurchasesRequest, opts ...grpc.CallOption) (*UserPurchasesResponse, error)
 }
 
 type lomsClient struct {
@@ -57,12 +59,22 @@ func (c *lomsClient) CreateOrder(ctx context.Context, in *CreateOrderRequest, op
 	return out, nil
 }
 
+func (c *lomsClient) UserPurchases(ctx context.Context, in *UserPurchasesRequest, opts ...grpc.CallOption) (*UserPurchasesResponse, error) {
+	out := new(UserPurchasesResponse)
+	err := c.cc.Invoke(ctx, Loms_UserPurchases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LomsServer is the server API for Loms service.
 // All implementations must embed UnimplementedLomsServer
 // for forward compatibility
 type LomsServer interface {
 	Stocks(context.Context, *StocksRequest) (*StocksResponse, error)
 	CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error)
+	UserPurchases(context.Context, *UserPurchasesRequest) (*UserPurchasesResponse, error)
 	mustEmbedUnimplementedLomsServer()
 }
 
@@ -76,6 +88,9 @@ func (UnimplementedLomsServer) Stocks(context.Context, *StocksRequest) (*StocksR
 func (UnimplementedLomsServer) CreateOrder(context.Context, *CreateOrderRequest) (*CreateOrderResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CreateOrder not implemented")
 }
+func (UnimplementedLomsServer) UserPurchases(context.Context, *UserPurchasesRequest) (*UserPurchasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPurchases not implemented")
+}
 func (UnimplementedLomsServer) mustEmbedUnimplementedLomsServer() {}
 
 // UnsafeLomsServer may be embedded to opt out of forward compatibility for this service.
@@ -125,6 +140,24 @@ func _Loms_CreateOrder_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Loms_UserPurchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPurchasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).UserPurchases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loms_UserPurchases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).UserPurchases(ctx, req.(*UserPurchasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Loms_ServiceDesc is the grpc.ServiceDesc for Loms service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -140,6 +173,10 @@ var Loms_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CreateOrder",
 			Handler:    _Loms_CreateOrder_Handler,
 		},
+		{
+			MethodName: "UserPurchases",
+			Handler:    _Loms_UserPurchases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "loms.proto",
@@ -0,0 +1,95 @@
+// Typed application errors that transport layers map to gRPC and HTTP statuses
+package apperr
+
+import (
+	"github.com/pkg/errors"
+)
+
+// Describe the class of an application error
+type Kind string
+
+const (
+	// Requested entity does not exist
+	NotFound Kind = "NOT_FOUND"
+	// Not enough units of an item are available
+	InsufficientStock Kind = "INSUFFICIENT_STOCK"
+	// Entity is in a state that does not allow the operation
+	InvalidState Kind = "INVALID_STATE"
+	// Operation conflicts with a concurrent change
+	Conflict Kind = "CONFLICT"
+	// Request is well-formed but its values are not acceptable
+	InvalidArgument Kind = "INVALID_ARGUMENT"
+	// Operation breaks a limit of the business policy, e.g. a purchase cap
+	PolicyViolation Kind = "POLICY_VIOLATION"
+	// Caller went over a limit, e.g. a stream reader did not keep up
+	ResourceExhausted Kind = "RESOURCE_EXHAUSTED"
+)
+
+// Describe an application error of a known kind with optional details for clients
+type Error struct {
+	kind    Kind
+	msg     string
+	details map[string]string
+	// Sentinel the error was created from with WithDetails
+	origin *Error
+}
+
+// Create a sentinel error of the kind
+func New(kind Kind, msg string) *Error {
+	return &Error{kind: kind, msg: msg}
+}
+
+func (e *Error) Error() string {
+	return e.msg
+}
+
+// Get the kind of the error
+func (e *Error) Kind() Kind {
+	return e.kind
+}
+
+// Get details of the error, e.g. the item and the available count
+func (e *Error) Details() map[string]string {
+	return e.details
+}
+
+// Match the error against the sentinel it was created from
+func (e *Error) Is(target error) bool {
+	t, ok := target.(*Error)
+	return ok && (e == t || e.origin == t)
+}
+
+// Create a copy of the error with details added. The copy still matches the original with errors.Is
+func (e *Error) WithDetails(details map[string]string) *Error {
+	merged := make(map[string]string, len(e.details)+len(details))
+	for k, v := range e.details {
+		merged[k] = v
+	}
+	for k, v := range details {
+		merged[k] = v
+	}
+
+	origin := e
+	if e.origin != nil {
+		origin = e.origin
+	}
+
+	return &Error{kind: e.kind, msg: e.msg, details: merged, origin: origin}
+}
+
+// Find an application error in the chain
+func As(err error) (*Error, bool) {
+	var e *Error
+	if errors.As(err, &e) {
+		return e, true
+	}
+	return nil, false
+}
+
+// Get the kind of an application error in the chain, empty for other errors
+func KindOf(err error) Kind {
+	if e, ok := As(err); ok {
+		return e.kind
+	}
+	return ""
+}
@@ -0,0 +1,178 @@
+// Application error mapping tests
+package apperr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Test matching errors with details against their sentinel
+func TestError_WithDetails(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sentinel := New(NotFound, "item not found")
+	other := New(NotFound, "item not found")
+
+	// Act
+	err := errors.Wrap(sentinel.WithDetails(map[string]string{"sku": "1"}).WithDetails(map[string]string{"count": "2"}), "get item")
+
+	// Assert
+	require.ErrorIs(t, err, sentinel)
+	require.NotErrorIs(t, err, other)
+	require.Equal(t, NotFound, KindOf(err))
+	e, ok := As(err)
+	require.True(t, ok)
+	require.Equal(t, map[string]string{"sku": "1", "count": "2"}, e.Details())
+	require.Nil(t, sentinel.Details())
+}
+
+// Test conversion of handler errors to gRPC statuses
+func TestToStatus(t *testing.T) {
+	t.Parallel()
+
+	t.Run("application error", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		err := errors.Wrap(New(NotFound, "order not found").WithDetails(map[string]string{"order_id": "1"}), "list order history")
+
+		// Act
+		st := status.Convert(ToStatus("notifications", err))
+
+		// Assert
+		require.Equal(t, codes.NotFound, st.Code())
+		require.Equal(t, "list order history: order not found", st.Message())
+		require.Len(t, st.Details(), 1)
+		info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+		require.True(t, ok)
+		require.Equal(t, "NOT_FOUND", info.GetReason())
+		require.Equal(t, "notifications", info.GetDomain())
+		require.Equal(t, map[string]string{"order_id": "1"}, info.GetMetadata())
+	})
+
+	t.Run("status error is kept", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		err := status.Error(codes.Unavailable, "telegram is down")
+
+		// Act
+		result := ToStatus("notifications", err)
+
+		// Assert
+		require.Equal(t, err, result)
+	})
+
+	t.Run("unknown error is internal", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		st := status.Convert(ToStatus("notifications", errors.New("connection reset")))
+
+		// Assert
+		require.Equal(t, codes.Internal, st.Code())
+		require.Empty(t, st.Details())
+	})
+}
+
+// Test conversion of stream handler errors to gRPC statuses
+func TestStreamServerInterceptor(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	interceptor := StreamServerInterceptor("notifications")
+	handler := func(srv interface{}, stream grpc.ServerStream) error {
+		return errors.Wrap(New(ResourceExhausted, "subscriber does not keep up"), "subscribe")
+	}
+
+	// Act
+	err := interceptor(nil, nil, &grpc.StreamServerInfo{}, handler)
+
+	// Assert
+	st := status.Convert(err)
+	require.Equal(t, codes.ResourceExhausted, st.Code())
+	require.Len(t, st.Details(), 1)
+	info, ok := st.Details()[0].(*errdetails.ErrorInfo)
+	require.True(t, ok)
+	require.Equal(t, "RESOURCE_EXHAUSTED", info.GetReason())
+	require.Equal(t, "notifications", info.GetDomain())
+}
+
+// Test rendering of gateway errors
+func TestHTTPErrorHandler(t *testing.T) {
+	t.Parallel()
+
+	tt := []struct {
+		name       string
+		err        error
+		wantStatus int
+		wantReason string
+	}{
+		{
+			name:       "not found",
+			err:        New(NotFound, "order not found"),
+			wantStatus: http.StatusNotFound,
+			wantReason: "NOT_FOUND",
+		},
+		{
+			name:       "insufficient stock",
+			err:        New(InsufficientStock, "stock insufficient"),
+			wantStatus: http.StatusConflict,
+			wantReason: "INSUFFICIENT_STOCK",
+		},
+		{
+			name:       "invalid state",
+			err:        New(InvalidState, "chat is already linked"),
+			wantStatus: http.StatusConflict,
+			wantReason: "INVALID_STATE",
+		},
+		{
+			name:       "invalid argument",
+			err:        New(InvalidArgument, "invalid page token"),
+			wantStatus: http.StatusBadRequest,
+			wantReason: "INVALID_ARGUMENT",
+		},
+		{
+			name:       "policy violation",
+			err:        New(PolicyViolation, "purchase cap is reached"),
+			wantStatus: http.StatusConflict,
+			wantReason: "POLICY_VIOLATION",
+		},
+		{
+			name:       "resource exhausted",
+			err:        New(ResourceExhausted, "subscriber does not keep up"),
+			wantStatus: http.StatusTooManyRequests,
+			wantReason: "RESOURCE_EXHAUSTED",
+		},
+		{
+			name:       "internal",
+			err:        errors.New("connection reset"),
+			wantStatus: http.StatusInternalServerError,
+		},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			w := httptest.NewRecorder()
+
+			// Act
+			HTTPErrorHandler(context.Background(), nil, nil, w, nil, ToStatus("notifications", tc.err))
+
+			// Assert
+			require.Equal(t, tc.wantStatus, w.Code)
+			var body httpError
+			require.NoError(t, json.Unmarshal(w.Body.Bytes(), &body))
+			require.Equal(t, tc.wantReason, body.Reason)
+			require.Equal(t, tc.err.Error(), body.Message)
+		})
+	}
+}
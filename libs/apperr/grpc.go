@@ -0,0 +1,98 @@
+// Mapping of application errors to gRPC statuses
+package apperr
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Describe a request validation error generated by protoc-gen-validate
+type fieldViolation interface {
+	Field() string
+	Reason() string
+}
+
+// Get the gRPC code of the kind
+func (k Kind) Code() codes.Code {
+	switch k {
+	case NotFound:
+		return codes.NotFound
+	case InsufficientStock, InvalidState, PolicyViolation:
+		return codes.FailedPrecondition
+	case Conflict:
+		return codes.Aborted
+	case InvalidArgument:
+		return codes.InvalidArgument
+	case ResourceExhausted:
+		return codes.ResourceExhausted
+	default:
+		return codes.Internal
+	}
+}
+
+// Convert handler errors to gRPC statuses.
+// The domain is reported in ErrorInfo details along with the error kind
+func UnaryServerInterceptor(domain string) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		resp, err := handler(ctx, req)
+		if err != nil {
+			return resp, ToStatus(domain, err)
+		}
+		return resp, nil
+	}
+}
+
+// Convert stream handler errors to gRPC statuses like UnaryServerInterceptor
+func StreamServerInterceptor(domain string) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		err := handler(srv, ss)
+		if err != nil {
+			return ToStatus(domain, err)
+		}
+		return nil
+	}
+}
+
+// Convert an error to a gRPC status error. Application errors get their kind's code and
+// ErrorInfo details, request validation errors get InvalidArgument with BadRequest details,
+// status errors are kept and the rest become Internal
+func ToStatus(domain string, err error) error {
+	if e, ok := As(err); ok {
+		st := status.New(e.Kind().Code(), err.Error())
+		detailed, derr := st.WithDetails(&errdetails.ErrorInfo{
+			Reason:   string(e.Kind()),
+			Domain:   domain,
+			Metadata: e.Details(),
+		})
+		if derr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	}
+
+	var violation fieldViolation
+	if errors.As(err, &violation) {
+		st := status.New(codes.InvalidArgument, err.Error())
+		detailed, derr := st.WithDetails(&errdetails.BadRequest{
+			FieldViolations: []*errdetails.BadRequest_FieldViolation{{
+				Field:       violation.Field(),
+				Description: violation.Reason(),
+			}},
+		})
+		if derr != nil {
+			return st.Err()
+		}
+		return detailed.Err()
+	}
+
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+
+	return status.Error(codes.Internal, err.Error())
+}
@@ -0,0 +1,73 @@
+// Rendering of gRPC status errors in the HTTP gateway
+package apperr
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/genproto/googleapis/rpc/errdetails"
+	"google.golang.org/grpc/status"
+)
+
+// Describe the JSON body of a gateway error response
+type httpError struct {
+	Code    string            `json:"code"`
+	Reason  string            `json:"reason,omitempty"`
+	Message string            `json:"message"`
+	Details map[string]string `json:"details,omitempty"`
+}
+
+// Get the HTTP status of the kind
+func (k Kind) HTTPStatus() int {
+	switch k {
+	case NotFound:
+		return http.StatusNotFound
+	case InsufficientStock, InvalidState, Conflict, PolicyViolation:
+		return http.StatusConflict
+	case InvalidArgument:
+		return http.StatusBadRequest
+	case ResourceExhausted:
+		return http.StatusTooManyRequests
+	default:
+		return http.StatusInternalServerError
+	}
+}
+
+// Write a gateway error as JSON with the HTTP status of the error kind.
+// Errors without ErrorInfo details get the default gateway status of their gRPC code
+func HTTPErrorHandler(_ context.Context, _ *runtime.ServeMux, _ runtime.Marshaler, w http.ResponseWriter, _ *http.Request, err error) {
+	st := status.Convert(err)
+	httpStatus := runtime.HTTPStatusFromCode(st.Code())
+	body := httpError{
+		Code:    st.Code().String(),
+		Message: st.Message(),
+	}
+
+	for _, detail := range st.Details() {
+		switch d := detail.(type) {
+		case *errdetails.ErrorInfo:
+			body.Reason = d.GetReason()
+			body.Details = d.GetMetadata()
+			if kind := Kind(d.GetReason()); kind.Code() == st.Code() {
+				httpStatus = kind.HTTPStatus()
+			}
+		case *errdetails.BadRequest:
+			body.Details = make(map[string]string, len(d.GetFieldViolations()))
+			for _, v := range d.GetFieldViolations() {
+				body.Details[v.GetField()] = v.GetDescription()
+			}
+		case *errdetails.PreconditionFailure:
+			body.Details = make(map[string]string, len(d.GetViolations()))
+			for _, v := range d.GetViolations() {
+				body.Reason = v.GetType()
+				body.Details[v.GetSubject()] = v.GetDescription()
+			}
+		}
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(httpStatus)
+	_ = json.NewEncoder(w).Encode(body)
+}
@@ -0,0 +1,150 @@
+// JWT authentication of callers
+package auth
+
+import (
+	"context"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/metadata"
+)
+
+var (
+	ErrNoToken      = errors.New("authorization token is missing")
+	ErrInvalidToken = errors.New("authorization token is invalid")
+)
+
+// Describe a role granted by the token
+type Role string
+
+const (
+	// Operators allowed to act for any user and to manage stocks
+	RoleAdmin Role = "admin"
+	// Other services calling on behalf of users
+	RoleService Role = "service"
+)
+
+// Describe claims of the tokens, the subject is the user id
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []Role `json:"roles,omitempty"`
+}
+
+// Describe the authenticated caller
+type Identity struct {
+	// Zero for service tokens without a user
+	UserID int64
+	Roles  []Role
+}
+
+// Check if the caller has any of the roles
+func (i Identity) HasRole(roles ...Role) bool {
+	for _, have := range i.Roles {
+		for _, want := range roles {
+			if have == want {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// Describe where the keys come from and which tokens are accepted.
+// Exactly one of HMACSecret and JWKSFile is set
+type Options struct {
+	// Shared secret of HS256/HS384/HS512 tokens
+	HMACSecret string
+	// Path to a local JWKS file with RSA or EC public keys
+	JWKSFile string
+	// Expected issuer, empty accepts any
+	Issuer string
+	// Expected audience, empty accepts any
+	Audience string
+}
+
+// Verify tokens and get the caller identity
+type Verifier struct {
+	parser  *jwt.Parser
+	keyFunc jwt.Keyfunc
+}
+
+// Create a verifier with the keys from the options
+func NewVerifier(opts Options) (*Verifier, error) {
+	var (
+		keyFunc jwt.Keyfunc
+		methods []string
+	)
+	switch {
+	case opts.HMACSecret != "" && opts.JWKSFile != "":
+		return nil, errors.New("both hmac secret and jwks file are set")
+	case opts.HMACSecret != "":
+		secret := []byte(opts.HMACSecret)
+		keyFunc = func(*jwt.Token) (interface{}, error) {
+			return secret, nil
+		}
+		methods = []string{"HS256", "HS384", "HS512"}
+	case opts.JWKSFile != "":
+		keys, err := LoadJWKS(opts.JWKSFile)
+		if err != nil {
+			return nil, err
+		}
+		keyFunc = keys.Keyfunc
+		methods = []string{"RS256", "RS384", "RS512", "PS256", "PS384", "PS512", "ES256", "ES384", "ES512"}
+	default:
+		return nil, errors.New("neither hmac secret nor jwks file is set")
+	}
+
+	parserOpts := []jwt.ParserOption{jwt.WithValidMethods(methods), jwt.WithLeeway(30 * time.Second)}
+	if opts.Issuer != "" {
+		parserOpts = append(parserOpts, jwt.WithIssuer(opts.Issuer))
+	}
+	if opts.Audience != "" {
+		parserOpts = append(parserOpts, jwt.WithAudience(opts.Audience))
+	}
+
+	return &Verifier{
+		parser:  jwt.NewParser(parserOpts...),
+		keyFunc: keyFunc,
+	}, nil
+}
+
+// Verify the token and get the identity from its claims. Tokens must expire
+func (v *Verifier) Verify(token string) (Identity, error) {
+	var claims Claims
+	_, err := v.parser.ParseWithClaims(token, &claims, v.keyFunc)
+	if err != nil {
+		return Identity{}, errors.Wrap(ErrInvalidToken, err.Error())
+	}
+	if claims.ExpiresAt == nil {
+		return Identity{}, errors.Wrap(ErrInvalidToken, "token has no expiration time")
+	}
+
+	var userID int64
+	if claims.Subject != "" {
+		userID, err = strconv.ParseInt(claims.Subject, 10, 64)
+		if err != nil || userID <= 0 {
+			return Identity{}, errors.Wrap(ErrInvalidToken, "subject is not a user id")
+		}
+	}
+
+	return Identity{UserID: userID, Roles: claims.Roles}, nil
+}
+
+// Get the bearer token from the authorization metadata of an incoming call.
+// The gateway forwards the Authorization header as this metadata
+func TokenFromContext(ctx context.Context) (string, error) {
+	values := metadata.ValueFromIncomingContext(ctx, "authorization")
+	if len(values) == 0 {
+		return "", ErrNoToken
+	}
+
+	scheme, token, ok := strings.Cut(values[0], " ")
+	if !ok || !strings.EqualFold(scheme, "bearer") || token == "" {
+		return "", errors.Wrap(ErrInvalidToken, "authorization is not a bearer token")
+	}
+
+	return token, nil
+}
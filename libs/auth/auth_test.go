@@ -0,0 +1,351 @@
+// Token verification and authorization tests
+package auth
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const testSecret = "test-secret"
+
+// Describe a request acting for a user
+type testUserRequest struct {
+	user int64
+}
+
+func (r testUserRequest) GetUser() int64 {
+	return r.user
+}
+
+// Test verification of HMAC tokens
+func TestVerifier_HMAC(t *testing.T) {
+	t.Parallel()
+	verifier, err := NewVerifier(Options{HMACSecret: testSecret, Issuer: "route256"})
+	require.NoError(t, err)
+
+	t.Run("valid token", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		token, err := MintHMAC(testSecret, TokenParams{UserID: 42, Roles: []Role{RoleAdmin}, Issuer: "route256", TTL: time.Minute})
+		require.NoError(t, err)
+
+		// Act
+		identity, err := verifier.Verify(token)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, Identity{UserID: 42, Roles: []Role{RoleAdmin}}, identity)
+	})
+
+	t.Run("error wrong secret", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		token, err := MintHMAC("other-secret", TokenParams{UserID: 42, Issuer: "route256", TTL: time.Minute})
+		require.NoError(t, err)
+
+		// Act
+		_, err = verifier.Verify(token)
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("error wrong issuer", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		token, err := MintHMAC(testSecret, TokenParams{UserID: 42, Issuer: "other", TTL: time.Minute})
+		require.NoError(t, err)
+
+		// Act
+		_, err = verifier.Verify(token)
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("error expired", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		token := signHMAC(t, Claims{RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   "42",
+			Issuer:    "route256",
+			ExpiresAt: jwt.NewNumericDate(time.Now().Add(-time.Hour)),
+		}})
+
+		// Act
+		_, err := verifier.Verify(token)
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+
+	t.Run("error no expiration", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		token := signHMAC(t, Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "42", Issuer: "route256"}})
+
+		// Act
+		_, err := verifier.Verify(token)
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidToken)
+	})
+}
+
+// Test verification of RSA tokens with keys from a JWKS file
+func TestVerifier_JWKS(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+	doc, err := json.Marshal(map[string]interface{}{"keys": []map[string]string{{
+		"kid": "key-1",
+		"kty": "RSA",
+		"use": "sig",
+		"n":   base64.RawURLEncoding.EncodeToString(key.N.Bytes()),
+		"e":   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes()),
+	}}})
+	require.NoError(t, err)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	require.NoError(t, os.WriteFile(path, doc, 0o600))
+
+	verifier, err := NewVerifier(Options{JWKSFile: path})
+	require.NoError(t, err)
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, Claims{RegisteredClaims: jwt.RegisteredClaims{
+		Subject:   "7",
+		ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+	}})
+	token.Header["kid"] = "key-1"
+	signed, err := token.SignedString(key)
+	require.NoError(t, err)
+
+	// Act
+	identity, err := verifier.Verify(signed)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, int64(7), identity.UserID)
+
+	// HMAC tokens are not accepted with asymmetric keys
+	_, err = verifier.Verify(mintToken(t, 7))
+	require.ErrorIs(t, err, ErrInvalidToken)
+}
+
+// Test authorization of calls by the policy
+func TestUnaryServerInterceptor(t *testing.T) {
+	t.Parallel()
+	verifier, err := NewVerifier(Options{HMACSecret: testSecret})
+	require.NoError(t, err)
+
+	admin, err := MintHMAC(testSecret, TokenParams{UserID: 1, Roles: []Role{RoleAdmin}, TTL: time.Minute})
+	require.NoError(t, err)
+
+	policy := Policy{
+		Default: Rule{Roles: []Role{RoleAdmin}, AllowGuest: true},
+		Methods: map[string]Rule{
+			"Stocks": {Roles: []Role{RoleAdmin, RoleService}, RolesOnly: true},
+		},
+	}
+	interceptor := UnaryServerInterceptor(verifier, policy)
+
+	tt := []struct {
+		name     string
+		method   string
+		token    string
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{name: "own cart", method: "/cart.Cart/ListCart", token: mintToken(t, 42), req: testUserRequest{user: 42}, wantCode: codes.OK},
+		{name: "cart of another user", method: "/cart.Cart/ListCart", token: mintToken(t, 42), req: testUserRequest{user: 43}, wantCode: codes.PermissionDenied},
+		{name: "admin acts for any user", method: "/cart.Cart/ListCart", token: admin, req: testUserRequest{user: 43}, wantCode: codes.OK},
+		{name: "guest without token", method: "/cart.Cart/ListCart", req: testUserRequest{}, wantCode: codes.OK},
+		{name: "user without token", method: "/cart.Cart/ListCart", req: testUserRequest{user: 42}, wantCode: codes.Unauthenticated},
+		{name: "invalid token", method: "/cart.Cart/ListCart", token: "garbage", req: testUserRequest{}, wantCode: codes.Unauthenticated},
+		{name: "user calls role method", method: "/loms.Loms/Stocks", token: mintToken(t, 42), req: struct{}{}, wantCode: codes.PermissionDenied},
+		{name: "admin calls role method", method: "/loms.Loms/Stocks", token: admin, req: struct{}{}, wantCode: codes.OK},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			ctx := context.Background()
+			if tc.token != "" {
+				ctx = metadata.NewIncomingContext(ctx, metadata.Pairs("authorization", "Bearer "+tc.token))
+			}
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+
+			// Act
+			_, err := interceptor(ctx, tc.req, &grpc.UnaryServerInfo{FullMethod: tc.method}, handler)
+
+			// Assert
+			require.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
+
+// Test authorization of calls about entities of other users
+func TestUnaryServerInterceptor_Owner(t *testing.T) {
+	t.Parallel()
+	verifier, err := NewVerifier(Options{HMACSecret: testSecret})
+	require.NoError(t, err)
+
+	service, err := MintHMAC(testSecret, TokenParams{Roles: []Role{RoleService}, TTL: time.Minute})
+	require.NoError(t, err)
+
+	// Orders 1 and 2 belong to users 42 and 43, other orders do not exist
+	errLookup := errors.New("database is down")
+	owner := func(_ context.Context, req interface{}) (int64, error) {
+		switch req.(testUserRequest).user {
+		case 1:
+			return 42, nil
+		case 2:
+			return 43, nil
+		case 3:
+			return 0, errLookup
+		default:
+			return 0, ErrNotOwner
+		}
+	}
+	policy := Policy{
+		Default: Rule{Roles: []Role{RoleAdmin, RoleService}},
+		Methods: map[string]Rule{
+			"ListOrder": {Roles: []Role{RoleAdmin, RoleService}, Owner: owner},
+		},
+	}
+	interceptor := UnaryServerInterceptor(verifier, policy)
+
+	tt := []struct {
+		name     string
+		token    string
+		req      interface{}
+		wantCode codes.Code
+	}{
+		{name: "own order", token: mintToken(t, 42), req: testUserRequest{user: 1}, wantCode: codes.OK},
+		{name: "order of another user", token: mintToken(t, 42), req: testUserRequest{user: 2}, wantCode: codes.PermissionDenied},
+		{name: "missing order looks like another user's", token: mintToken(t, 42), req: testUserRequest{user: 4}, wantCode: codes.PermissionDenied},
+		{name: "owner lookup fails", token: mintToken(t, 42), req: testUserRequest{user: 3}, wantCode: codes.Unknown},
+		{name: "service skips owner lookup", token: service, req: testUserRequest{user: 3}, wantCode: codes.OK},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+tc.token))
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return "ok", nil
+			}
+
+			// Act
+			_, err := interceptor(ctx, tc.req, &grpc.UnaryServerInfo{FullMethod: "/loms.Loms/ListOrder"}, handler)
+
+			// Assert
+			require.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
+
+// Describe a stream receiving one request of a user
+type testStream struct {
+	grpc.ServerStream
+	ctx  context.Context
+	user int64
+}
+
+func (s *testStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *testStream) RecvMsg(m interface{}) error {
+	*(m.(*testUserRequest)) = testUserRequest{user: s.user}
+	return nil
+}
+
+// Test authorization of requests received by streams
+func TestStreamServerInterceptor(t *testing.T) {
+	t.Parallel()
+	verifier, err := NewVerifier(Options{HMACSecret: testSecret})
+	require.NoError(t, err)
+	interceptor := StreamServerInterceptor(verifier, Policy{Default: Rule{Roles: []Role{RoleAdmin}}})
+
+	tt := []struct {
+		name     string
+		user     int64
+		wantCode codes.Code
+	}{
+		{name: "own stream", user: 42, wantCode: codes.OK},
+		{name: "stream of another user", user: 43, wantCode: codes.PermissionDenied},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+mintToken(t, 42)))
+			stream := &testStream{ctx: ctx, user: tc.user}
+			handler := func(_ interface{}, ss grpc.ServerStream) error {
+				var req testUserRequest
+				return ss.RecvMsg(&req)
+			}
+
+			// Act
+			err := interceptor(nil, stream, &grpc.StreamServerInfo{FullMethod: "/notifications.Notifications/Subscribe"}, handler)
+
+			// Assert
+			require.Equal(t, tc.wantCode, status.Code(err))
+		})
+	}
+}
+
+func mintToken(t *testing.T, userID int64) string {
+	t.Helper()
+	token, err := MintHMAC(testSecret, TokenParams{UserID: userID, TTL: time.Minute})
+	require.NoError(t, err)
+	return token
+}
+
+func signHMAC(t *testing.T, claims Claims) string {
+	t.Helper()
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(testSecret))
+	require.NoError(t, err)
+	return token
+}
+
+// Test the token is sent only over TLS by default
+func TestTokenCredentials(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	creds := TokenCredentials{Token: "service-token"}
+
+	// Act
+	md, err := creds.GetRequestMetadata(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, map[string]string{"authorization": "Bearer service-token"}, md)
+	require.True(t, creds.RequireTransportSecurity())
+	require.False(t, TokenCredentials{Token: "service-token", AllowInsecure: true}.RequireTransportSecurity())
+}
@@ -0,0 +1,137 @@
+// Authorization of gRPC calls
+package auth
+
+import (
+	"context"
+	"path"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Status of a call for another user. Owner functions return it for entities that do not exist,
+// so that users can not tell someone else's entity from a missing one
+var ErrNotOwner = status.Error(codes.PermissionDenied, "request is for another user")
+
+// Get the user a request acts for, zero means no user, e.g. a guest cart
+type OwnerFunc func(ctx context.Context, req interface{}) (int64, error)
+
+// Describe who can call a method
+type Rule struct {
+	// Roles allowed to call the method for any user
+	Roles []Role
+	// Only callers with the roles are allowed
+	RolesOnly bool
+	// Calls without a token are allowed when the request acts for no user
+	AllowGuest bool
+	// Get the user the request acts for, nil takes the user field of the request
+	Owner OwnerFunc
+}
+
+// Describe rules of the service methods
+type Policy struct {
+	Default Rule
+	// Rules by method name, e.g. "Stocks"
+	Methods map[string]Rule
+}
+
+func (p Policy) rule(fullMethod string) Rule {
+	if rule, ok := p.Methods[path.Base(fullMethod)]; ok {
+		return rule
+	}
+	return p.Default
+}
+
+// Describe requests with the user they act for
+type userRequest interface {
+	GetUser() int64
+}
+
+// Take the user field of the request, requests without it act for no user
+func RequestUser(_ context.Context, req interface{}) (int64, error) {
+	if r, ok := req.(userRequest); ok {
+		return r.GetUser(), nil
+	}
+	return 0, nil
+}
+
+// Authenticate calls with bearer tokens and allow users to act only for themselves.
+// Missing or invalid tokens are Unauthenticated, calls for another user are PermissionDenied
+func UnaryServerInterceptor(verifier *Verifier, policy Policy) grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		err := authorize(ctx, verifier, policy.rule(info.FullMethod), req)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+// Authorize streams like unary calls, every received request is checked
+func StreamServerInterceptor(verifier *Verifier, policy Policy) grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &authorizedStream{
+			ServerStream: ss,
+			verifier:     verifier,
+			rule:         policy.rule(info.FullMethod),
+		})
+	}
+}
+
+// Describe a stream checking requests as they are received
+type authorizedStream struct {
+	grpc.ServerStream
+	verifier *Verifier
+	rule     Rule
+}
+
+func (s *authorizedStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err != nil {
+		return err
+	}
+	return authorize(s.Context(), s.verifier, s.rule, m)
+}
+
+// Check the caller of the incoming call may make the request
+func authorize(ctx context.Context, verifier *Verifier, rule Rule, req interface{}) error {
+	owner := rule.Owner
+	if owner == nil {
+		owner = RequestUser
+	}
+
+	token, err := TokenFromContext(ctx)
+	if errors.Is(err, ErrNoToken) && rule.AllowGuest && !rule.RolesOnly {
+		user, err := RequestUser(ctx, req)
+		if err == nil && user == 0 {
+			return nil
+		}
+	}
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	identity, err := verifier.Verify(token)
+	if err != nil {
+		return status.Error(codes.Unauthenticated, err.Error())
+	}
+
+	if identity.HasRole(rule.Roles...) {
+		return nil
+	}
+	if rule.RolesOnly {
+		return status.Errorf(codes.PermissionDenied, "method requires one of roles %v", rule.Roles)
+	}
+
+	user, err := owner(ctx, req)
+	if err != nil {
+		return err
+	}
+	if user != 0 && user != identity.UserID {
+		return ErrNotOwner
+	}
+
+	return nil
+}
@@ -0,0 +1,144 @@
+// Public keys from a local JWKS file
+package auth
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+)
+
+// Describe a key of a JWKS document, only the fields of RSA and EC public keys
+type jwk struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+	Crv string `json:"crv"`
+	X   string `json:"x"`
+	Y   string `json:"y"`
+}
+
+// Public keys by key id
+type JWKS struct {
+	keys map[string]interface{}
+}
+
+// Read RSA and EC signing keys from the JWKS file, other keys are skipped
+func LoadJWKS(path string) (*JWKS, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, errors.Wrap(err, "read jwks file")
+	}
+
+	var doc struct {
+		Keys []jwk `json:"keys"`
+	}
+	err = json.Unmarshal(raw, &doc)
+	if err != nil {
+		return nil, errors.Wrap(err, "parse jwks file")
+	}
+
+	keys := make(map[string]interface{}, len(doc.Keys))
+	for _, k := range doc.Keys {
+		if k.Use != "" && k.Use != "sig" {
+			continue
+		}
+
+		var key interface{}
+		switch k.Kty {
+		case "RSA":
+			key, err = k.rsaKey()
+		case "EC":
+			key, err = k.ecKey()
+		default:
+			continue
+		}
+		if err != nil {
+			return nil, errors.Wrapf(err, "key %q", k.Kid)
+		}
+		keys[k.Kid] = key
+	}
+	if len(keys) == 0 {
+		return nil, errors.New("jwks file has no signing keys")
+	}
+
+	return &JWKS{keys: keys}, nil
+}
+
+// Get the key the token is signed with by its kid header.
+// Tokens without kid are accepted when the set has a single key
+func (s *JWKS) Keyfunc(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid == "" && len(s.keys) == 1 {
+		for _, key := range s.keys {
+			return key, nil
+		}
+	}
+
+	key, ok := s.keys[kid]
+	if !ok {
+		return nil, errors.Errorf("unknown key id %q", kid)
+	}
+	return key, nil
+}
+
+func (k jwk) rsaKey() (*rsa.PublicKey, error) {
+	n, err := decodeInt(k.N)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode modulus")
+	}
+	e, err := decodeInt(k.E)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode exponent")
+	}
+	if !e.IsInt64() || e.Int64() > 1<<31-1 {
+		return nil, errors.New("exponent is too large")
+	}
+
+	return &rsa.PublicKey{N: n, E: int(e.Int64())}, nil
+}
+
+func (k jwk) ecKey() (*ecdsa.PublicKey, error) {
+	var curve elliptic.Curve
+	switch k.Crv {
+	case "P-256":
+		curve = elliptic.P256()
+	case "P-384":
+		curve = elliptic.P384()
+	case "P-521":
+		curve = elliptic.P521()
+	default:
+		return nil, errors.Errorf("unsupported curve %q", k.Crv)
+	}
+
+	x, err := decodeInt(k.X)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode x")
+	}
+	y, err := decodeInt(k.Y)
+	if err != nil {
+		return nil, errors.Wrap(err, "decode y")
+	}
+	if !curve.IsOnCurve(x, y) {
+		return nil, errors.New("point is not on the curve")
+	}
+
+	return &ecdsa.PublicKey{Curve: curve, X: x, Y: y}, nil
+}
+
+// Decode a base64url big-endian integer
+func decodeInt(s string) (*big.Int, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(s)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(raw), nil
+}
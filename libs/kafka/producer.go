@@ -1,7 +1,9 @@
-// Kafka producer
+// Kafka producer shared by the services
 package kafka
 
 import (
+	"context"
+
 	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
 )
@@ -61,6 +63,24 @@ func (k *Producer) SendSyncMessages(messages []*sarama.ProducerMessage) error {
 	return nil
 }
 
+// Publish a message to the topic
+func (k *Producer) Publish(_ context.Context, topic string, key []byte, value []byte) error {
+	message := &sarama.ProducerMessage{
+		Topic: topic,
+		Value: sarama.ByteEncoder(value),
+	}
+	if key != nil {
+		message.Key = sarama.ByteEncoder(key)
+	}
+
+	_, _, err := k.syncProducer.SendMessage(message)
+	if err != nil {
+		return errors.Wrapf(err, "publish to %s", topic)
+	}
+
+	return nil
+}
+
 // Close kafka producer
 func (k *Producer) Close() error {
 	err := k.syncProducer.Close()
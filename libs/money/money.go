@@ -0,0 +1,152 @@
+// Amounts of money in minor units with overflow-checked arithmetic
+package money
+
+import (
+	"fmt"
+	"math"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrOverflow         = errors.New("money amount overflow")
+	ErrCurrencyMismatch = errors.New("money currencies do not match")
+)
+
+// Describe ISO 4217 currency code
+type Currency string
+
+// Describe an amount of money in minor units of the currency, e.g. kopecks for RUB.
+// The zero value has no currency and can be combined with an amount in any currency
+type Money struct {
+	Amount   int64
+	Currency Currency
+}
+
+// Create an amount of money in minor units
+func New(amount int64, currency Currency) Money {
+	return Money{Amount: amount, Currency: currency}
+}
+
+// Create a zero amount in the currency
+func Zero(currency Currency) Money {
+	return Money{Currency: currency}
+}
+
+// Check if the amount is zero
+func (m Money) IsZero() bool {
+	return m.Amount == 0
+}
+
+// Check if the amount is negative
+func (m Money) IsNegative() bool {
+	return m.Amount < 0
+}
+
+// Add two amounts of the same currency
+func (m Money) Add(other Money) (Money, error) {
+	currency, err := commonCurrency(m, other)
+	if err != nil {
+		return Money{}, err
+	}
+
+	if (other.Amount > 0 && m.Amount > math.MaxInt64-other.Amount) ||
+		(other.Amount < 0 && m.Amount < math.MinInt64-other.Amount) {
+		return Money{}, errors.Wrapf(ErrOverflow, "%v + %v", m, other)
+	}
+
+	return Money{Amount: m.Amount + other.Amount, Currency: currency}, nil
+}
+
+// Subtract an amount of the same currency
+func (m Money) Sub(other Money) (Money, error) {
+	if other.Amount == math.MinInt64 {
+		return Money{}, errors.Wrapf(ErrOverflow, "%v - %v", m, other)
+	}
+	return m.Add(Money{Amount: -other.Amount, Currency: other.Currency})
+}
+
+// Multiply the amount by a number of units
+func (m Money) Mul(n int64) (Money, error) {
+	if m.Amount == 0 || n == 0 {
+		return Money{Currency: m.Currency}, nil
+	}
+
+	result := m.Amount * n
+	// MinInt64 * -1 wraps around to itself and passes the division check
+	if result/n != m.Amount || (n == -1 && m.Amount == math.MinInt64) {
+		return Money{}, errors.Wrapf(ErrOverflow, "%v * %d", m, n)
+	}
+
+	return Money{Amount: result, Currency: m.Currency}, nil
+}
+
+// Get the percent of the amount rounded down to a minor unit
+func (m Money) Percent(percent int64) (Money, error) {
+	result, err := m.Mul(percent)
+	if err != nil {
+		return Money{}, err
+	}
+	result.Amount /= 100
+	return result, nil
+}
+
+// Compare two amounts of the same currency, returns -1, 0 or 1
+func (m Money) Cmp(other Money) (int, error) {
+	if _, err := commonCurrency(m, other); err != nil {
+		return 0, err
+	}
+
+	switch {
+	case m.Amount < other.Amount:
+		return -1, nil
+	case m.Amount > other.Amount:
+		return 1, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Get the smaller of two amounts of the same currency
+func (m Money) Min(other Money) (Money, error) {
+	currency, err := commonCurrency(m, other)
+	if err != nil {
+		return Money{}, err
+	}
+	if other.Amount < m.Amount {
+		return Money{Amount: other.Amount, Currency: currency}, nil
+	}
+	return Money{Amount: m.Amount, Currency: currency}, nil
+}
+
+// Format the amount with the currency code
+func (m Money) String() string {
+	return fmt.Sprintf("%d %s", m.Amount, m.Currency)
+}
+
+// Sum amounts of the same currency
+func Sum(amounts ...Money) (Money, error) {
+	var total Money
+	for _, amount := range amounts {
+		var err error
+		total, err = total.Add(amount)
+		if err != nil {
+			return Money{}, err
+		}
+	}
+	return total, nil
+}
+
+// Get the currency of the result, an amount without currency takes the other one
+func commonCurrency(a, b Money) (Currency, error) {
+	switch {
+	case a.Currency == b.Currency:
+		return a.Currency, nil
+	case a.Currency == "" && a.Amount == 0:
+		return b.Currency, nil
+	case b.Currency == "" && b.Amount == 0:
+		return a.Currency, nil
+	default:
+		return "", errors.Wrapf(ErrCurrencyMismatch, "%s and %s", a.Currency, b.Currency)
+	}
+}
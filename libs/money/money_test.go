@@ -0,0 +1,120 @@
+// Money arithmetic tests
+package money
+
+import (
+	"math"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Test addition and subtraction of amounts
+func TestMoney_AddSub(t *testing.T) {
+	t.Parallel()
+
+	t.Run("same currency", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		sum, err := New(150, "RUB").Add(New(50, "RUB"))
+		require.NoError(t, err)
+		diff, err := sum.Sub(New(300, "RUB"))
+		require.NoError(t, err)
+
+		// Assert
+		require.Equal(t, New(200, "RUB"), sum)
+		require.Equal(t, New(-100, "RUB"), diff)
+	})
+
+	t.Run("zero value takes the other currency", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		sum, err := Money{}.Add(New(10, "USD"))
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, New(10, "USD"), sum)
+	})
+
+	t.Run("error currency mismatch", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		_, err := New(10, "RUB").Add(New(10, "USD"))
+
+		// Assert
+		require.ErrorIs(t, err, ErrCurrencyMismatch)
+	})
+
+	t.Run("error overflow", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		_, errAdd := New(math.MaxInt64, "RUB").Add(New(1, "RUB"))
+		_, errSub := New(math.MinInt64, "RUB").Sub(New(1, "RUB"))
+		_, errNeg := New(0, "RUB").Sub(New(math.MinInt64, "RUB"))
+
+		// Assert
+		require.ErrorIs(t, errAdd, ErrOverflow)
+		require.ErrorIs(t, errSub, ErrOverflow)
+		require.ErrorIs(t, errNeg, ErrOverflow)
+	})
+}
+
+// Test multiplication and percent of amounts
+func TestMoney_Mul(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name     string
+		amount   Money
+		n        int64
+		expected Money
+		err      error
+	}{
+		{name: "units", amount: New(250, "RUB"), n: 4, expected: New(1000, "RUB")},
+		{name: "zero", amount: New(250, "RUB"), n: 0, expected: Zero("RUB")},
+		{name: "overflow", amount: New(math.MaxInt64/2+1, "RUB"), n: 2, err: ErrOverflow},
+		{name: "min int negated", amount: New(math.MinInt64, "RUB"), n: -1, err: ErrOverflow},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			result, err := tc.amount.Mul(tc.n)
+
+			// Assert
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, result)
+		})
+	}
+
+	t.Run("percent is rounded down", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		result, err := New(999, "RUB").Percent(15)
+
+		// Assert
+		require.NoError(t, err)
+		require.Equal(t, New(149, "RUB"), result)
+	})
+}
+
+// Test comparison and sum of amounts
+func TestMoney_MinSum(t *testing.T) {
+	t.Parallel()
+	// Act
+	smaller, err := New(300, "RUB").Min(New(200, "RUB"))
+	require.NoError(t, err)
+	sum, err := Sum(New(1, "RUB"), New(2, "RUB"), New(3, "RUB"))
+	require.NoError(t, err)
+	_, errSum := Sum(New(math.MaxInt64, "RUB"), New(1, "RUB"))
+
+	// Assert
+	require.Equal(t, New(200, "RUB"), smaller)
+	require.Equal(t, New(6, "RUB"), sum)
+	require.ErrorIs(t, errSum, ErrOverflow)
+}
@@ -0,0 +1,131 @@
+// Certificate files reloaded on rotation
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Keep the certificate and the CA pool loaded from files.
+// Files are checked at most once per reload interval, on the handshake that follows it
+type store struct {
+	opts     Options
+	interval time.Duration
+
+	mu        sync.Mutex
+	cert      *tls.Certificate
+	pool      *x509.CertPool
+	modTimes  []time.Time
+	checkedAt time.Time
+}
+
+// Load the files, the first load must succeed
+func newStore(opts Options) (*store, error) {
+	s := &store{opts: opts, interval: opts.ReloadInterval}
+	if s.interval <= 0 {
+		s.interval = defaultReloadInterval
+	}
+
+	modTimes, err := s.statFiles()
+	if err != nil {
+		return nil, err
+	}
+	err = s.load(modTimes)
+	if err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// Get the current certificate and CA pool, reloading them if the files have changed.
+// A failed reload keeps the previous files in use
+func (s *store) current() (*tls.Certificate, *x509.CertPool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if time.Since(s.checkedAt) < s.interval {
+		return s.cert, s.pool
+	}
+	s.checkedAt = time.Now()
+
+	modTimes, err := s.statFiles()
+	if err != nil {
+		s.opts.Logger.error("check tls files: ", err)
+		return s.cert, s.pool
+	}
+	if !changed(s.modTimes, modTimes) {
+		return s.cert, s.pool
+	}
+
+	err = s.load(modTimes)
+	if err != nil {
+		s.opts.Logger.error("reload tls files: ", err)
+	} else {
+		s.opts.Logger.info("tls files reloaded")
+	}
+	return s.cert, s.pool
+}
+
+// Read the certificate, key and CA files
+func (s *store) load(modTimes []time.Time) error {
+	var cert *tls.Certificate
+	if s.opts.CertFile != "" {
+		pair, err := tls.LoadX509KeyPair(s.opts.CertFile, s.opts.KeyFile)
+		if err != nil {
+			return errors.Wrap(err, "load certificate")
+		}
+		cert = &pair
+	}
+
+	var pool *x509.CertPool
+	if s.opts.CAFile != "" {
+		pem, err := os.ReadFile(s.opts.CAFile)
+		if err != nil {
+			return errors.Wrap(err, "read ca file")
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(pem) {
+			return errors.New("ca file has no certificates")
+		}
+	}
+
+	s.cert = cert
+	s.pool = pool
+	s.modTimes = modTimes
+	s.checkedAt = time.Now()
+	return nil
+}
+
+// Get modification times of the configured files
+func (s *store) statFiles() ([]time.Time, error) {
+	var result []time.Time
+	for _, path := range []string{s.opts.CertFile, s.opts.KeyFile, s.opts.CAFile} {
+		if path == "" {
+			continue
+		}
+		info, err := os.Stat(path)
+		if err != nil {
+			return nil, errors.Wrap(err, "stat tls file")
+		}
+		result = append(result, info.ModTime())
+	}
+	return result, nil
+}
+
+func changed(old, cur []time.Time) bool {
+	if len(old) != len(cur) {
+		return true
+	}
+	for i := range old {
+		if !old[i].Equal(cur[i]) {
+			return true
+		}
+	}
+	return false
+}
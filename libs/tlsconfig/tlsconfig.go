@@ -0,0 +1,151 @@
+// TLS and mutual TLS credentials of gRPC servers and clients with certificate hot reload
+package tlsconfig
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+)
+
+const defaultReloadInterval = 30 * time.Second
+
+// Describe certificate files of a service, empty files turn TLS off
+type Options struct {
+	// Certificate of the service, used as the server certificate and as the client certificate
+	CertFile string
+	KeyFile  string
+	// CA verifying peers: server certificates for clients and client certificates for servers
+	CAFile string
+	// Servers require client certificates signed by the CA
+	RequireClientCert bool
+	// How often files are checked for rotation
+	ReloadInterval time.Duration
+	// Where reloads of the files are reported
+	Logger Logger
+}
+
+// Describe the log functions of the service, nil functions drop the messages
+type Logger struct {
+	Info  func(args ...interface{})
+	Error func(args ...interface{})
+}
+
+func (l Logger) info(args ...interface{}) {
+	if l.Info != nil {
+		l.Info(args...)
+	}
+}
+
+func (l Logger) error(args ...interface{}) {
+	if l.Error != nil {
+		l.Error(args...)
+	}
+}
+
+// Check that the files make a consistent setup. A server with a certificate also needs the CA,
+// its gateway dials it as a client
+func (o Options) Validate() error {
+	switch {
+	case (o.CertFile == "") != (o.KeyFile == ""):
+		return errors.New("cert file and key file are set together")
+	case o.CertFile != "" && o.CAFile == "":
+		return errors.New("ca file is required with a certificate")
+	case o.RequireClientCert && o.CertFile == "":
+		return errors.New("client certificates are required but the server has no certificate")
+	}
+	return nil
+}
+
+// Create credentials of a gRPC server. Without a certificate the server is plaintext
+func ServerCredentials(opts Options) (credentials.TransportCredentials, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if opts.CertFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	store, err := newStore(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// Every handshake gets the current certificate and CA
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			cert, pool := store.current()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*cert},
+				NextProtos:   []string{"h2"},
+			}
+			if opts.RequireClientCert {
+				cfg.ClientAuth = tls.RequireAndVerifyClientCert
+				cfg.ClientCAs = pool
+			}
+			return cfg, nil
+		},
+	}), nil
+}
+
+// Create credentials of a gRPC client. Without a CA the connection is plaintext,
+// the certificate of the service is presented when the server asks for one
+func ClientCredentials(opts Options) (credentials.TransportCredentials, error) {
+	err := opts.Validate()
+	if err != nil {
+		return nil, err
+	}
+	if opts.CAFile == "" {
+		return insecure.NewCredentials(), nil
+	}
+
+	store, err := newStore(opts)
+	if err != nil {
+		return nil, err
+	}
+
+	return credentials.NewTLS(&tls.Config{
+		MinVersion: tls.VersionTLS12,
+		// The server is verified in VerifyConnection against the current CA
+		InsecureSkipVerify: true,
+		VerifyConnection: func(cs tls.ConnectionState) error {
+			_, pool := store.current()
+			return verifyServer(cs, pool)
+		},
+		GetClientCertificate: func(*tls.CertificateRequestInfo) (*tls.Certificate, error) {
+			cert, _ := store.current()
+			if cert == nil {
+				return &tls.Certificate{}, nil
+			}
+			return cert, nil
+		},
+	}), nil
+}
+
+// Verify the server certificate chain and its name
+func verifyServer(cs tls.ConnectionState, roots *x509.CertPool) error {
+	if len(cs.PeerCertificates) == 0 {
+		return errors.New("server sent no certificate")
+	}
+
+	intermediates := x509.NewCertPool()
+	for _, cert := range cs.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+
+	_, err := cs.PeerCertificates[0].Verify(x509.VerifyOptions{
+		DNSName:       cs.ServerName,
+		Roots:         roots,
+		Intermediates: intermediates,
+	})
+	if err != nil {
+		return errors.Wrap(err, "verify server certificate")
+	}
+	return nil
+}
@@ -0,0 +1,203 @@
+// TLS credentials tests
+package tlsconfig
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/health"
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Test calls between a server requiring client certificates and its clients
+func TestCredentials_MutualTLS(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	dir := t.TempDir()
+	ca, caKey := writeCA(t, dir, "ca.pem")
+	writeCert(t, dir, "server", ca, caKey)
+	writeCert(t, dir, "client", ca, caKey)
+
+	serverCreds, err := ServerCredentials(Options{
+		CertFile:          filepath.Join(dir, "server.pem"),
+		KeyFile:           filepath.Join(dir, "server-key.pem"),
+		CAFile:            filepath.Join(dir, "ca.pem"),
+		RequireClientCert: true,
+	})
+	require.NoError(t, err)
+
+	lis := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.Creds(serverCreds))
+	healthpb.RegisterHealthServer(server, health.NewServer())
+	go func() {
+		_ = server.Serve(lis)
+	}()
+	t.Cleanup(server.Stop)
+
+	check := func(t *testing.T, opts Options) error {
+		creds, err := ClientCredentials(opts)
+		require.NoError(t, err)
+		conn, err := grpc.Dial("localhost",
+			grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+				return lis.DialContext(ctx)
+			}),
+			grpc.WithTransportCredentials(creds),
+		)
+		require.NoError(t, err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+		_, err = healthpb.NewHealthClient(conn).Check(ctx, &healthpb.HealthCheckRequest{})
+		return err
+	}
+
+	t.Run("client with certificate", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		err := check(t, Options{
+			CertFile: filepath.Join(dir, "client.pem"),
+			KeyFile:  filepath.Join(dir, "client-key.pem"),
+			CAFile:   filepath.Join(dir, "ca.pem"),
+		})
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("error client without certificate", func(t *testing.T) {
+		t.Parallel()
+		// Act
+		err := check(t, Options{CAFile: filepath.Join(dir, "ca.pem")})
+
+		// Assert
+		require.Error(t, err)
+	})
+
+	t.Run("error server from another ca", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		otherDir := t.TempDir()
+		writeCA(t, otherDir, "ca.pem")
+
+		// Act
+		err := check(t, Options{CAFile: filepath.Join(otherDir, "ca.pem")})
+
+		// Assert
+		require.Error(t, err)
+	})
+}
+
+// Test that rotated files are picked up after the reload interval
+func TestStore_Reload(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	dir := t.TempDir()
+	ca, caKey := writeCA(t, dir, "ca.pem")
+	writeCert(t, dir, "server", ca, caKey)
+
+	var reloads []string
+	s, err := newStore(Options{
+		CertFile:       filepath.Join(dir, "server.pem"),
+		KeyFile:        filepath.Join(dir, "server-key.pem"),
+		CAFile:         filepath.Join(dir, "ca.pem"),
+		ReloadInterval: time.Millisecond,
+		Logger: Logger{Info: func(args ...interface{}) {
+			reloads = append(reloads, fmt.Sprint(args...))
+		}},
+	})
+	require.NoError(t, err)
+	before, _ := s.current()
+
+	// Act
+	writeCert(t, dir, "server", ca, caKey)
+	later := time.Now().Add(time.Minute)
+	require.NoError(t, os.Chtimes(filepath.Join(dir, "server.pem"), later, later))
+	time.Sleep(2 * time.Millisecond)
+	after, _ := s.current()
+
+	// Assert
+	require.NotEqual(t, before.Certificate[0], after.Certificate[0])
+	require.Equal(t, []string{"tls files reloaded"}, reloads)
+}
+
+// Test checks of inconsistent options
+func TestOptions_Validate(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, Options{}.Validate())
+	require.Error(t, Options{CertFile: "cert.pem"}.Validate())
+	require.Error(t, Options{CertFile: "cert.pem", KeyFile: "key.pem"}.Validate())
+	require.Error(t, Options{RequireClientCert: true, CAFile: "ca.pem"}.Validate())
+
+	creds, err := ServerCredentials(Options{})
+	require.NoError(t, err)
+	require.Equal(t, insecure.NewCredentials().Info(), creds.Info())
+}
+
+func writeCA(t *testing.T, dir string, name string) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: "test CA"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageCertSign,
+		BasicConstraintsValid: true,
+		IsCA:                  true,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	require.NoError(t, err)
+	ca, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+
+	writeFile(t, filepath.Join(dir, name), "CERTIFICATE", der)
+	return ca, key
+}
+
+func writeCert(t *testing.T, dir string, name string, ca *x509.Certificate, caKey *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"localhost"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, ca, &key.PublicKey, caKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+
+	writeFile(t, filepath.Join(dir, name+".pem"), "CERTIFICATE", der)
+	writeFile(t, filepath.Join(dir, name+"-key.pem"), "EC PRIVATE KEY", keyDER)
+}
+
+func writeFile(t *testing.T, path string, blockType string, der []byte) {
+	t.Helper()
+	require.NoError(t, os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: blockType, Bytes: der}), 0o600))
+}
@@ -8,10 +8,14 @@ import (
 	"net"
 	"net/http"
 	"os/signal"
+	"route256/libs/apperr"
+	"route256/libs/auth"
+	"route256/libs/kafka"
+	"route256/libs/tlsconfig"
 	api "route256/loms/internal/api/loms"
 	"route256/loms/internal/config"
 	"route256/loms/internal/domain"
-	"route256/loms/internal/kafka"
+	"route256/loms/internal/model"
 	"route256/loms/internal/pkg/logger"
 	"route256/loms/internal/pkg/metrics"
 	"route256/loms/internal/pkg/tracer"
@@ -25,7 +29,6 @@ import (
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
 	"google.golang.org/grpc/reflection"
 )
 
@@ -82,12 +85,52 @@ func run(ctx context.Context) error {
 		sender.NewKafkaSender(producer, kafkaTopic),
 	)
 
+	// Save the warehouse locations from the config
+	warehouses := make([]model.Warehouse, 0, len(cfg.Warehouses))
+	for _, warehouse := range cfg.Warehouses {
+		warehouses = append(warehouses, model.Warehouse{ID: model.WarehouseID(warehouse.ID), City: warehouse.City})
+	}
+	err = service.SaveWarehouses(context.Background(), warehouses)
+	if err != nil {
+		return errors.Wrap(err, "save warehouses")
+	}
+
+	verifier, err := auth.NewVerifier(auth.Options{
+		HMACSecret: cfg.Auth.HMACSecret,
+		JWKSFile:   cfg.Auth.JWKSFile,
+		Issuer:     cfg.Auth.Issuer,
+		Audience:   cfg.Auth.Audience,
+	})
+	if err != nil {
+		return errors.Wrap(err, "create token verifier")
+	}
+
+	tlsOptions := tlsconfig.Options{
+		CertFile:          cfg.TLS.CertFile,
+		KeyFile:           cfg.TLS.KeyFile,
+		CAFile:            cfg.TLS.CAFile,
+		RequireClientCert: cfg.TLS.RequireClientCert,
+		ReloadInterval:    cfg.TLS.ReloadInterval,
+		Logger:            tlsconfig.Logger{Info: logger.Info, Error: logger.Error},
+	}
+	serverCreds, err := tlsconfig.ServerCredentials(tlsOptions)
+	if err != nil {
+		return errors.Wrap(err, "create server credentials")
+	}
+	clientCreds, err := tlsconfig.ClientCredentials(tlsOptions)
+	if err != nil {
+		return errors.Wrap(err, "create client credentials")
+	}
+
 	// Create new gRPC server
 	s := grpc.NewServer(
+		grpc.Creds(serverCreds),
 		grpc.ChainUnaryInterceptor(
 			logger.MiddlewareGRPC,
 			tracer.MiddlewareGRPC,
 			metrics.MiddlewareGRPC,
+			apperr.UnaryServerInterceptor("loms"),
+			auth.UnaryServerInterceptor(verifier, api.AuthPolicy(service)),
 		),
 	)
 	reflection.Register(s)
@@ -101,18 +144,18 @@ func run(ctx context.Context) error {
 		}
 	}()
 
-	// Create connection to gRPC-gateway
+	// Create connection to gRPC-gateway, the server certificate is issued for localhost
 	conn, err := grpc.DialContext(
 		context.Background(),
-		lis.Addr().String(),
+		fmt.Sprintf("localhost:%d", grpcPort),
 		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(clientCreds),
 	)
 	if err != nil {
 		return errors.Wrap(err, "Failed to dial server")
 	}
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(apperr.HTTPErrorHandler))
 	err = loms_v1.RegisterLomsHandler(context.Background(), mux, conn)
 	if err != nil {
 		return errors.Wrap(err, "Failed to register gateway")
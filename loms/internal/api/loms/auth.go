@@ -0,0 +1,57 @@
+// Authorization rules of the LOMS API
+package loms
+
+import (
+	"context"
+	"route256/libs/auth"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/model"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// Describe requests about an existing order
+type orderRequest interface {
+	GetOrderID() int64
+}
+
+// Get rules of the LOMS API. Users act only for themselves and their orders,
+// services and admins act for anyone. Stocks of warehouses are not shown to users.
+// Orders are created only by Checkout, which applies the purchase caps of the cart policy
+func AuthPolicy(service *domain.Service) auth.Policy {
+	privileged := []auth.Role{auth.RoleAdmin, auth.RoleService}
+	orderRule := auth.Rule{Roles: privileged, Owner: orderOwner(service)}
+
+	return auth.Policy{
+		Default: auth.Rule{Roles: privileged},
+		Methods: map[string]auth.Rule{
+			"ListOrder":   orderRule,
+			"OrderPayed":  orderRule,
+			"CancelOrder": orderRule,
+			"Stocks":      {Roles: privileged, RolesOnly: true},
+			"CreateOrder": {Roles: []auth.Role{auth.RoleService}, RolesOnly: true},
+		},
+	}
+}
+
+// Take the owner of the order the request is about
+func orderOwner(service *domain.Service) auth.OwnerFunc {
+	return func(ctx context.Context, req interface{}) (int64, error) {
+		r, ok := req.(orderRequest)
+		if !ok {
+			return 0, status.Errorf(codes.Internal, "request %T has no order id", req)
+		}
+
+		// Only privileged callers learn that an order does not exist
+		user, err := service.OrderOwner(ctx, model.OrderID(r.GetOrderID()))
+		if errors.Is(err, model.ErrOrderNotFound) {
+			return 0, auth.ErrNotOwner
+		}
+		if err != nil {
+			return 0, err
+		}
+		return int64(user), nil
+	}
+}
@@ -0,0 +1,131 @@
+package loms
+
+import (
+	"context"
+	"route256/libs/auth"
+	"route256/loms/internal/domain"
+	"route256/loms/internal/domain/mocks"
+	"route256/loms/internal/model"
+	"route256/loms/pkg/loms_v1"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+func Test_orderOwner(t *testing.T) {
+	t.Parallel()
+
+	errLookup := errors.New("database is down")
+	cases := []struct {
+		name  string
+		order *model.Order
+		err   error
+		user  int64
+		want  error
+	}{
+		{
+			name:  "owner of the order",
+			order: &model.Order{User: 42},
+			user:  42,
+		},
+		{
+			name: "missing order is reported as another user's",
+			err:  model.ErrOrderNotFound,
+			want: auth.ErrNotOwner,
+		},
+		{
+			name: "lookup error",
+			err:  errLookup,
+			want: errLookup,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			orderRepository := mocks.NewOrderRepository(t)
+			orderRepository.On("GetOrder", mock.Anything, model.OrderID(7)).Return(tc.order, tc.err).Once()
+			owner := orderOwner(domain.New(orderRepository, mocks.NewStockRepository(t), nil))
+
+			// Act
+			user, err := owner(context.Background(), &loms_v1.ListOrderRequest{OrderID: 7})
+
+			// Assert
+			if tc.want != nil {
+				require.ErrorIs(t, err, tc.want)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.user, user)
+		})
+	}
+}
+
+func Test_AuthPolicy_CreateOrder(t *testing.T) {
+	t.Parallel()
+
+	const secret = "test-secret"
+	verifier, err := auth.NewVerifier(auth.Options{HMACSecret: secret})
+	require.NoError(t, err)
+	interceptor := auth.UnaryServerInterceptor(verifier, AuthPolicy(nil))
+
+	cases := []struct {
+		name    string
+		subject string
+		roles   []auth.Role
+		want    codes.Code
+	}{
+		{
+			name:    "user creates an order for themselves",
+			subject: "42",
+			want:    codes.PermissionDenied,
+		},
+		{
+			name:  "admin creates an order",
+			roles: []auth.Role{auth.RoleAdmin},
+			want:  codes.PermissionDenied,
+		},
+		{
+			name:  "checkout creates an order",
+			roles: []auth.Role{auth.RoleService},
+			want:  codes.OK,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			claims := auth.Claims{
+				RegisteredClaims: jwt.RegisteredClaims{
+					Subject:   tc.subject,
+					ExpiresAt: jwt.NewNumericDate(time.Now().Add(time.Minute)),
+				},
+				Roles: tc.roles,
+			}
+			token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, claims).SignedString([]byte(secret))
+			require.NoError(t, err)
+			ctx := metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+			handler := func(context.Context, interface{}) (interface{}, error) {
+				return &loms_v1.CreateOrderResponse{}, nil
+			}
+
+			// Act
+			_, err = interceptor(ctx, &loms_v1.CreateOrderRequest{User: 42}, &grpc.UnaryServerInfo{FullMethod: "/loms.Loms/CreateOrder"}, handler)
+
+			// Assert
+			require.Equal(t, tc.want, status.Code(err))
+		})
+	}
+}
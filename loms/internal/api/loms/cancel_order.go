@@ -6,8 +6,6 @@ import (
 	"route256/loms/internal/model"
 	"route256/loms/pkg/loms_v1"
 
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -19,7 +17,7 @@ func (s *Server) CancelOrder(ctx context.Context, req *loms_v1.CancelOrderReques
 	}
 	err = s.service.CancelOrder(ctx, model.OrderID(req.GetOrderID()))
 	if err != nil {
-		return nil, status.Errorf(codes.Internal, err.Error())
+		return nil, err
 	}
 	return &emptypb.Empty{}, nil
 }
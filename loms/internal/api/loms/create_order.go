@@ -12,13 +12,13 @@ import (
 
 // CreateOrder controller
 func (s *Server) CreateOrder(ctx context.Context, req *loms_v1.CreateOrderRequest) (*loms_v1.CreateOrderResponse, error) {
-	or, err := server.OrderFromReq(req)
+	err := req.ValidateAll()
 	if err != nil {
-		return &loms_v1.CreateOrderResponse{}, status.Errorf(codes.InvalidArgument, err.Error())
+		return &loms_v1.CreateOrderResponse{}, status.Error(codes.InvalidArgument, err.Error())
 	}
-	orderID, err := s.service.CreateOrder(ctx, or)
+	orderID, err := s.service.CreateOrder(ctx, server.OrderFromReq(req))
 	if err != nil {
-		return &loms_v1.CreateOrderResponse{}, status.Errorf(codes.Internal, err.Error())
+		return &loms_v1.CreateOrderResponse{}, err
 	}
 	return &loms_v1.CreateOrderResponse{OrderID: int64(orderID)}, nil
 }
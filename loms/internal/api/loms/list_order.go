@@ -6,9 +6,6 @@ import (
 	"route256/loms/internal/converter/server"
 	"route256/loms/internal/model"
 	"route256/loms/pkg/loms_v1"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // ListOrder controller
@@ -19,7 +16,7 @@ func (s *Server) ListOrder(ctx context.Context, req *loms_v1.ListOrderRequest) (
 	}
 	orderInfo, err := s.service.ListOrder(ctx, model.OrderID(req.GetOrderID()))
 	if err != nil {
-		return &loms_v1.ListOrderResponse{}, status.Errorf(codes.Internal, err.Error())
+		return &loms_v1.ListOrderResponse{}, err
 	}
 	res := server.ListOrderToResp(orderInfo)
 	return &res, nil
@@ -6,9 +6,6 @@ import (
 	"route256/loms/internal/converter/server"
 	"route256/loms/internal/model"
 	"route256/loms/pkg/loms_v1"
-
-	"google.golang.org/grpc/codes"
-	"google.golang.org/grpc/status"
 )
 
 // Stocks controller
@@ -19,7 +16,7 @@ func (s *Server) Stocks(ctx context.Context, req *loms_v1.StocksRequest) (*loms_
 	}
 	stocks, err := s.service.Stocks(ctx, model.SKU(req.GetSku()))
 	if err != nil {
-		return &loms_v1.StocksResponse{}, status.Errorf(codes.Internal, err.Error())
+		return &loms_v1.StocksResponse{}, err
 	}
 
 	res := server.StocksToRes(stocks)
@@ -0,0 +1,22 @@
+// UserPurchases
+package loms
+
+import (
+	"context"
+	"route256/loms/internal/converter/server"
+	"route256/loms/internal/model"
+	"route256/loms/pkg/loms_v1"
+)
+
+// UserPurchases controller
+func (s *Server) UserPurchases(ctx context.Context, req *loms_v1.UserPurchasesRequest) (*loms_v1.UserPurchasesResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+	items, err := s.service.UserPurchases(ctx, model.UserID(req.GetUser()), req.GetSince().AsTime(), req.GetSkus())
+	if err != nil {
+		return nil, err
+	}
+	return server.UserPurchasesToRes(items), nil
+}
@@ -3,6 +3,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -13,13 +14,34 @@ const pathToConfig = "config.yaml"
 // Service configuraton
 type Config struct {
 	Postgres struct {
-		ConnectionString string `yaml:"connection_string"`
+		ConnectionString       string `yaml:"connection_string"`
+		TestDBConnectionString string `yaml:"test_db_connection_string"`
 	} `yaml:"postgres"`
 	Brokers []string `yaml:"brokers"`
-	Jaeger struct {
+	Jaeger  struct {
 		Host string `yaml:"host"`
 		Port string `yaml:"port"`
 	} `yaml:"jaeger"`
+	// Locations of the warehouses, stocks of the warehouses in the city of the delivery address are reserved first
+	Warehouses []struct {
+		ID   int64  `yaml:"id"`
+		City string `yaml:"city"`
+	} `yaml:"warehouses"`
+	// Keys and expected claims of bearer tokens, one of the keys is set
+	Auth struct {
+		HMACSecret string `yaml:"hmac_secret"`
+		JWKSFile   string `yaml:"jwks_file"`
+		Issuer     string `yaml:"issuer"`
+		Audience   string `yaml:"audience"`
+	} `yaml:"auth"`
+	// Certificate files of the service, TLS is off when they are empty
+	TLS struct {
+		CertFile          string        `yaml:"cert_file"`
+		KeyFile           string        `yaml:"key_file"`
+		CAFile            string        `yaml:"ca_file"`
+		RequireClientCert bool          `yaml:"require_client_cert"`
+		ReloadInterval    time.Duration `yaml:"reload_interval"`
+	} `yaml:"tls"`
 }
 
 // Create a new instance of the config
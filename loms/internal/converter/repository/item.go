@@ -1,6 +1,7 @@
 package repository
 
 import (
+	"route256/libs/money"
 	"route256/loms/internal/model"
 	schema "route256/loms/internal/repository/scheme"
 )
@@ -10,6 +11,7 @@ func ToOrderItem(cartItem schema.Item) model.OrderItem {
 	return model.OrderItem{
 		SKU:   cartItem.SKU,
 		Count: cartItem.Count,
+		Price: money.New(cartItem.Price, money.Currency(cartItem.Currency)),
 	}
 }
 
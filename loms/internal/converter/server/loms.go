@@ -2,20 +2,18 @@
 package server
 
 import (
+	"route256/libs/money"
 	"route256/loms/internal/model"
 	"route256/loms/pkg/loms_v1"
 )
 
 // Convert order item from request to OrderItem
-func OrderItemFromReq(item *loms_v1.OrderItem) (*model.OrderItem, error) {
-	err := item.ValidateAll()
-	if err != nil {
-		return nil, err
-	}
-	return &model.OrderItem{
+func OrderItemFromReq(item *loms_v1.OrderItem) model.OrderItem {
+	return model.OrderItem{
 		SKU:   item.GetSku(),
 		Count: uint16(item.GetCount()),
-	}, nil
+		Price: MoneyFromReq(item.GetPrice()),
+	}
 }
 
 // Convert OrderItem to response object
@@ -23,25 +21,124 @@ func OrderItemToRes(item model.OrderItem) *loms_v1.OrderItem {
 	return &loms_v1.OrderItem{
 		Sku:   item.SKU,
 		Count: uint32(item.Count),
+		Price: MoneyToRes(item.Price),
 	}
 }
 
-// Convert data from request to Order
-func OrderFromReq(req *loms_v1.CreateOrderRequest) (model.Order, error) {
-	items := []model.OrderItem{}
+// Convert amount of money from request
+func MoneyFromReq(amount *loms_v1.Money) money.Money {
+	return money.New(amount.GetAmount(), money.Currency(amount.GetCurrency()))
+}
+
+// Convert amount of money to response object
+func MoneyToRes(amount money.Money) *loms_v1.Money {
+	return &loms_v1.Money{
+		Amount:   amount.Amount,
+		Currency: string(amount.Currency),
+	}
+}
 
+// Convert data from request to Order, the request is validated by the handler
+func OrderFromReq(req *loms_v1.CreateOrderRequest) model.Order {
+	items := make([]model.OrderItem, 0, len(req.GetItems()))
 	for _, item := range req.GetItems() {
-		orderItem, err := OrderItemFromReq(item)
-		if err != nil {
-			return model.Order{}, err
-		}
-		items = append(items, *orderItem)
+		items = append(items, OrderItemFromReq(item))
+	}
+
+	caps := make([]model.PurchaseCap, 0, len(req.GetPurchaseCaps()))
+	for _, c := range req.GetPurchaseCaps() {
+		caps = append(caps, model.PurchaseCap{
+			SKU:   c.GetSku(),
+			Limit: c.GetLimit(),
+			Since: c.GetSince().AsTime(),
+		})
 	}
 
 	return model.Order{
-		User:  req.GetUser(),
-		Items: items,
-	}, nil
+		User:         req.GetUser(),
+		Items:        items,
+		Promotion:    OrderPromotionFromReq(req.GetPromotion()),
+		Shipping:     ShippingFromReq(req.GetShipping()),
+		PurchaseCaps: caps,
+	}
+}
+
+// Convert promotion from request, missing promotion becomes empty
+func OrderPromotionFromReq(promotion *loms_v1.OrderPromotion) model.OrderPromotion {
+	return model.OrderPromotion{
+		Code:     promotion.GetCode(),
+		Discount: MoneyFromReq(promotion.GetDiscount()),
+	}
+}
+
+// Convert promotion to response object, empty promotion is omitted
+func OrderPromotionToRes(promotion model.OrderPromotion) *loms_v1.OrderPromotion {
+	if promotion.Code == "" {
+		return nil
+	}
+	return &loms_v1.OrderPromotion{
+		Code:     promotion.Code,
+		Discount: MoneyToRes(promotion.Discount),
+	}
+}
+
+// Shipping methods of the api and their domain names
+var shippingMethods = map[loms_v1.ShippingMethod]model.ShippingMethod{
+	loms_v1.ShippingMethod_SHIPPING_METHOD_COURIER:          model.ShippingCourier,
+	loms_v1.ShippingMethod_SHIPPING_METHOD_PICKUP_POINT:     model.ShippingPickupPoint,
+	loms_v1.ShippingMethod_SHIPPING_METHOD_WAREHOUSE_PICKUP: model.ShippingWarehousePickup,
+}
+
+// Convert shipping from request
+func ShippingFromReq(shipping *loms_v1.Shipping) model.Shipping {
+	return model.Shipping{
+		Method:  shippingMethods[shipping.GetMethod()],
+		Address: DeliveryAddressFromReq(shipping.GetAddress()),
+	}
+}
+
+// Convert shipping to response object, orders without shipping have none
+func ShippingToRes(shipping model.Shipping) *loms_v1.Shipping {
+	if shipping.Method == "" {
+		return nil
+	}
+
+	var method loms_v1.ShippingMethod
+	for res, name := range shippingMethods {
+		if name == shipping.Method {
+			method = res
+			break
+		}
+	}
+
+	return &loms_v1.Shipping{
+		Method:  method,
+		Address: DeliveryAddressToRes(shipping.Address),
+	}
+}
+
+// Convert delivery address from request
+func DeliveryAddressFromReq(address *loms_v1.DeliveryAddress) model.DeliveryAddress {
+	return model.DeliveryAddress{
+		City:       address.GetCity(),
+		Street:     address.GetStreet(),
+		House:      address.GetHouse(),
+		Apartment:  address.GetApartment(),
+		PostalCode: address.GetPostalCode(),
+		Comment:    address.GetComment(),
+	}
+}
+
+// Convert delivery address to response object
+func DeliveryAddressToRes(address model.DeliveryAddress) *loms_v1.DeliveryAddress {
+	return &loms_v1.DeliveryAddress{
+		City:       address.City,
+		Street:     address.Street,
+		House:      address.House,
+		Apartment:  address.Apartment,
+		PostalCode: address.PostalCode,
+		Comment:    address.Comment,
+	}
 }
 
 // Convert order info to response object
@@ -52,9 +149,12 @@ func ListOrderToResp(order model.OrderWithStatus) loms_v1.ListOrderResponse {
 	}
 
 	return loms_v1.ListOrderResponse{
-		Status: order.Status,
-		User:   order.User,
-		Items:  items,
+		Status:    order.Status,
+		User:      order.User,
+		Items:     items,
+		Promotion: OrderPromotionToRes(order.Promotion),
+		Total:     MoneyToRes(order.Total),
+		Shipping:  ShippingToRes(order.Shipping),
 	}
 }
 
@@ -77,3 +177,15 @@ func StocksToRes(stocks []model.Stock) loms_v1.StocksResponse {
 		Stocks: items,
 	}
 }
+
+// Convert items bought by a user to response object
+func UserPurchasesToRes(items []model.PurchasedItem) *loms_v1.UserPurchasesResponse {
+	result := make([]*loms_v1.PurchasedItem, 0, len(items))
+	for _, item := range items {
+		result = append(result, &loms_v1.PurchasedItem{
+			Sku:   item.SKU,
+			Count: item.Count,
+		})
+	}
+	return &loms_v1.UserPurchasesResponse{Items: result}
+}
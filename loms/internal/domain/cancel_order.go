@@ -14,6 +14,9 @@ func (s *Service) CancelOrder(ctx context.Context, orderID model.OrderID) error
 	if err != nil {
 		return errors.Wrap(err, "can not get order")
 	}
+	if !order.Status.IsCancelable() {
+		return invalidOrderStatus(orderID, order.Status)
+	}
 
 	for _, item := range order.Items {
 		err := s.stock.Unreserve(ctx, orderID, model.SKU(item.SKU))
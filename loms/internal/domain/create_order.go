@@ -5,12 +5,23 @@ import (
 	"context"
 	"fmt"
 	"route256/loms/internal/model"
+	"strconv"
 
 	"github.com/pkg/errors"
 )
 
-// Create user order
+// Create user order, the order total is stored along with the item prices
 func (s *Service) CreateOrder(ctx context.Context, order model.Order) (model.OrderID, error) {
+	order, err := orderTotal(order)
+	if err != nil {
+		return 0, err
+	}
+
+	// Warehouses in the city of the delivery address are reserved first
+	nearby, err := s.nearbyWarehouses(ctx, order.Shipping)
+	if err != nil {
+		return 0, err
+	}
 
 	// Create order
 	orderID, err := s.order.CreateOrder(ctx, order)
@@ -33,19 +44,20 @@ func (s *Service) CreateOrder(ctx context.Context, order model.Order) (model.Ord
 		stocks, err := s.stock.GetAvailableStocks(ctx, model.SKU(v.SKU))
 		if err != nil {
 			s.order.FailOrder(ctx, orderID)
-			err = s.notifier.SendMessage(OrderStatusNotification{
+			notifyErr := s.notifier.SendMessage(OrderStatusNotification{
 				UserId: model.UserID(order.User),
 				OrderID: orderID,
 				Status:  model.FailedStatus,
 				Message: fmt.Sprintf("no free item: %v for your order", v.SKU),
 			})
-			if err != nil {
-				return 0, errors.Wrap(err, "Can not notify about order status")
+			if notifyErr != nil {
+				return 0, errors.Wrap(notifyErr, "Can not notify about order status")
 			}
 			return orderID, errors.Wrap(err, "no available item stocks")
 		}
 
 		// Reserve the required quantity from available stocks
+		preferNearby(stocks, nearby)
 		remainingQuantity := v.Count
 		for _, stock := range stocks {
 			var countToAdd uint64
@@ -88,7 +100,11 @@ func (s *Service) CreateOrder(ctx context.Context, order model.Order) (model.Ord
 			if err != nil {
 				return 0, errors.Wrap(err, "Can not notify about order status")
 			}
-			return orderID, errors.Wrap(err, "not enough available stocks")
+			return orderID, model.ErrInsufficientStock.WithDetails(map[string]string{
+				"sku":       strconv.FormatUint(uint64(v.SKU), 10),
+				"requested": strconv.FormatUint(uint64(v.Count), 10),
+				"available": strconv.FormatUint(uint64(v.Count-remainingQuantity), 10),
+			})
 		}
 	}
 
@@ -1,9 +1,13 @@
+//go:generate mockery --output ./mocks --filename order_repository_mock.go --name OrderRepository
+//go:generate mockery --output ./mocks --filename stock_repository_mock.go --name StockRepository
+
 // Description of things common to the domain layer
 package domain
 
 import (
 	"context"
 	"route256/loms/internal/model"
+	"time"
 )
 
 // Describe repository for working with orders
@@ -11,6 +15,7 @@ type OrderRepository interface {
 	GetOrder(ctx context.Context, id model.OrderID) (*model.Order, error)
 	CreateOrder(ctx context.Context, order model.Order) (model.OrderID, error)
 	ListOrder(ctx context.Context, orderID model.OrderID) (model.OrderWithStatus, error)
+	ListUserPurchases(ctx context.Context, user model.UserID, since time.Time, skus []uint32) ([]model.PurchasedItem, error)
 	PayOrder(ctx context.Context, orderID model.OrderID) error
 	CancelOrder(ctx context.Context, orderID model.OrderID) error
 	AwaitPaymentOrder(ctx context.Context, orderID model.OrderID) error
@@ -20,6 +25,8 @@ type OrderRepository interface {
 // Describe repository for working with stocks
 type StockRepository interface {
 	GetAvailableStocks(ctx context.Context, sku model.SKU) ([]model.Stock, error)
+	GetWarehousesInCity(ctx context.Context, city string) ([]model.WarehouseID, error)
+	SaveWarehouses(ctx context.Context, warehouses []model.Warehouse) error
 	Reserve(ctx context.Context, orderID model.OrderID, sku model.SKU, stock model.Stock) error
 	Unreserve(ctx context.Context, orderID model.OrderID, sku model.SKU) error
 	WriteOffOrderItems(ctx context.Context, orderID model.OrderID) ([]model.Stock, error)
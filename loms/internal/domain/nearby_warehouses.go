@@ -0,0 +1,62 @@
+// Choosing warehouses close to the delivery address
+package domain
+
+import (
+	"context"
+	"route256/libs/apperr"
+	"route256/loms/internal/model"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidWarehouse = apperr.New(apperr.InvalidArgument, "invalid warehouse")
+)
+
+// Save the cities of the warehouses, stocks of a warehouse without a city are reserved after the nearby ones
+func (s *Service) SaveWarehouses(ctx context.Context, warehouses []model.Warehouse) error {
+	for _, warehouse := range warehouses {
+		if warehouse.ID <= 0 || strings.TrimSpace(warehouse.City) == "" {
+			return errors.Wrapf(ErrInvalidWarehouse, "warehouse %d in city %q", warehouse.ID, warehouse.City)
+		}
+	}
+
+	err := s.stock.SaveWarehouses(ctx, warehouses)
+	if err != nil {
+		return errors.Wrap(err, "save warehouses")
+	}
+	return nil
+}
+
+// Get warehouses in the city of the delivery address
+func (s *Service) nearbyWarehouses(ctx context.Context, shipping model.Shipping) (map[int64]struct{}, error) {
+	if shipping.Address.City == "" {
+		return nil, nil
+	}
+
+	warehouses, err := s.stock.GetWarehousesInCity(ctx, shipping.Address.City)
+	if err != nil {
+		return nil, errors.Wrap(err, "get warehouses in city")
+	}
+
+	result := make(map[int64]struct{}, len(warehouses))
+	for _, id := range warehouses {
+		result[int64(id)] = struct{}{}
+	}
+	return result, nil
+}
+
+// Move stocks of nearby warehouses to the front keeping the original order otherwise
+func preferNearby(stocks []model.Stock, nearby map[int64]struct{}) {
+	if len(nearby) == 0 {
+		return
+	}
+
+	sort.SliceStable(stocks, func(i, j int) bool {
+		_, iNearby := nearby[stocks[i].WarehouseID]
+		_, jNearby := nearby[stocks[j].WarehouseID]
+		return iNearby && !jNearby
+	})
+}
@@ -0,0 +1,137 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/money"
+	"route256/loms/internal/domain/mocks"
+	"route256/loms/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+// Notifier remembering the statuses it is asked to send
+type notifierStub struct {
+	statuses []model.OrderStatus
+}
+
+func (n *notifierStub) SendMessage(message OrderStatusNotification) error {
+	n.statuses = append(n.statuses, message.Status)
+	return nil
+}
+
+func Test_preferNearby(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name   string
+		stocks []model.Stock
+		nearby map[int64]struct{}
+		result []int64
+	}{
+		{
+			name:   "no nearby warehouses",
+			stocks: []model.Stock{{WarehouseID: 1}, {WarehouseID: 2}, {WarehouseID: 3}},
+			result: []int64{1, 2, 3},
+		},
+		{
+			name:   "nearby warehouse first",
+			stocks: []model.Stock{{WarehouseID: 1}, {WarehouseID: 2}, {WarehouseID: 3}},
+			nearby: map[int64]struct{}{3: {}},
+			result: []int64{3, 1, 2},
+		},
+		{
+			name:   "order kept within nearby and other warehouses",
+			stocks: []model.Stock{{WarehouseID: 1}, {WarehouseID: 2}, {WarehouseID: 3}, {WarehouseID: 4}},
+			nearby: map[int64]struct{}{4: {}, 2: {}},
+			result: []int64{2, 4, 1, 3},
+		},
+		{
+			name:   "nearby warehouse without stock",
+			stocks: []model.Stock{{WarehouseID: 1}, {WarehouseID: 2}},
+			nearby: map[int64]struct{}{5: {}},
+			result: []int64{1, 2},
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			preferNearby(tc.stocks, tc.nearby)
+
+			// Assert
+			result := make([]int64, 0, len(tc.stocks))
+			for _, stock := range tc.stocks {
+				result = append(result, stock.WarehouseID)
+			}
+			require.Equal(t, tc.result, result)
+		})
+	}
+}
+
+func Test_CreateOrder_NearbyWarehouseReservedFirst(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	orderID := model.OrderID(10)
+	order := model.Order{
+		User:     1,
+		Items:    []model.OrderItem{{SKU: 7, Count: 3, Price: money.New(100, "RUB")}},
+		Shipping: model.Shipping{Method: model.ShippingCourier, Address: model.DeliveryAddress{City: "Kazan"}},
+	}
+	orderRepository := mocks.NewOrderRepository(t)
+	stockRepository := mocks.NewStockRepository(t)
+	notifier := &notifierStub{}
+
+	stockRepository.On("GetWarehousesInCity", mock.Anything, "Kazan").Return([]model.WarehouseID{2}, nil).Once()
+	orderRepository.On("CreateOrder", mock.Anything, mock.Anything).Return(orderID, nil).Once()
+	stockRepository.On("GetAvailableStocks", mock.Anything, model.SKU(7)).
+		Return([]model.Stock{{WarehouseID: 1, Count: 5}, {WarehouseID: 2, Count: 2}}, nil).Once()
+	stockRepository.On("Reserve", mock.Anything, orderID, model.SKU(7), model.Stock{WarehouseID: 2, Count: 2}).Return(nil).Once()
+	stockRepository.On("Reserve", mock.Anything, orderID, model.SKU(7), model.Stock{WarehouseID: 1, Count: 1}).Return(nil).Once()
+	orderRepository.On("AwaitPaymentOrder", mock.Anything, orderID).Return(nil).Once()
+
+	service := New(orderRepository, stockRepository, notifier)
+
+	// Act
+	id, err := service.CreateOrder(context.Background(), order)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, orderID, id)
+	require.Equal(t, []model.OrderStatus{model.CreatedStatus, model.WaitStatus}, notifier.statuses)
+}
+
+func Test_SaveWarehouses(t *testing.T) {
+	t.Parallel()
+
+	t.Run("success", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		warehouses := []model.Warehouse{{ID: 1, City: "Moscow"}, {ID: 2, City: "Kazan"}}
+		stockRepository := mocks.NewStockRepository(t)
+		stockRepository.On("SaveWarehouses", mock.Anything, warehouses).Return(nil).Once()
+
+		service := New(mocks.NewOrderRepository(t), stockRepository, &notifierStub{})
+
+		// Act
+		err := service.SaveWarehouses(context.Background(), warehouses)
+
+		// Assert
+		require.NoError(t, err)
+	})
+
+	t.Run("error warehouse without city", func(t *testing.T) {
+		t.Parallel()
+		// Arrange
+		service := New(mocks.NewOrderRepository(t), mocks.NewStockRepository(t), &notifierStub{})
+
+		// Act
+		err := service.SaveWarehouses(context.Background(), []model.Warehouse{{ID: 1, City: " "}})
+
+		// Assert
+		require.ErrorIs(t, err, ErrInvalidWarehouse)
+	})
+}
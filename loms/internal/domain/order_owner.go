@@ -0,0 +1,18 @@
+// Getting the owner of an order
+package domain
+
+import (
+	"context"
+	"route256/loms/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+// Get the user who created the order
+func (s *Service) OrderOwner(ctx context.Context, orderID model.OrderID) (model.UserID, error) {
+	order, err := s.order.GetOrder(ctx, orderID)
+	if err != nil {
+		return 0, errors.Wrap(err, "can not get order")
+	}
+	return model.UserID(order.User), nil
+}
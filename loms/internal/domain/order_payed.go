@@ -4,6 +4,7 @@ package domain
 import (
 	"context"
 	"route256/loms/internal/model"
+	"strconv"
 
 	"github.com/pkg/errors"
 )
@@ -14,6 +15,9 @@ func (s *Service) OrderPayed(ctx context.Context, orderID model.OrderID) error {
 	if err != nil {
 		return errors.Wrap(err, "can not get order")
 	}
+	if order.Status != model.WaitStatus {
+		return invalidOrderStatus(orderID, order.Status)
+	}
 
 	_, err = s.stock.WriteOffOrderItems(ctx, orderID)
 	if err != nil {
@@ -37,3 +41,11 @@ func (s *Service) OrderPayed(ctx context.Context, orderID model.OrderID) error {
 
 	return nil
 }
+
+// Create an error about an operation the current order status does not allow
+func invalidOrderStatus(orderID model.OrderID, status model.OrderStatus) error {
+	return model.ErrInvalidOrderStatus.WithDetails(map[string]string{
+		"order_id": strconv.FormatInt(int64(orderID), 10),
+		"status":   string(status),
+	})
+}
@@ -0,0 +1,40 @@
+// Calculating the order cost
+package domain
+
+import (
+	"route256/libs/apperr"
+	"route256/libs/money"
+	"route256/loms/internal/model"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrInvalidOrderTotal = apperr.New(apperr.InvalidArgument, "invalid order total")
+)
+
+// Calculate the order cost from the item price snapshot minus the promotion discount
+func orderTotal(order model.Order) (model.Order, error) {
+	var total money.Money
+	for _, item := range order.Items {
+		line, err := item.Price.Mul(int64(item.Count))
+		if err != nil {
+			return model.Order{}, errors.Wrapf(ErrInvalidOrderTotal, "sku %d: %v", item.SKU, err)
+		}
+		total, err = total.Add(line)
+		if err != nil {
+			return model.Order{}, errors.Wrapf(ErrInvalidOrderTotal, "sku %d: %v", item.SKU, err)
+		}
+	}
+
+	total, err := total.Sub(order.Promotion.Discount)
+	if err != nil {
+		return model.Order{}, errors.Wrapf(ErrInvalidOrderTotal, "promotion discount: %v", err)
+	}
+	if total.IsNegative() {
+		return model.Order{}, errors.Wrap(ErrInvalidOrderTotal, "promotion discount exceeds the order cost")
+	}
+
+	order.Total = total
+	return order, nil
+}
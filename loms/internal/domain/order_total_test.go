@@ -0,0 +1,123 @@
+package domain
+
+import (
+	"math"
+	"route256/libs/money"
+	"route256/loms/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func Test_orderTotal(t *testing.T) {
+	t.Parallel()
+
+	rub := func(amount int64) money.Money {
+		return money.New(amount, "RUB")
+	}
+
+	cases := []struct {
+		name      string
+		items     []model.OrderItem
+		promotion model.OrderPromotion
+		total     money.Money
+		err       error
+	}{
+		{
+			name:  "no items",
+			total: money.Money{},
+		},
+		{
+			name: "without promotion",
+			items: []model.OrderItem{
+				{SKU: 1, Count: 3, Price: rub(1050)},
+				{SKU: 2, Count: 1, Price: rub(499)},
+			},
+			total: rub(3649),
+		},
+		{
+			name: "with discount",
+			items: []model.OrderItem{
+				{SKU: 1, Count: 2, Price: rub(1000)},
+			},
+			promotion: model.OrderPromotion{Code: "SALE", Discount: rub(150)},
+			total:     rub(1850),
+		},
+		{
+			name: "discount in kopecks is kept exact",
+			items: []model.OrderItem{
+				{SKU: 1, Count: 3, Price: rub(333)},
+			},
+			promotion: model.OrderPromotion{Code: "SALE", Discount: rub(99)},
+			total:     rub(900),
+		},
+		{
+			name: "discount equal to the cost",
+			items: []model.OrderItem{
+				{SKU: 1, Count: 1, Price: rub(500)},
+			},
+			promotion: model.OrderPromotion{Code: "FREE", Discount: rub(500)},
+			total:     rub(0),
+		},
+		{
+			name: "error discount exceeds the cost",
+			items: []model.OrderItem{
+				{SKU: 1, Count: 1, Price: rub(500)},
+			},
+			promotion: model.OrderPromotion{Code: "SALE", Discount: rub(501)},
+			err:       ErrInvalidOrderTotal,
+		},
+		{
+			name: "error discount in another currency",
+			items: []model.OrderItem{
+				{SKU: 1, Count: 1, Price: rub(500)},
+			},
+			promotion: model.OrderPromotion{Code: "SALE", Discount: money.New(10, "USD")},
+			err:       ErrInvalidOrderTotal,
+		},
+		{
+			name: "error items in different currencies",
+			items: []model.OrderItem{
+				{SKU: 1, Count: 1, Price: rub(500)},
+				{SKU: 2, Count: 1, Price: money.New(10, "USD")},
+			},
+			err: ErrInvalidOrderTotal,
+		},
+		{
+			name: "error line overflow",
+			items: []model.OrderItem{
+				{SKU: 1, Count: math.MaxUint16, Price: rub(math.MaxInt64 / 2)},
+			},
+			err: ErrInvalidOrderTotal,
+		},
+		{
+			name: "error total overflow",
+			items: []model.OrderItem{
+				{SKU: 1, Count: 1, Price: rub(math.MaxInt64)},
+				{SKU: 2, Count: 1, Price: rub(1)},
+			},
+			err: ErrInvalidOrderTotal,
+		},
+	}
+
+	for _, tc := range cases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			order := model.Order{User: 1, Items: tc.items, Promotion: tc.promotion}
+
+			// Act
+			result, err := orderTotal(order)
+
+			// Assert
+			if tc.err != nil {
+				require.ErrorIs(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.total, result.Total)
+			require.Equal(t, tc.items, result.Items)
+		})
+	}
+}
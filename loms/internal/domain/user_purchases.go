@@ -0,0 +1,20 @@
+// Get items bought by a user
+package domain
+
+import (
+	"context"
+	"route256/loms/internal/model"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Get units of the items the user has ordered since the moment
+func (s *Service) UserPurchases(ctx context.Context, user model.UserID, since time.Time, skus []uint32) ([]model.PurchasedItem, error) {
+	items, err := s.order.ListUserPurchases(ctx, user, since, skus)
+	if err != nil {
+		return nil, errors.Wrap(err, "get user purchases")
+	}
+
+	return items, nil
+}
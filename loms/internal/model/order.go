@@ -1,6 +1,19 @@
 // Define order's DTO for domain layer
 package model
 
+import (
+	"route256/libs/apperr"
+	"route256/libs/money"
+	"time"
+)
+
+var (
+	ErrOrderNotFound      = apperr.New(apperr.NotFound, "order not found")
+	ErrInvalidOrderStatus = apperr.New(apperr.InvalidState, "order status does not allow the operation")
+	ErrInsufficientStock  = apperr.New(apperr.InsufficientStock, "not enough available stocks")
+	ErrPurchaseCapReached = apperr.New(apperr.PolicyViolation, "purchase cap is reached")
+)
+
 // Definte user identifier
 type UserID int64
 
@@ -11,12 +24,53 @@ type OrderID int64
 type OrderItem struct {
 	SKU   uint32
 	Count uint16
+	// Price of one unit at the moment the order is created
+	Price money.Money
+}
+
+// Define promotion applied to the order in checkout, empty code means no promotion
+type OrderPromotion struct {
+	Code     string
+	Discount money.Money
+}
+
+// Define how the order gets to the shopper
+type ShippingMethod string
+
+const (
+	ShippingCourier         ShippingMethod = "courier"
+	ShippingPickupPoint     ShippingMethod = "pickup_point"
+	ShippingWarehousePickup ShippingMethod = "warehouse_pickup"
+)
+
+// Define copy of the shopper's address made when the order is created
+type DeliveryAddress struct {
+	City       string
+	Street     string
+	House      string
+	Apartment  string
+	PostalCode string
+	Comment    string
+}
+
+// Define order delivery
+type Shipping struct {
+	Method  ShippingMethod
+	Address DeliveryAddress
 }
 
 // Define user order
 type Order struct {
-	User  int64
-	Items []OrderItem
+	User      int64
+	Items     []OrderItem
+	Promotion OrderPromotion
+	// Order cost after the promotion discount
+	Total    money.Money
+	Shipping Shipping
+	// Limits of the units the user can buy, checked when the order is created
+	PurchaseCaps []PurchaseCap
+	// Current status, filled when the order is read back
+	Status OrderStatus
 }
 
 type OrderStatus string
@@ -29,9 +83,30 @@ const (
 	WaitStatus     OrderStatus = "awaiting payment"
 )
 
+// Check if the order can still be cancelled, i.e. it is not paid or finished
+func (s OrderStatus) IsCancelable() bool {
+	return s == CreatedStatus || s == WaitStatus
+}
+
 // Define user order info with status
 type OrderWithStatus struct {
-	Status string
-	User   int64
-	Items  []OrderItem
+	Status    string
+	User      int64
+	Items     []OrderItem
+	Promotion OrderPromotion
+	Total     money.Money
+	Shipping  Shipping
+}
+
+// Define units of the item the user can buy since the moment, the order being created included
+type PurchaseCap struct {
+	SKU   uint32
+	Limit uint64
+	Since time.Time
+}
+
+// Define units of an item bought by a user
+type PurchasedItem struct {
+	SKU   uint32
+	Count uint64
 }
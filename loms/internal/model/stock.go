@@ -12,3 +12,9 @@ type Stock struct {
 	WarehouseID int64
 	Count       uint64
 }
+
+// Describe warehouse location used to reserve stocks close to the delivery address
+type Warehouse struct {
+	ID   WarehouseID
+	City string
+}
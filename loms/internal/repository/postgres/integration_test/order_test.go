@@ -0,0 +1,117 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"route256/libs/money"
+	"route256/loms/internal/model"
+	"sync"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+)
+
+// Create an order of the user with the items and set its status and creation time
+func (s *Suite) createOrder(user int64, status model.OrderStatus, createdAt time.Time, items ...model.OrderItem) model.OrderID {
+	orderID, err := s.order.CreateOrder(context.Background(), model.Order{
+		User:     user,
+		Items:    items,
+		Shipping: model.Shipping{Method: model.ShippingCourier, Address: model.DeliveryAddress{City: "Moscow", Street: "Tverskaya", House: "1"}},
+	})
+	s.Require().NoError(err)
+
+	query, args, err := psql.
+		Update(tableNameOrder).
+		Set("status", string(status)).
+		Set("created_at", createdAt).
+		Where(sq.Eq{"id": orderID}).
+		ToSql()
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query, args...)
+	s.Require().NoError(err)
+
+	return orderID
+}
+
+func item(sku uint32, count uint16) model.OrderItem {
+	return model.OrderItem{SKU: sku, Count: count, Price: money.New(100, "RUB")}
+}
+
+// Test that purchases are summed per item for the user since the moment without failed and cancelled orders
+func (s *Suite) Test_ListUserPurchases() {
+	// Arrange
+	now := time.Now()
+	since := now.Add(-24 * time.Hour)
+	s.createOrder(1, model.PaidStatus, now.Add(-time.Hour), item(10, 2), item(20, 1))
+	s.createOrder(1, model.WaitStatus, now.Add(-2*time.Hour), item(10, 3))
+	s.createOrder(1, model.FailedStatus, now.Add(-time.Hour), item(10, 5))
+	s.createOrder(1, model.CanceledStatus, now.Add(-time.Hour), item(10, 7))
+	s.createOrder(1, model.PaidStatus, now.Add(-48*time.Hour), item(10, 11))
+	s.createOrder(2, model.PaidStatus, now.Add(-time.Hour), item(10, 13))
+	s.createOrder(1, model.PaidStatus, now.Add(-time.Hour), item(30, 17))
+
+	// Act
+	items, err := s.order.ListUserPurchases(context.Background(), model.UserID(1), since, []uint32{10, 20, 40})
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().ElementsMatch([]model.PurchasedItem{{SKU: 10, Count: 5}, {SKU: 20, Count: 1}}, items)
+}
+
+// Test that an order over the purchase cap is not created
+func (s *Suite) Test_CreateOrder_PurchaseCapReached() {
+	// Arrange
+	now := time.Now()
+	s.createOrder(1, model.PaidStatus, now.Add(-time.Hour), item(10, 4))
+	order := model.Order{
+		User:         1,
+		Items:        []model.OrderItem{item(10, 2)},
+		Shipping:     model.Shipping{Method: model.ShippingCourier, Address: model.DeliveryAddress{City: "Moscow", Street: "Tverskaya", House: "1"}},
+		PurchaseCaps: []model.PurchaseCap{{SKU: 10, Limit: 5, Since: now.Add(-24 * time.Hour)}},
+	}
+
+	// Act
+	_, err := s.order.CreateOrder(context.Background(), order)
+
+	// Assert
+	s.Require().ErrorIs(err, model.ErrPurchaseCapReached)
+	items, err := s.order.ListUserPurchases(context.Background(), model.UserID(1), now.Add(-24*time.Hour), []uint32{10})
+	s.Require().NoError(err)
+	s.Require().Equal([]model.PurchasedItem{{SKU: 10, Count: 4}}, items)
+}
+
+// Test that concurrent orders of the user together do not exceed the purchase cap
+func (s *Suite) Test_CreateOrder_PurchaseCapConcurrent() {
+	// Arrange
+	now := time.Now()
+	order := model.Order{
+		User:         1,
+		Items:        []model.OrderItem{item(10, 2)},
+		Shipping:     model.Shipping{Method: model.ShippingCourier, Address: model.DeliveryAddress{City: "Moscow", Street: "Tverskaya", House: "1"}},
+		PurchaseCaps: []model.PurchaseCap{{SKU: 10, Limit: 5, Since: now.Add(-24 * time.Hour)}},
+	}
+
+	// Act
+	var wg sync.WaitGroup
+	errs := make([]error, 5)
+	for i := range errs {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			_, errs[i] = s.order.CreateOrder(context.Background(), order)
+		}(i)
+	}
+	wg.Wait()
+
+	// Assert
+	created := 0
+	for _, err := range errs {
+		if err == nil {
+			created++
+			continue
+		}
+		s.Require().ErrorIs(err, model.ErrPurchaseCapReached)
+	}
+	s.Require().Equal(2, created)
+}
@@ -0,0 +1,68 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"route256/loms/internal/config"
+	"route256/loms/internal/repository/postgres"
+	"testing"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/stretchr/testify/suite"
+)
+
+var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+const (
+	tableNameOrder     = "user_order"
+	tableNameOrderItem = "order_item"
+	tableNameShipping  = "order_shipping"
+)
+
+// Group integration tests and data for it
+type Suite struct {
+	suite.Suite
+	pg    *pgxpool.Pool
+	order *postgres.OrderRepository
+}
+
+// Starting point for tests
+func TestSuite(t *testing.T) {
+	suite.Run(t, new(Suite))
+}
+
+// Setup environment for integration tests
+func (s *Suite) SetupSuite() {
+	cfg, err := config.New()
+	s.Require().NoError(err)
+
+	s.pg, err = pgxpool.Connect(context.Background(), cfg.Postgres.TestDBConnectionString)
+	s.Require().NoError(err)
+
+	s.order = postgres.NewOrderRepository(s.pg)
+}
+
+// Clean db tables before each test
+func (s *Suite) SetupTest() {
+	query := "TRUNCATE TABLE "
+	_, err := s.pg.Exec(context.Background(), query+tableNameOrderItem)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameShipping)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameOrder)
+	s.Require().NoError(err)
+}
+
+// Tear down environment for integration tests after all tests
+func (s *Suite) TearDownSuite() {
+	query := "TRUNCATE TABLE "
+	_, err := s.pg.Exec(context.Background(), query+tableNameOrderItem)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameShipping)
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(context.Background(), query+tableNameOrder)
+	s.Require().NoError(err)
+	s.pg.Close()
+}
@@ -3,10 +3,13 @@ package postgres
 
 import (
 	"context"
+	"route256/libs/money"
 	"route256/loms/internal/converter/repository"
 	"route256/loms/internal/model"
 	"route256/loms/internal/pkg/tracer"
 	schema "route256/loms/internal/repository/scheme"
+	"strconv"
+	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/georgysavva/scany/pgxscan"
@@ -23,6 +26,7 @@ type OrderStatus string
 const (
 	tableNameOrder     = "user_order"
 	tableNameOrderItem = "order_item"
+	tableNameShipping  = "order_shipping"
 
 	createdStatus  OrderStatus = "new"
 	paidStatus     OrderStatus = "payed"
@@ -48,18 +52,22 @@ func (r *OrderRepository) GetOrder(ctx context.Context, id model.OrderID) (*mode
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/order/get_order")
 	defer span.Finish()
 
-	query, agrs, err := psql.Select("user_id").From(tableNameOrder).Where(sq.Eq{"id": id}).ToSql()
+	query, agrs, err := psql.Select("user_id", "status").From(tableNameOrder).Where(sq.Eq{"id": id}).ToSql()
 	if err != nil {
 		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query for get order"))
 	}
 
 	var userID model.UserID
-	err = r.db.QueryRow(ctx, query, agrs...).Scan(&userID)
+	var status string
+	err = r.db.QueryRow(ctx, query, agrs...).Scan(&userID, &status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, tracer.MarkSpanWithError(ctx, model.ErrOrderNotFound)
+	}
 	if err != nil {
 		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "get order"))
 	}
 
-	itemsQuery, agrs, err := psql.Select("sku", "count").From(tableNameOrderItem).Where(sq.Eq{"order_id": id}).ToSql()
+	itemsQuery, agrs, err := psql.Select("sku", "count", "price", "currency").From(tableNameOrderItem).Where(sq.Eq{"order_id": id}).ToSql()
 	if err != nil {
 		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query for get order items"))
 	}
@@ -73,8 +81,9 @@ func (r *OrderRepository) GetOrder(ctx context.Context, id model.OrderID) (*mode
 	orderItems := repository.ToOrderItems(items)
 
 	return &model.Order{
-		User:  int64(userID),
-		Items: orderItems,
+		User:   int64(userID),
+		Items:  orderItems,
+		Status: model.OrderStatus(status),
 	}, nil
 }
 
@@ -83,15 +92,25 @@ func (r *OrderRepository) CreateOrder(ctx context.Context, order model.Order) (m
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/order/create_order")
 	defer span.Finish()
 
-	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{IsoLevel: pgx.RepeatableRead})
+	// Purchases are counted after the lock of the user is taken, so the statements must see orders committed meanwhile
+	txOptions := pgx.TxOptions{IsoLevel: pgx.RepeatableRead}
+	if len(order.PurchaseCaps) > 0 {
+		txOptions.IsoLevel = pgx.ReadCommitted
+	}
+	tx, err := r.db.BeginTx(ctx, txOptions)
 	if err != nil {
 		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
 	}
+	defer tx.Rollback(ctx)
+
+	err = r.checkPurchaseCaps(ctx, tx, order)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "check purchase caps"))
+	}
 
 	// Create the order
 	orderID, err := r.createOrder(ctx, tx, order)
 	if err != nil {
-		tx.Rollback(ctx)
 		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "create order"))
 	}
 
@@ -100,6 +119,11 @@ func (r *OrderRepository) CreateOrder(ctx context.Context, order model.Order) (m
 		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "insert order items"))
 	}
 
+	err = r.insertShipping(ctx, tx, orderID, order.Shipping)
+	if err != nil {
+		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "insert order shipping"))
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
 		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
@@ -117,9 +141,10 @@ func (r *OrderRepository) ListOrder(ctx context.Context, orderID model.OrderID)
 	if err != nil {
 		return model.OrderWithStatus{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
 	}
+	defer tx.Rollback(ctx)
 
 	// Get user id
-	user, status, err := r.getOrderUserWithStatus(ctx, tx, orderID)
+	order, err := r.getOrderUserWithStatus(ctx, tx, orderID)
 	if err != nil {
 		return model.OrderWithStatus{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "get order"))
 	}
@@ -129,15 +154,111 @@ func (r *OrderRepository) ListOrder(ctx context.Context, orderID model.OrderID)
 		return model.OrderWithStatus{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "get order items"))
 	}
 
+	order.Shipping, err = r.getShipping(ctx, tx, orderID)
+	if err != nil {
+		return model.OrderWithStatus{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "get order shipping"))
+	}
+
 	err = tx.Commit(ctx)
 	if err != nil {
 		return model.OrderWithStatus{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
 	}
-	return model.OrderWithStatus{
-		Status: string(status),
-		User:   int64(user),
-		Items:  items,
-	}, nil
+	order.Items = items
+	return order, nil
+}
+
+// Get units of the items the user has ordered since the moment, failed and cancelled orders are not counted
+func (r *OrderRepository) ListUserPurchases(ctx context.Context, user model.UserID, since time.Time, skus []uint32) ([]model.PurchasedItem, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/order/list_user_purchases")
+	defer span.Finish()
+
+	items, err := listUserPurchases(ctx, r.db, user, since, skus)
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, err)
+	}
+
+	return items, nil
+}
+
+// Serialize orders of the user with caps and check that the units bought since each cap's moment
+// together with the order do not exceed the cap
+func (r *OrderRepository) checkPurchaseCaps(ctx context.Context, tx pgx.Tx, order model.Order) error {
+	if len(order.PurchaseCaps) == 0 {
+		return nil
+	}
+
+	_, err := tx.Exec(ctx, "SELECT pg_advisory_xact_lock($1)", order.User)
+	if err != nil {
+		return errors.Wrap(err, "lock user orders")
+	}
+
+	ordered := make(map[uint32]uint64, len(order.Items))
+	for _, item := range order.Items {
+		ordered[item.SKU] += uint64(item.Count)
+	}
+
+	for _, c := range order.PurchaseCaps {
+		if ordered[c.SKU] == 0 {
+			continue
+		}
+
+		purchased, err := listUserPurchases(ctx, tx, model.UserID(order.User), c.Since, []uint32{c.SKU})
+		if err != nil {
+			return err
+		}
+		total := ordered[c.SKU]
+		for _, item := range purchased {
+			total += item.Count
+		}
+		if total > c.Limit {
+			return model.ErrPurchaseCapReached.WithDetails(map[string]string{
+				"sku":    strconv.FormatUint(uint64(c.SKU), 10),
+				"limit":  strconv.FormatUint(c.Limit, 10),
+				"actual": strconv.FormatUint(total, 10),
+			})
+		}
+	}
+
+	return nil
+}
+
+// Describe a connection or a transaction to read rows with
+type querier interface {
+	Query(ctx context.Context, sql string, args ...interface{}) (pgx.Rows, error)
+}
+
+// Get units of the items the user has ordered since the moment with the connection or the transaction
+func listUserPurchases(ctx context.Context, db querier, user model.UserID, since time.Time, skus []uint32) ([]model.PurchasedItem, error) {
+	query, args, err := psql.
+		Select("i.sku", "sum(i.count)").
+		From(tableNameOrderItem + " i").
+		Join(tableNameOrder + " o ON o.id = i.order_id").
+		Where(sq.Eq{"o.user_id": user, "i.sku": skus}).
+		Where(sq.GtOrEq{"o.created_at": since}).
+		Where(sq.NotEq{"o.status": []OrderStatus{failedStatus, canceledStatus}}).
+		GroupBy("i.sku").
+		ToSql()
+	if err != nil {
+		return nil, errors.Wrap(err, "build query for user purchases")
+	}
+
+	rows, err := db.Query(ctx, query, args...)
+	if err != nil {
+		return nil, errors.Wrap(err, "get user purchases")
+	}
+	defer rows.Close()
+
+	var items []model.PurchasedItem
+	for rows.Next() {
+		var item model.PurchasedItem
+		err := rows.Scan(&item.SKU, &item.Count)
+		if err != nil {
+			return nil, errors.Wrap(err, "scan user purchases")
+		}
+		items = append(items, item)
+	}
+
+	return items, rows.Err()
 }
 
 // Change order status to paid
@@ -224,8 +345,8 @@ func (r *OrderRepository) FailOrder(ctx context.Context, orderID model.OrderID)
 func (r *OrderRepository) createOrder(ctx context.Context, tx pgx.Tx, order model.Order) (model.OrderID, error) {
 	query, args, err := psql.
 		Insert(tableNameOrder).
-		Columns("user_id", "status").
-		Values(order.User, createdStatus).
+		Columns("user_id", "status", "promo_code", "discount", "total", "currency").
+		Values(order.User, createdStatus, promoCode(order.Promotion), order.Promotion.Discount.Amount, order.Total.Amount, string(order.Total.Currency)).
 		Suffix("RETURNING id").
 		ToSql()
 
@@ -243,10 +364,10 @@ func (r *OrderRepository) createOrder(ctx context.Context, tx pgx.Tx, order mode
 
 // Insert item to order
 func (r *OrderRepository) insertOrderItems(ctx context.Context, tx pgx.Tx, orderID model.OrderID, items []model.OrderItem) error {
-	query := psql.Insert(tableNameOrderItem).Columns("order_id", "sku", "count")
+	query := psql.Insert(tableNameOrderItem).Columns("order_id", "sku", "count", "price", "currency")
 
 	for _, item := range items {
-		query = query.Values(int64(orderID), item.SKU, item.Count)
+		query = query.Values(int64(orderID), item.SKU, item.Count, item.Price.Amount, string(item.Price.Currency))
 	}
 
 	rawSQL, args, err := query.ToSql()
@@ -262,30 +383,100 @@ func (r *OrderRepository) insertOrderItems(ctx context.Context, tx pgx.Tx, order
 	return nil
 }
 
-// Get order's user id with order status
-func (r *OrderRepository) getOrderUserWithStatus(ctx context.Context, tx pgx.Tx, orderID model.OrderID) (model.UserID, OrderStatus, error) {
+// Save the delivery address snapshot of the order
+func (r *OrderRepository) insertShipping(ctx context.Context, tx pgx.Tx, orderID model.OrderID, shipping model.Shipping) error {
+	address := shipping.Address
+	query, args, err := psql.
+		Insert(tableNameShipping).
+		Columns("order_id", "method", "city", "street", "house", "apartment", "postal_code", "comment").
+		Values(int64(orderID), string(shipping.Method), address.City, address.Street, address.House, address.Apartment, address.PostalCode, address.Comment).
+		ToSql()
+	if err != nil {
+		return errors.Wrap(err, "build query for insert order shipping")
+	}
+
+	_, err = tx.Exec(ctx, query, args...)
+	if err != nil {
+		return errors.Wrap(err, "exec insert order shipping")
+	}
+
+	return nil
+}
+
+// Get the delivery address snapshot of the order, orders created before shipping was introduced have none
+func (r *OrderRepository) getShipping(ctx context.Context, tx pgx.Tx, orderID model.OrderID) (model.Shipping, error) {
+	query, args, err := psql.
+		Select("method", "city", "street", "house", "apartment", "postal_code", "comment").
+		From(tableNameShipping).
+		Where(sq.Eq{"order_id": orderID}).
+		ToSql()
+	if err != nil {
+		return model.Shipping{}, errors.Wrap(err, "build query for get order shipping")
+	}
+
+	var shipping model.Shipping
+	var method string
+	address := &shipping.Address
+	err = tx.QueryRow(ctx, query, args...).Scan(&method, &address.City, &address.Street, &address.House, &address.Apartment, &address.PostalCode, &address.Comment)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.Shipping{}, nil
+	}
+	if err != nil {
+		return model.Shipping{}, errors.Wrap(err, "get order shipping")
+	}
+	shipping.Method = model.ShippingMethod(method)
+
+	return shipping, nil
+}
+
+// Get order's user id with order status, applied promotion and total
+func (r *OrderRepository) getOrderUserWithStatus(ctx context.Context, tx pgx.Tx, orderID model.OrderID) (model.OrderWithStatus, error) {
 	// Get user id
-	query := psql.Select("user_id", "status").From(tableNameOrder).Where(sq.Eq{"id": orderID})
+	query := psql.Select("user_id", "status", "promo_code", "discount", "total", "currency").From(tableNameOrder).Where(sq.Eq{"id": orderID})
 
 	rawSQL, args, err := query.ToSql()
 	if err != nil {
-		return 0, "", errors.Wrap(err, "build query for list order")
+		return model.OrderWithStatus{}, errors.Wrap(err, "build query for list order")
 	}
 
 	var user model.UserID
 	var status string
-	err = tx.QueryRow(ctx, rawSQL, args...).Scan(&user, &status)
+	var code *string
+	var discount, total int64
+	var currency string
+	err = tx.QueryRow(ctx, rawSQL, args...).Scan(&user, &status, &code, &discount, &total, &currency)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.OrderWithStatus{}, model.ErrOrderNotFound
+	}
 	if err != nil {
-		return 0, "", errors.Wrap(err, "get user id")
+		return model.OrderWithStatus{}, errors.Wrap(err, "get user id")
 	}
 
-	return user, OrderStatus(status), nil
+	promotion := model.OrderPromotion{Discount: money.New(discount, money.Currency(currency))}
+	if code != nil {
+		promotion.Code = *code
+	}
+
+	return model.OrderWithStatus{
+		Status:    status,
+		User:      int64(user),
+		Promotion: promotion,
+		Total:     money.New(total, money.Currency(currency)),
+	}, nil
+}
+
+// Store an empty promo code as NULL
+func promoCode(promotion model.OrderPromotion) *string {
+	if promotion.Code == "" {
+		return nil
+	}
+	return &promotion.Code
 }
 
 // Get order items by order id
 func (r *OrderRepository) getOrderItems(ctx context.Context, tx pgx.Tx, orderID model.OrderID) ([]model.OrderItem, error) {
 	// Get order items
-	itemsQuery := psql.Select("sku", "count").From(tableNameOrderItem).Where(sq.Eq{"order_id": orderID})
+	itemsQuery := psql.Select("sku", "count", "price", "currency").From(tableNameOrderItem).Where(sq.Eq{"order_id": orderID})
 
 	rawSQL, args, err := itemsQuery.ToSql()
 	if err != nil {
@@ -301,11 +492,13 @@ func (r *OrderRepository) getOrderItems(ctx context.Context, tx pgx.Tx, orderID
 
 	for rows.Next() {
 		var item model.OrderItem
+		var currency string
 
-		err := rows.Scan(&item.SKU, &item.Count)
+		err := rows.Scan(&item.SKU, &item.Count, &item.Price.Amount, &currency)
 		if err != nil {
 			return nil, errors.Wrap(err, "scan order items")
 		}
+		item.Price.Currency = money.Currency(currency)
 
 		items = append(items, item)
 	}
@@ -17,6 +17,7 @@ import (
 const (
 	tableNameStock         = "stock"
 	tableNameReservedStock = "reservation_stock"
+	tableNameWarehouse     = "warehouse"
 )
 
 // Repository for working with stock and reserved items
@@ -65,6 +66,58 @@ func (s *StockRepository) GetAvailableStocks(ctx context.Context, sku model.SKU)
 	return stocks, nil
 }
 
+// Get warehouses located in the city, the city name is compared case-insensitively
+func (s *StockRepository) GetWarehousesInCity(ctx context.Context, city string) ([]model.WarehouseID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/stocks/get_warehouses_in_city")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Select("id").
+		From(tableNameWarehouse).
+		Where(sq.Expr("lower(city) = lower(?)", city)).
+		ToSql()
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to build query"))
+	}
+
+	var warehouses []model.WarehouseID
+	err = pgxscan.Select(ctx, s.db, &warehouses, query, args...)
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to get warehouses"))
+	}
+
+	return warehouses, nil
+}
+
+// Save warehouse locations, the city of a known warehouse is replaced
+func (s *StockRepository) SaveWarehouses(ctx context.Context, warehouses []model.Warehouse) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/stocks/save_warehouses")
+	defer span.Finish()
+
+	if len(warehouses) == 0 {
+		return nil
+	}
+
+	insert := psql.
+		Insert(tableNameWarehouse).
+		Columns("id", "city").
+		Suffix("ON CONFLICT (id) DO UPDATE SET city = EXCLUDED.city")
+	for _, warehouse := range warehouses {
+		insert = insert.Values(int64(warehouse.ID), warehouse.City)
+	}
+	query, args, err := insert.ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to build query"))
+	}
+
+	_, err = s.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to save warehouses"))
+	}
+
+	return nil
+}
+
 // Reserve Item
 func (r *StockRepository) Reserve(ctx context.Context, orderID model.OrderID, sku model.SKU, stock model.Stock) error {
 	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/stocks/reserve")
@@ -3,7 +3,9 @@ package schema
 
 // Describe order item table in postgres
 type Item struct {
-	orderID int64  `db:"order_id"`
-	SKU    uint32 `db:"sku"`
-	Count   uint16 `db:"count"`
+	orderID  int64  `db:"order_id"`
+	SKU      uint32 `db:"sku"`
+	Count    uint16 `db:"count"`
+	Price    int64  `db:"price"`
+	Currency string `db:"currency"`
 }
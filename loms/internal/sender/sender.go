@@ -7,8 +7,8 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
 	"log"
+	"route256/libs/kafka"
 	"route256/loms/internal/domain"
-	"route256/loms/internal/kafka"
 )
 
 // Define kafka sender
@@ -66,9 +66,18 @@ func (s *KafkaSender) SendMessages(messages []domain.OrderStatusNotification) er
 	return nil
 }
 
+// Describe order status event, an order reaches every status once, so the pair identifies the event
+type orderStatusMessage struct {
+	domain.OrderStatusNotification
+	EventID string
+}
+
 // Create kafka message from input data
 func (s *KafkaSender) buildMessage(message domain.OrderStatusNotification) (*sarama.ProducerMessage, error) {
-	msg, err := json.Marshal(message)
+	msg, err := json.Marshal(orderStatusMessage{
+		OrderStatusNotification: message,
+		EventID:                 fmt.Sprintf("order:%d:%s", message.OrderID, message.Status),
+	})
 
 	if err != nil {
 		return nil, errors.Wrap(err, "send message marshal error")
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_order ADD COLUMN promo_code TEXT;
+ALTER TABLE user_order ADD COLUMN discount BIGINT NOT NULL DEFAULT 0;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_order DROP COLUMN IF EXISTS discount;
+ALTER TABLE user_order DROP COLUMN IF EXISTS promo_code;
+-- +goose StatementEnd
@@ -0,0 +1,15 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE order_item ADD COLUMN price BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE order_item ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+ALTER TABLE user_order ADD COLUMN total BIGINT NOT NULL DEFAULT 0;
+ALTER TABLE user_order ADD COLUMN currency CHAR(3) NOT NULL DEFAULT 'RUB';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE user_order DROP COLUMN IF EXISTS currency;
+ALTER TABLE user_order DROP COLUMN IF EXISTS total;
+ALTER TABLE order_item DROP COLUMN IF EXISTS currency;
+ALTER TABLE order_item DROP COLUMN IF EXISTS price;
+-- +goose StatementEnd
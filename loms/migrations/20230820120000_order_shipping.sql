@@ -0,0 +1,25 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS order_shipping (
+    order_id BIGINT PRIMARY KEY,
+    method TEXT NOT NULL CHECK (method IN ('courier', 'pickup_point', 'warehouse_pickup')),
+    city TEXT NOT NULL,
+    street TEXT NOT NULL,
+    house TEXT NOT NULL,
+    apartment TEXT NOT NULL DEFAULT '',
+    postal_code TEXT NOT NULL DEFAULT '',
+    comment TEXT NOT NULL DEFAULT ''
+);
+
+CREATE TABLE IF NOT EXISTS warehouse (
+    id BIGINT PRIMARY KEY,
+    city TEXT NOT NULL
+);
+CREATE INDEX IF NOT EXISTS warehouse_city_idx ON warehouse (lower(city));
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS warehouse;
+DROP TABLE IF EXISTS order_shipping;
+-- +goose StatementEnd
@@ -0,0 +1,11 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE user_order ADD COLUMN created_at TIMESTAMPTZ NOT NULL DEFAULT now();
+CREATE INDEX IF NOT EXISTS user_order_user_created_idx ON user_order (user_id, created_at);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS user_order_user_created_idx;
+ALTER TABLE user_order DROP COLUMN IF EXISTS created_at;
+-- +goose StatementEnd
@@ -35,6 +35,127 @@ var (
 	_ = sort.Sort
 )
 
+// Validate checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Money) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Money with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in MoneyMultiError, or nil if none found.
+func (m *Money) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Money) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAmount() < 0 {
+		err := MoneyValidationError{
+			field:  "Amount",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetCurrency()) != 3 {
+		err := MoneyValidationError{
+			field:  "Currency",
+			reason: "value length must be 3 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+
+	}
+
+	if len(errors) > 0 {
+		return MoneyMultiError(errors)
+	}
+
+	return nil
+}
+
+// MoneyMultiError is an error wrapping multiple validation errors returned by
+// Money.ValidateAll() if the designated constraints aren't met.
+type MoneyMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m MoneyMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m MoneyMultiError) AllErrors() []error { return m }
+
+// MoneyValidationError is the validation error returned by Money.Validate if
+// the designated constraints aren't met.
+type MoneyValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e MoneyValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e MoneyValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e MoneyValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e MoneyValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e MoneyValidationError) ErrorName() string { return "MoneyValidationError" }
+
+// Error satisfies the builtin error interface
+func (e MoneyValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sMoney.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = MoneyValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = MoneyValidationError{}
+
 // Validate checks the field values on OrderItem with the rules defined in the
 // proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
@@ -79,6 +200,46 @@ func (m *OrderItem) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if m.GetPrice() == nil {
+		err := OrderItemValidationError{
+			field:  "Price",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetPrice()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, OrderItemValidationError{
+					field:  "Price",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPrice()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderItemValidationError{
+				field:  "Price",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
 		return OrderItemMultiError(errors)
 	}
@@ -156,32 +317,32 @@ var _ interface {
 	ErrorName() string
 } = OrderItemValidationError{}
 
-// Validate checks the field values on CreateOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateOrderRequest) Validate() error {
+// Validate checks the field values on OrderPromotion with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *OrderPromotion) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CreateOrderRequestMultiError, or nil if none found.
-func (m *CreateOrderRequest) ValidateAll() error {
+// ValidateAll checks the field values on OrderPromotion with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in OrderPromotionMultiError,
+// or nil if none found.
+func (m *OrderPromotion) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateOrderRequest) validate(all bool) error {
+func (m *OrderPromotion) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetUser() <= 0 {
-		err := CreateOrderRequestValidationError{
-			field:  "User",
-			reason: "value must be greater than 0",
+	if utf8.RuneCountInString(m.GetCode()) < 1 {
+		err := OrderPromotionValidationError{
+			field:  "Code",
+			reason: "value length must be at least 1 runes",
 		}
 		if !all {
 			return err
@@ -189,10 +350,10 @@ func (m *CreateOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	if len(m.GetItems()) < 1 {
-		err := CreateOrderRequestValidationError{
-			field:  "Items",
-			reason: "value must contain at least 1 item(s)",
+	if m.GetDiscount() == nil {
+		err := OrderPromotionValidationError{
+			field:  "Discount",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -200,54 +361,49 @@ func (m *CreateOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
-	for idx, item := range m.GetItems() {
-		_, _ = idx, item
-
-		if all {
-			switch v := interface{}(item).(type) {
-			case interface{ ValidateAll() error }:
-				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, CreateOrderRequestValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
-			case interface{ Validate() error }:
-				if err := v.Validate(); err != nil {
-					errors = append(errors, CreateOrderRequestValidationError{
-						field:  fmt.Sprintf("Items[%v]", idx),
-						reason: "embedded message failed validation",
-						cause:  err,
-					})
-				}
+	if all {
+		switch v := interface{}(m.GetDiscount()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, OrderPromotionValidationError{
+					field:  "Discount",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
 			}
-		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+		case interface{ Validate() error }:
 			if err := v.Validate(); err != nil {
-				return CreateOrderRequestValidationError{
-					field:  fmt.Sprintf("Items[%v]", idx),
+				errors = append(errors, OrderPromotionValidationError{
+					field:  "Discount",
 					reason: "embedded message failed validation",
 					cause:  err,
-				}
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetDiscount()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return OrderPromotionValidationError{
+				field:  "Discount",
+				reason: "embedded message failed validation",
+				cause:  err,
 			}
 		}
-
 	}
 
 	if len(errors) > 0 {
-		return CreateOrderRequestMultiError(errors)
+		return OrderPromotionMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by CreateOrderRequest.ValidateAll() if the designated constraints
+// OrderPromotionMultiError is an error wrapping multiple validation errors
+// returned by OrderPromotion.ValidateAll() if the designated constraints
 // aren't met.
-type CreateOrderRequestMultiError []error
+type OrderPromotionMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateOrderRequestMultiError) Error() string {
+func (m OrderPromotionMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -256,11 +412,11 @@ func (m CreateOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateOrderRequestMultiError) AllErrors() []error { return m }
+func (m OrderPromotionMultiError) AllErrors() []error { return m }
 
-// CreateOrderRequestValidationError is the validation error returned by
-// CreateOrderRequest.Validate if the designated constraints aren't met.
-type CreateOrderRequestValidationError struct {
+// OrderPromotionValidationError is the validation error returned by
+// OrderPromotion.Validate if the designated constraints aren't met.
+type OrderPromotionValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -268,24 +424,22 @@ type CreateOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateOrderRequestValidationError) Field() string { return e.field }
+func (e OrderPromotionValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateOrderRequestValidationError) Reason() string { return e.reason }
+func (e OrderPromotionValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateOrderRequestValidationError) Cause() error { return e.cause }
+func (e OrderPromotionValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateOrderRequestValidationError) Key() bool { return e.key }
+func (e OrderPromotionValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateOrderRequestValidationError) ErrorName() string {
-	return "CreateOrderRequestValidationError"
-}
+func (e OrderPromotionValidationError) ErrorName() string { return "OrderPromotionValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateOrderRequestValidationError) Error() string {
+func (e OrderPromotionValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -297,14 +451,14 @@ func (e CreateOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateOrderRequest.%s: %s%s",
+		"invalid %sOrderPromotion.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateOrderRequestValidationError{}
+var _ error = OrderPromotionValidationError{}
 
 var _ interface {
 	Field() string
@@ -312,46 +466,110 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateOrderRequestValidationError{}
+} = OrderPromotionValidationError{}
 
-// Validate checks the field values on CreateOrderResponse with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CreateOrderResponse) Validate() error {
+// Validate checks the field values on DeliveryAddress with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *DeliveryAddress) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CreateOrderResponse with the rules
+// ValidateAll checks the field values on DeliveryAddress with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// CreateOrderResponseMultiError, or nil if none found.
-func (m *CreateOrderResponse) ValidateAll() error {
+// DeliveryAddressMultiError, or nil if none found.
+func (m *DeliveryAddress) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CreateOrderResponse) validate(all bool) error {
+func (m *DeliveryAddress) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for OrderID
+	if l := utf8.RuneCountInString(m.GetCity()); l < 1 || l > 128 {
+		err := DeliveryAddressValidationError{
+			field:  "City",
+			reason: "value length must be between 1 and 128 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetStreet()); l < 1 || l > 256 {
+		err := DeliveryAddressValidationError{
+			field:  "Street",
+			reason: "value length must be between 1 and 256 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if l := utf8.RuneCountInString(m.GetHouse()); l < 1 || l > 32 {
+		err := DeliveryAddressValidationError{
+			field:  "House",
+			reason: "value length must be between 1 and 32 runes, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetApartment()) > 32 {
+		err := DeliveryAddressValidationError{
+			field:  "Apartment",
+			reason: "value length must be at most 32 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPostalCode()) > 16 {
+		err := DeliveryAddressValidationError{
+			field:  "PostalCode",
+			reason: "value length must be at most 16 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetComment()) > 512 {
+		err := DeliveryAddressValidationError{
+			field:  "Comment",
+			reason: "value length must be at most 512 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	if len(errors) > 0 {
-		return CreateOrderResponseMultiError(errors)
+		return DeliveryAddressMultiError(errors)
 	}
 
 	return nil
 }
 
-// CreateOrderResponseMultiError is an error wrapping multiple validation
-// errors returned by CreateOrderResponse.ValidateAll() if the designated
-// constraints aren't met.
-type CreateOrderResponseMultiError []error
+// DeliveryAddressMultiError is an error wrapping multiple validation errors
+// returned by DeliveryAddress.ValidateAll() if the designated constraints
+// aren't met.
+type DeliveryAddressMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CreateOrderResponseMultiError) Error() string {
+func (m DeliveryAddressMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -360,11 +578,11 @@ func (m CreateOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CreateOrderResponseMultiError) AllErrors() []error { return m }
+func (m DeliveryAddressMultiError) AllErrors() []error { return m }
 
-// CreateOrderResponseValidationError is the validation error returned by
-// CreateOrderResponse.Validate if the designated constraints aren't met.
-type CreateOrderResponseValidationError struct {
+// DeliveryAddressValidationError is the validation error returned by
+// DeliveryAddress.Validate if the designated constraints aren't met.
+type DeliveryAddressValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -372,24 +590,22 @@ type CreateOrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e CreateOrderResponseValidationError) Field() string { return e.field }
+func (e DeliveryAddressValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CreateOrderResponseValidationError) Reason() string { return e.reason }
+func (e DeliveryAddressValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CreateOrderResponseValidationError) Cause() error { return e.cause }
+func (e DeliveryAddressValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CreateOrderResponseValidationError) Key() bool { return e.key }
+func (e DeliveryAddressValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CreateOrderResponseValidationError) ErrorName() string {
-	return "CreateOrderResponseValidationError"
-}
+func (e DeliveryAddressValidationError) ErrorName() string { return "DeliveryAddressValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CreateOrderResponseValidationError) Error() string {
+func (e DeliveryAddressValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -401,14 +617,14 @@ func (e CreateOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCreateOrderResponse.%s: %s%s",
+		"invalid %sDeliveryAddress.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CreateOrderResponseValidationError{}
+var _ error = DeliveryAddressValidationError{}
 
 var _ interface {
 	Field() string
@@ -416,34 +632,56 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CreateOrderResponseValidationError{}
+} = DeliveryAddressValidationError{}
 
-// Validate checks the field values on ListOrderRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListOrderRequest) Validate() error {
+// Validate checks the field values on Shipping with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Shipping) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// ListOrderRequestMultiError, or nil if none found.
-func (m *ListOrderRequest) ValidateAll() error {
+// ValidateAll checks the field values on Shipping with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in ShippingMultiError, or nil
+// if none found.
+func (m *Shipping) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrderRequest) validate(all bool) error {
+func (m *Shipping) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderID() <= 0 {
-		err := ListOrderRequestValidationError{
-			field:  "OrderID",
-			reason: "value must be greater than 0",
+	if _, ok := _Shipping_Method_NotInLookup[m.GetMethod()]; ok {
+		err := ShippingValidationError{
+			field:  "Method",
+			reason: "value must not be in list [SHIPPING_METHOD_UNSPECIFIED]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := ShippingMethod_name[int32(m.GetMethod())]; !ok {
+		err := ShippingValidationError{
+			field:  "Method",
+			reason: "value must be one of the defined enum values",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetAddress() == nil {
+		err := ShippingValidationError{
+			field:  "Address",
+			reason: "value is required",
 		}
 		if !all {
 			return err
@@ -451,20 +689,48 @@ func (m *ListOrderRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	if all {
+		switch v := interface{}(m.GetAddress()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ShippingValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ShippingValidationError{
+					field:  "Address",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetAddress()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ShippingValidationError{
+				field:  "Address",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
 	if len(errors) > 0 {
-		return ListOrderRequestMultiError(errors)
+		return ShippingMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by ListOrderRequest.ValidateAll() if the designated constraints
-// aren't met.
-type ListOrderRequestMultiError []error
+// ShippingMultiError is an error wrapping multiple validation errors returned
+// by Shipping.ValidateAll() if the designated constraints aren't met.
+type ShippingMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrderRequestMultiError) Error() string {
+func (m ShippingMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -473,11 +739,11 @@ func (m ListOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrderRequestMultiError) AllErrors() []error { return m }
+func (m ShippingMultiError) AllErrors() []error { return m }
 
-// ListOrderRequestValidationError is the validation error returned by
-// ListOrderRequest.Validate if the designated constraints aren't met.
-type ListOrderRequestValidationError struct {
+// ShippingValidationError is the validation error returned by
+// Shipping.Validate if the designated constraints aren't met.
+type ShippingValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -485,22 +751,22 @@ type ListOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrderRequestValidationError) Field() string { return e.field }
+func (e ShippingValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrderRequestValidationError) Reason() string { return e.reason }
+func (e ShippingValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrderRequestValidationError) Cause() error { return e.cause }
+func (e ShippingValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrderRequestValidationError) Key() bool { return e.key }
+func (e ShippingValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrderRequestValidationError) ErrorName() string { return "ListOrderRequestValidationError" }
+func (e ShippingValidationError) ErrorName() string { return "ShippingValidationError" }
 
 // Error satisfies the builtin error interface
-func (e ListOrderRequestValidationError) Error() string {
+func (e ShippingValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -512,14 +778,14 @@ func (e ListOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrderRequest.%s: %s%s",
+		"invalid %sShipping.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrderRequestValidationError{}
+var _ error = ShippingValidationError{}
 
 var _ interface {
 	Field() string
@@ -527,33 +793,55 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrderRequestValidationError{}
+} = ShippingValidationError{}
 
-// Validate checks the field values on ListOrderResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *ListOrderResponse) Validate() error {
+var _Shipping_Method_NotInLookup = map[ShippingMethod]struct{}{
+	0: {},
+}
+
+// Validate checks the field values on CreateOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrderRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on ListOrderResponse with the rules
+// ValidateAll checks the field values on CreateOrderRequest with the rules
 // defined in the proto definition for this message. If any rules are
 // violated, the result is a list of violation errors wrapped in
-// ListOrderResponseMultiError, or nil if none found.
-func (m *ListOrderResponse) ValidateAll() error {
+// CreateOrderRequestMultiError, or nil if none found.
+func (m *CreateOrderRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *ListOrderResponse) validate(all bool) error {
+func (m *CreateOrderRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for Status
+	if m.GetUser() <= 0 {
+		err := CreateOrderRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for User
+	if len(m.GetItems()) < 1 {
+		err := CreateOrderRequestValidationError{
+			field:  "Items",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
 	for idx, item := range m.GetItems() {
 		_, _ = idx, item
@@ -562,7 +850,7 @@ func (m *ListOrderResponse) validate(all bool) error {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, ListOrderResponseValidationError{
+					errors = append(errors, CreateOrderRequestValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -570,7 +858,7 @@ func (m *ListOrderResponse) validate(all bool) error {
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, ListOrderResponseValidationError{
+					errors = append(errors, CreateOrderRequestValidationError{
 						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
@@ -579,7 +867,7 @@ func (m *ListOrderResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return ListOrderResponseValidationError{
+				return CreateOrderRequestValidationError{
 					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
@@ -589,20 +877,123 @@ func (m *ListOrderResponse) validate(all bool) error {
 
 	}
 
+	if all {
+		switch v := interface{}(m.GetPromotion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "Promotion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "Promotion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPromotion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrderRequestValidationError{
+				field:  "Promotion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetShipping() == nil {
+		err := CreateOrderRequestValidationError{
+			field:  "Shipping",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if all {
+		switch v := interface{}(m.GetShipping()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "Shipping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, CreateOrderRequestValidationError{
+					field:  "Shipping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShipping()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return CreateOrderRequestValidationError{
+				field:  "Shipping",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	for idx, item := range m.GetPurchaseCaps() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, CreateOrderRequestValidationError{
+						field:  fmt.Sprintf("PurchaseCaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, CreateOrderRequestValidationError{
+						field:  fmt.Sprintf("PurchaseCaps[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return CreateOrderRequestValidationError{
+					field:  fmt.Sprintf("PurchaseCaps[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
 	if len(errors) > 0 {
-		return ListOrderResponseMultiError(errors)
+		return CreateOrderRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// ListOrderResponseMultiError is an error wrapping multiple validation errors
-// returned by ListOrderResponse.ValidateAll() if the designated constraints
+// CreateOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by CreateOrderRequest.ValidateAll() if the designated constraints
 // aren't met.
-type ListOrderResponseMultiError []error
+type CreateOrderRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m ListOrderResponseMultiError) Error() string {
+func (m CreateOrderRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -611,11 +1002,11 @@ func (m ListOrderResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m ListOrderResponseMultiError) AllErrors() []error { return m }
+func (m CreateOrderRequestMultiError) AllErrors() []error { return m }
 
-// ListOrderResponseValidationError is the validation error returned by
-// ListOrderResponse.Validate if the designated constraints aren't met.
-type ListOrderResponseValidationError struct {
+// CreateOrderRequestValidationError is the validation error returned by
+// CreateOrderRequest.Validate if the designated constraints aren't met.
+type CreateOrderRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -623,24 +1014,24 @@ type ListOrderResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e ListOrderResponseValidationError) Field() string { return e.field }
+func (e CreateOrderRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e ListOrderResponseValidationError) Reason() string { return e.reason }
+func (e CreateOrderRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e ListOrderResponseValidationError) Cause() error { return e.cause }
+func (e CreateOrderRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e ListOrderResponseValidationError) Key() bool { return e.key }
+func (e CreateOrderRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e ListOrderResponseValidationError) ErrorName() string {
-	return "ListOrderResponseValidationError"
+func (e CreateOrderRequestValidationError) ErrorName() string {
+	return "CreateOrderRequestValidationError"
 }
 
 // Error satisfies the builtin error interface
-func (e ListOrderResponseValidationError) Error() string {
+func (e CreateOrderRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -652,14 +1043,14 @@ func (e ListOrderResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sListOrderResponse.%s: %s%s",
+		"invalid %sCreateOrderRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = ListOrderResponseValidationError{}
+var _ error = CreateOrderRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -667,33 +1058,33 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = ListOrderResponseValidationError{}
+} = CreateOrderRequestValidationError{}
 
-// Validate checks the field values on OrderPayedRequest with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// first error encountered is returned, or nil if there are no violations.
-func (m *OrderPayedRequest) Validate() error {
+// Validate checks the field values on PurchaseCap with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *PurchaseCap) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on OrderPayedRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// OrderPayedRequestMultiError, or nil if none found.
-func (m *OrderPayedRequest) ValidateAll() error {
+// ValidateAll checks the field values on PurchaseCap with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PurchaseCapMultiError, or
+// nil if none found.
+func (m *PurchaseCap) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *OrderPayedRequest) validate(all bool) error {
+func (m *PurchaseCap) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderID() <= 0 {
-		err := OrderPayedRequestValidationError{
-			field:  "OrderID",
+	if m.GetSku() <= 0 {
+		err := PurchaseCapValidationError{
+			field:  "Sku",
 			reason: "value must be greater than 0",
 		}
 		if !all {
@@ -702,20 +1093,32 @@ func (m *OrderPayedRequest) validate(all bool) error {
 		errors = append(errors, err)
 	}
 
+	// no validation rules for Limit
+
+	if m.GetSince() == nil {
+		err := PurchaseCapValidationError{
+			field:  "Since",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
-		return OrderPayedRequestMultiError(errors)
+		return PurchaseCapMultiError(errors)
 	}
 
 	return nil
 }
 
-// OrderPayedRequestMultiError is an error wrapping multiple validation errors
-// returned by OrderPayedRequest.ValidateAll() if the designated constraints
-// aren't met.
-type OrderPayedRequestMultiError []error
+// PurchaseCapMultiError is an error wrapping multiple validation errors
+// returned by PurchaseCap.ValidateAll() if the designated constraints aren't met.
+type PurchaseCapMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m OrderPayedRequestMultiError) Error() string {
+func (m PurchaseCapMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -724,11 +1127,11 @@ func (m OrderPayedRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m OrderPayedRequestMultiError) AllErrors() []error { return m }
+func (m PurchaseCapMultiError) AllErrors() []error { return m }
 
-// OrderPayedRequestValidationError is the validation error returned by
-// OrderPayedRequest.Validate if the designated constraints aren't met.
-type OrderPayedRequestValidationError struct {
+// PurchaseCapValidationError is the validation error returned by
+// PurchaseCap.Validate if the designated constraints aren't met.
+type PurchaseCapValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -736,24 +1139,903 @@ type OrderPayedRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e OrderPayedRequestValidationError) Field() string { return e.field }
+func (e PurchaseCapValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e OrderPayedRequestValidationError) Reason() string { return e.reason }
+func (e PurchaseCapValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e OrderPayedRequestValidationError) Cause() error { return e.cause }
+func (e PurchaseCapValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e OrderPayedRequestValidationError) Key() bool { return e.key }
+func (e PurchaseCapValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e OrderPayedRequestValidationError) ErrorName() string {
-	return "OrderPayedRequestValidationError"
-}
+func (e PurchaseCapValidationError) ErrorName() string { return "PurchaseCapValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PurchaseCapValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPurchaseCap.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PurchaseCapValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PurchaseCapValidationError{}
+
+// Validate checks the field values on CreateOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CreateOrderResponseMultiError, or nil if none found.
+func (m *CreateOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for OrderID
+
+	if len(errors) > 0 {
+		return CreateOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateOrderResponseMultiError is an error wrapping multiple validation
+// errors returned by CreateOrderResponse.ValidateAll() if the designated
+// constraints aren't met.
+type CreateOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateOrderResponseMultiError) AllErrors() []error { return m }
+
+// CreateOrderResponseValidationError is the validation error returned by
+// CreateOrderResponse.Validate if the designated constraints aren't met.
+type CreateOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateOrderResponseValidationError) ErrorName() string {
+	return "CreateOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateOrderResponseValidationError{}
+
+// Validate checks the field values on ListOrderRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrderRequestMultiError, or nil if none found.
+func (m *ListOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := ListOrderRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return ListOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by ListOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrderRequestMultiError) AllErrors() []error { return m }
+
+// ListOrderRequestValidationError is the validation error returned by
+// ListOrderRequest.Validate if the designated constraints aren't met.
+type ListOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrderRequestValidationError) ErrorName() string { return "ListOrderRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e ListOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrderRequestValidationError{}
+
+// Validate checks the field values on ListOrderResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ListOrderResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListOrderResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListOrderResponseMultiError, or nil if none found.
+func (m *ListOrderResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListOrderResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Status
+
+	// no validation rules for User
+
+	for idx, item := range m.GetItems() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListOrderResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListOrderResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if all {
+		switch v := interface{}(m.GetPromotion()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOrderResponseValidationError{
+					field:  "Promotion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOrderResponseValidationError{
+					field:  "Promotion",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetPromotion()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOrderResponseValidationError{
+				field:  "Promotion",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetTotal()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOrderResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOrderResponseValidationError{
+					field:  "Total",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetTotal()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOrderResponseValidationError{
+				field:  "Total",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetShipping()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, ListOrderResponseValidationError{
+					field:  "Shipping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, ListOrderResponseValidationError{
+					field:  "Shipping",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetShipping()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return ListOrderResponseValidationError{
+				field:  "Shipping",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return ListOrderResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListOrderResponseMultiError is an error wrapping multiple validation errors
+// returned by ListOrderResponse.ValidateAll() if the designated constraints
+// aren't met.
+type ListOrderResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListOrderResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListOrderResponseMultiError) AllErrors() []error { return m }
+
+// ListOrderResponseValidationError is the validation error returned by
+// ListOrderResponse.Validate if the designated constraints aren't met.
+type ListOrderResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListOrderResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListOrderResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListOrderResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListOrderResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListOrderResponseValidationError) ErrorName() string {
+	return "ListOrderResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListOrderResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListOrderResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListOrderResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListOrderResponseValidationError{}
+
+// Validate checks the field values on OrderPayedRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *OrderPayedRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on OrderPayedRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// OrderPayedRequestMultiError, or nil if none found.
+func (m *OrderPayedRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *OrderPayedRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := OrderPayedRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return OrderPayedRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// OrderPayedRequestMultiError is an error wrapping multiple validation errors
+// returned by OrderPayedRequest.ValidateAll() if the designated constraints
+// aren't met.
+type OrderPayedRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m OrderPayedRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m OrderPayedRequestMultiError) AllErrors() []error { return m }
+
+// OrderPayedRequestValidationError is the validation error returned by
+// OrderPayedRequest.Validate if the designated constraints aren't met.
+type OrderPayedRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e OrderPayedRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e OrderPayedRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e OrderPayedRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e OrderPayedRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e OrderPayedRequestValidationError) ErrorName() string {
+	return "OrderPayedRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e OrderPayedRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sOrderPayedRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = OrderPayedRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = OrderPayedRequestValidationError{}
+
+// Validate checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CancelOrderRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CancelOrderRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// CancelOrderRequestMultiError, or nil if none found.
+func (m *CancelOrderRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CancelOrderRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetOrderID() <= 0 {
+		err := CancelOrderRequestValidationError{
+			field:  "OrderID",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CancelOrderRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CancelOrderRequestMultiError is an error wrapping multiple validation errors
+// returned by CancelOrderRequest.ValidateAll() if the designated constraints
+// aren't met.
+type CancelOrderRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CancelOrderRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CancelOrderRequestMultiError) AllErrors() []error { return m }
+
+// CancelOrderRequestValidationError is the validation error returned by
+// CancelOrderRequest.Validate if the designated constraints aren't met.
+type CancelOrderRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CancelOrderRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CancelOrderRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CancelOrderRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CancelOrderRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CancelOrderRequestValidationError) ErrorName() string {
+	return "CancelOrderRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CancelOrderRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCancelOrderRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CancelOrderRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CancelOrderRequestValidationError{}
+
+// Validate checks the field values on Stock with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Stock) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Stock with the rules defined in the
+// proto definition for this message. If any rules are violated, the result is
+// a list of violation errors wrapped in StockMultiError, or nil if none found.
+func (m *Stock) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Stock) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for WarehouseID
+
+	// no validation rules for Count
+
+	if len(errors) > 0 {
+		return StockMultiError(errors)
+	}
+
+	return nil
+}
+
+// StockMultiError is an error wrapping multiple validation errors returned by
+// Stock.ValidateAll() if the designated constraints aren't met.
+type StockMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StockMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StockMultiError) AllErrors() []error { return m }
+
+// StockValidationError is the validation error returned by Stock.Validate if
+// the designated constraints aren't met.
+type StockValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StockValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StockValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StockValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StockValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StockValidationError) ErrorName() string { return "StockValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StockValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStock.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StockValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StockValidationError{}
+
+// Validate checks the field values on StocksRequest with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StocksRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StocksRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StocksRequestMultiError, or
+// nil if none found.
+func (m *StocksRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StocksRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetSku() <= 0 {
+		err := StocksRequestValidationError{
+			field:  "Sku",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return StocksRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// StocksRequestMultiError is an error wrapping multiple validation errors
+// returned by StocksRequest.ValidateAll() if the designated constraints
+// aren't met.
+type StocksRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StocksRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StocksRequestMultiError) AllErrors() []error { return m }
+
+// StocksRequestValidationError is the validation error returned by
+// StocksRequest.Validate if the designated constraints aren't met.
+type StocksRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StocksRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StocksRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StocksRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StocksRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StocksRequestValidationError) ErrorName() string { return "StocksRequestValidationError" }
 
 // Error satisfies the builtin error interface
-func (e OrderPayedRequestValidationError) Error() string {
+func (e StocksRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -765,14 +2047,14 @@ func (e OrderPayedRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sOrderPayedRequest.%s: %s%s",
+		"invalid %sStocksRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = OrderPayedRequestValidationError{}
+var _ error = StocksRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -780,55 +2062,78 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = OrderPayedRequestValidationError{}
+} = StocksRequestValidationError{}
 
-// Validate checks the field values on CancelOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the first error encountered is returned, or nil if there are no violations.
-func (m *CancelOrderRequest) Validate() error {
+// Validate checks the field values on StocksResponse with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *StocksResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on CancelOrderRequest with the rules
-// defined in the proto definition for this message. If any rules are
-// violated, the result is a list of violation errors wrapped in
-// CancelOrderRequestMultiError, or nil if none found.
-func (m *CancelOrderRequest) ValidateAll() error {
+// ValidateAll checks the field values on StocksResponse with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in StocksResponseMultiError,
+// or nil if none found.
+func (m *StocksResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *CancelOrderRequest) validate(all bool) error {
+func (m *StocksResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetOrderID() <= 0 {
-		err := CancelOrderRequestValidationError{
-			field:  "OrderID",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
+	for idx, item := range m.GetStocks() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, StocksResponseValidationError{
+						field:  fmt.Sprintf("Stocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, StocksResponseValidationError{
+						field:  fmt.Sprintf("Stocks[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return StocksResponseValidationError{
+					field:  fmt.Sprintf("Stocks[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
 		}
-		errors = append(errors, err)
+
 	}
 
 	if len(errors) > 0 {
-		return CancelOrderRequestMultiError(errors)
+		return StocksResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// CancelOrderRequestMultiError is an error wrapping multiple validation errors
-// returned by CancelOrderRequest.ValidateAll() if the designated constraints
+// StocksResponseMultiError is an error wrapping multiple validation errors
+// returned by StocksResponse.ValidateAll() if the designated constraints
 // aren't met.
-type CancelOrderRequestMultiError []error
+type StocksResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m CancelOrderRequestMultiError) Error() string {
+func (m StocksResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -837,11 +2142,11 @@ func (m CancelOrderRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m CancelOrderRequestMultiError) AllErrors() []error { return m }
+func (m StocksResponseMultiError) AllErrors() []error { return m }
 
-// CancelOrderRequestValidationError is the validation error returned by
-// CancelOrderRequest.Validate if the designated constraints aren't met.
-type CancelOrderRequestValidationError struct {
+// StocksResponseValidationError is the validation error returned by
+// StocksResponse.Validate if the designated constraints aren't met.
+type StocksResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -849,24 +2154,22 @@ type CancelOrderRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e CancelOrderRequestValidationError) Field() string { return e.field }
+func (e StocksResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e CancelOrderRequestValidationError) Reason() string { return e.reason }
+func (e StocksResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e CancelOrderRequestValidationError) Cause() error { return e.cause }
+func (e StocksResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e CancelOrderRequestValidationError) Key() bool { return e.key }
+func (e StocksResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e CancelOrderRequestValidationError) ErrorName() string {
-	return "CancelOrderRequestValidationError"
-}
+func (e StocksResponseValidationError) ErrorName() string { return "StocksResponseValidationError" }
 
 // Error satisfies the builtin error interface
-func (e CancelOrderRequestValidationError) Error() string {
+func (e StocksResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -878,14 +2181,14 @@ func (e CancelOrderRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sCancelOrderRequest.%s: %s%s",
+		"invalid %sStocksResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = CancelOrderRequestValidationError{}
+var _ error = StocksResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -893,46 +2196,93 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = CancelOrderRequestValidationError{}
+} = StocksResponseValidationError{}
 
-// Validate checks the field values on Stock with the rules defined in the
-// proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *Stock) Validate() error {
+// Validate checks the field values on UserPurchasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserPurchasesRequest) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on Stock with the rules defined in the
-// proto definition for this message. If any rules are violated, the result is
-// a list of violation errors wrapped in StockMultiError, or nil if none found.
-func (m *Stock) ValidateAll() error {
+// ValidateAll checks the field values on UserPurchasesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPurchasesRequestMultiError, or nil if none found.
+func (m *UserPurchasesRequest) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *Stock) validate(all bool) error {
+func (m *UserPurchasesRequest) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	// no validation rules for WarehouseID
+	if m.GetUser() <= 0 {
+		err := UserPurchasesRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
 
-	// no validation rules for Count
+	if m.GetSince() == nil {
+		err := UserPurchasesRequestValidationError{
+			field:  "Since",
+			reason: "value is required",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetSkus()) < 1 {
+		err := UserPurchasesRequestValidationError{
+			field:  "Skus",
+			reason: "value must contain at least 1 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetSkus() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := UserPurchasesRequestValidationError{
+				field:  fmt.Sprintf("Skus[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
 
 	if len(errors) > 0 {
-		return StockMultiError(errors)
+		return UserPurchasesRequestMultiError(errors)
 	}
 
 	return nil
 }
 
-// StockMultiError is an error wrapping multiple validation errors returned by
-// Stock.ValidateAll() if the designated constraints aren't met.
-type StockMultiError []error
+// UserPurchasesRequestMultiError is an error wrapping multiple validation
+// errors returned by UserPurchasesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type UserPurchasesRequestMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StockMultiError) Error() string {
+func (m UserPurchasesRequestMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -941,11 +2291,11 @@ func (m StockMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StockMultiError) AllErrors() []error { return m }
+func (m UserPurchasesRequestMultiError) AllErrors() []error { return m }
 
-// StockValidationError is the validation error returned by Stock.Validate if
-// the designated constraints aren't met.
-type StockValidationError struct {
+// UserPurchasesRequestValidationError is the validation error returned by
+// UserPurchasesRequest.Validate if the designated constraints aren't met.
+type UserPurchasesRequestValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -953,22 +2303,24 @@ type StockValidationError struct {
 }
 
 // Field function returns field value.
-func (e StockValidationError) Field() string { return e.field }
+func (e UserPurchasesRequestValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StockValidationError) Reason() string { return e.reason }
+func (e UserPurchasesRequestValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StockValidationError) Cause() error { return e.cause }
+func (e UserPurchasesRequestValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StockValidationError) Key() bool { return e.key }
+func (e UserPurchasesRequestValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StockValidationError) ErrorName() string { return "StockValidationError" }
+func (e UserPurchasesRequestValidationError) ErrorName() string {
+	return "UserPurchasesRequestValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StockValidationError) Error() string {
+func (e UserPurchasesRequestValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -980,14 +2332,14 @@ func (e StockValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStock.%s: %s%s",
+		"invalid %sUserPurchasesRequest.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StockValidationError{}
+var _ error = UserPurchasesRequestValidationError{}
 
 var _ interface {
 	Field() string
@@ -995,55 +2347,48 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StockValidationError{}
+} = UserPurchasesRequestValidationError{}
 
-// Validate checks the field values on StocksRequest with the rules defined in
+// Validate checks the field values on PurchasedItem with the rules defined in
 // the proto definition for this message. If any rules are violated, the first
 // error encountered is returned, or nil if there are no violations.
-func (m *StocksRequest) Validate() error {
+func (m *PurchasedItem) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StocksRequest with the rules defined
+// ValidateAll checks the field values on PurchasedItem with the rules defined
 // in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StocksRequestMultiError, or
+// result is a list of violation errors wrapped in PurchasedItemMultiError, or
 // nil if none found.
-func (m *StocksRequest) ValidateAll() error {
+func (m *PurchasedItem) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StocksRequest) validate(all bool) error {
+func (m *PurchasedItem) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	if m.GetSku() <= 0 {
-		err := StocksRequestValidationError{
-			field:  "Sku",
-			reason: "value must be greater than 0",
-		}
-		if !all {
-			return err
-		}
-		errors = append(errors, err)
-	}
+	// no validation rules for Sku
+
+	// no validation rules for Count
 
 	if len(errors) > 0 {
-		return StocksRequestMultiError(errors)
+		return PurchasedItemMultiError(errors)
 	}
 
 	return nil
 }
 
-// StocksRequestMultiError is an error wrapping multiple validation errors
-// returned by StocksRequest.ValidateAll() if the designated constraints
+// PurchasedItemMultiError is an error wrapping multiple validation errors
+// returned by PurchasedItem.ValidateAll() if the designated constraints
 // aren't met.
-type StocksRequestMultiError []error
+type PurchasedItemMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StocksRequestMultiError) Error() string {
+func (m PurchasedItemMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1052,11 +2397,11 @@ func (m StocksRequestMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StocksRequestMultiError) AllErrors() []error { return m }
+func (m PurchasedItemMultiError) AllErrors() []error { return m }
 
-// StocksRequestValidationError is the validation error returned by
-// StocksRequest.Validate if the designated constraints aren't met.
-type StocksRequestValidationError struct {
+// PurchasedItemValidationError is the validation error returned by
+// PurchasedItem.Validate if the designated constraints aren't met.
+type PurchasedItemValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1064,22 +2409,22 @@ type StocksRequestValidationError struct {
 }
 
 // Field function returns field value.
-func (e StocksRequestValidationError) Field() string { return e.field }
+func (e PurchasedItemValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StocksRequestValidationError) Reason() string { return e.reason }
+func (e PurchasedItemValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StocksRequestValidationError) Cause() error { return e.cause }
+func (e PurchasedItemValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StocksRequestValidationError) Key() bool { return e.key }
+func (e PurchasedItemValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StocksRequestValidationError) ErrorName() string { return "StocksRequestValidationError" }
+func (e PurchasedItemValidationError) ErrorName() string { return "PurchasedItemValidationError" }
 
 // Error satisfies the builtin error interface
-func (e StocksRequestValidationError) Error() string {
+func (e PurchasedItemValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1091,14 +2436,14 @@ func (e StocksRequestValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStocksRequest.%s: %s%s",
+		"invalid %sPurchasedItem.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StocksRequestValidationError{}
+var _ error = PurchasedItemValidationError{}
 
 var _ interface {
 	Field() string
@@ -1106,47 +2451,47 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StocksRequestValidationError{}
+} = PurchasedItemValidationError{}
 
-// Validate checks the field values on StocksResponse with the rules defined in
-// the proto definition for this message. If any rules are violated, the first
-// error encountered is returned, or nil if there are no violations.
-func (m *StocksResponse) Validate() error {
+// Validate checks the field values on UserPurchasesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *UserPurchasesResponse) Validate() error {
 	return m.validate(false)
 }
 
-// ValidateAll checks the field values on StocksResponse with the rules defined
-// in the proto definition for this message. If any rules are violated, the
-// result is a list of violation errors wrapped in StocksResponseMultiError,
-// or nil if none found.
-func (m *StocksResponse) ValidateAll() error {
+// ValidateAll checks the field values on UserPurchasesResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// UserPurchasesResponseMultiError, or nil if none found.
+func (m *UserPurchasesResponse) ValidateAll() error {
 	return m.validate(true)
 }
 
-func (m *StocksResponse) validate(all bool) error {
+func (m *UserPurchasesResponse) validate(all bool) error {
 	if m == nil {
 		return nil
 	}
 
 	var errors []error
 
-	for idx, item := range m.GetStocks() {
+	for idx, item := range m.GetItems() {
 		_, _ = idx, item
 
 		if all {
 			switch v := interface{}(item).(type) {
 			case interface{ ValidateAll() error }:
 				if err := v.ValidateAll(); err != nil {
-					errors = append(errors, StocksResponseValidationError{
-						field:  fmt.Sprintf("Stocks[%v]", idx),
+					errors = append(errors, UserPurchasesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
 				}
 			case interface{ Validate() error }:
 				if err := v.Validate(); err != nil {
-					errors = append(errors, StocksResponseValidationError{
-						field:  fmt.Sprintf("Stocks[%v]", idx),
+					errors = append(errors, UserPurchasesResponseValidationError{
+						field:  fmt.Sprintf("Items[%v]", idx),
 						reason: "embedded message failed validation",
 						cause:  err,
 					})
@@ -1154,8 +2499,8 @@ func (m *StocksResponse) validate(all bool) error {
 			}
 		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
 			if err := v.Validate(); err != nil {
-				return StocksResponseValidationError{
-					field:  fmt.Sprintf("Stocks[%v]", idx),
+				return UserPurchasesResponseValidationError{
+					field:  fmt.Sprintf("Items[%v]", idx),
 					reason: "embedded message failed validation",
 					cause:  err,
 				}
@@ -1165,19 +2510,19 @@ func (m *StocksResponse) validate(all bool) error {
 	}
 
 	if len(errors) > 0 {
-		return StocksResponseMultiError(errors)
+		return UserPurchasesResponseMultiError(errors)
 	}
 
 	return nil
 }
 
-// StocksResponseMultiError is an error wrapping multiple validation errors
-// returned by StocksResponse.ValidateAll() if the designated constraints
-// aren't met.
-type StocksResponseMultiError []error
+// UserPurchasesResponseMultiError is an error wrapping multiple validation
+// errors returned by UserPurchasesResponse.ValidateAll() if the designated
+// constraints aren't met.
+type UserPurchasesResponseMultiError []error
 
 // Error returns a concatenation of all the error messages it wraps.
-func (m StocksResponseMultiError) Error() string {
+func (m UserPurchasesResponseMultiError) Error() string {
 	var msgs []string
 	for _, err := range m {
 		msgs = append(msgs, err.Error())
@@ -1186,11 +2531,11 @@ func (m StocksResponseMultiError) Error() string {
 }
 
 // AllErrors returns a list of validation violation errors.
-func (m StocksResponseMultiError) AllErrors() []error { return m }
+func (m UserPurchasesResponseMultiError) AllErrors() []error { return m }
 
-// StocksResponseValidationError is the validation error returned by
-// StocksResponse.Validate if the designated constraints aren't met.
-type StocksResponseValidationError struct {
+// UserPurchasesResponseValidationError is the validation error returned by
+// UserPurchasesResponse.Validate if the designated constraints aren't met.
+type UserPurchasesResponseValidationError struct {
 	field  string
 	reason string
 	cause  error
@@ -1198,22 +2543,24 @@ type StocksResponseValidationError struct {
 }
 
 // Field function returns field value.
-func (e StocksResponseValidationError) Field() string { return e.field }
+func (e UserPurchasesResponseValidationError) Field() string { return e.field }
 
 // Reason function returns reason value.
-func (e StocksResponseValidationError) Reason() string { return e.reason }
+func (e UserPurchasesResponseValidationError) Reason() string { return e.reason }
 
 // Cause function returns cause value.
-func (e StocksResponseValidationError) Cause() error { return e.cause }
+func (e UserPurchasesResponseValidationError) Cause() error { return e.cause }
 
 // Key function returns key value.
-func (e StocksResponseValidationError) Key() bool { return e.key }
+func (e UserPurchasesResponseValidationError) Key() bool { return e.key }
 
 // ErrorName returns error name.
-func (e StocksResponseValidationError) ErrorName() string { return "StocksResponseValidationError" }
+func (e UserPurchasesResponseValidationError) ErrorName() string {
+	return "UserPurchasesResponseValidationError"
+}
 
 // Error satisfies the builtin error interface
-func (e StocksResponseValidationError) Error() string {
+func (e UserPurchasesResponseValidationError) Error() string {
 	cause := ""
 	if e.cause != nil {
 		cause = fmt.Sprintf(" | caused by: %v", e.cause)
@@ -1225,14 +2572,14 @@ func (e StocksResponseValidationError) Error() string {
 	}
 
 	return fmt.Sprintf(
-		"invalid %sStocksResponse.%s: %s%s",
+		"invalid %sUserPurchasesResponse.%s: %s%s",
 		key,
 		e.field,
 		e.reason,
 		cause)
 }
 
-var _ error = StocksResponseValidationError{}
+var _ error = UserPurchasesResponseValidationError{}
 
 var _ interface {
 	Field() string
@@ -1240,4 +2587,4 @@ var _ interface {
 	Key() bool
 	Cause() error
 	ErrorName() string
-} = StocksResponseValidationError{}
+} = UserPurchasesResponseValidationError{}
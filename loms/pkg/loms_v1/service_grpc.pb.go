@@ -20,11 +20,12 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Loms_CreateOrder_FullMethodName = "/loms.Loms/CreateOrder"
-	Loms_ListOrder_FullMethodName   = "/loms.Loms/ListOrder"
-	Loms_OrderPayed_FullMethodName  = "/loms.Loms/OrderPayed"
-	Loms_CancelOrder_FullMethodName = "/loms.Loms/CancelOrder"
-	Loms_Stocks_FullMethodName      = "/loms.Loms/Stocks"
+	Loms_CreateOrder_FullMethodName   = "/loms.Loms/CreateOrder"
+	Loms_ListOrder_FullMethodName     = "/loms.Loms/ListOrder"
+	Loms_OrderPayed_FullMethodName    = "/loms.Loms/OrderPayed"
+	Loms_CancelOrder_FullMethodName   = "/loms.Loms/CancelOrder"
+	Loms_Stocks_FullMethodName        = "/loms.Loms/Stocks"
+	Loms_UserPurchases_FullMethodName = "/loms.Loms/UserPurchases"
 )
 
 // LomsClient is the client API for Loms service.
@@ -36,6 +37,7 @@ type LomsClient interface {
 	OrderPayed(ctx context.Context, in *OrderPayedRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
 	Stocks(ctx context.Context, in *StocksRequest, opts ...grpc.CallOption) (*StocksResponse, error)
+	UserPurchases(ctx context.Context, in *UserPurchasesRequest, opts ...grpc.CallOption) (*UserPurchasesResponse, error)
 }
 
 type lomsClient struct {
@@ -91,6 +93,15 @@ func (c *lomsClient) Stocks(ctx context.Context, in *StocksRequest, opts ...grpc
 	return out, nil
 }
 
+func (c *lomsClient) UserPurchases(ctx context.Context, in *UserPurchasesRequest, opts ...grpc.CallOption) (*UserPurchasesResponse, error) {
+	out := new(UserPurchasesResponse)
+	err := c.cc.Invoke(ctx, Loms_UserPurchases_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // LomsServer is the server API for Loms service.
 // All implementations must embed UnimplementedLomsServer
 // for forward compatibility
@@ -100,6 +111,7 @@ type LomsServer interface {
 	OrderPayed(context.Context, *OrderPayedRequest) (*emptypb.Empty, error)
 	CancelOrder(context.Context, *CancelOrderRequest) (*emptypb.Empty, error)
 	Stocks(context.Context, *StocksRequest) (*StocksResponse, error)
+	UserPurchases(context.Context, *UserPurchasesRequest) (*UserPurchasesResponse, error)
 	mustEmbedUnimplementedLomsServer()
 }
 
@@ -122,6 +134,9 @@ func (UnimplementedLomsServer) CancelOrder(context.Context, *CancelOrderRequest)
 func (UnimplementedLomsServer) Stocks(context.Context, *StocksRequest) (*StocksResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Stocks not implemented")
 }
+func (UnimplementedLomsServer) UserPurchases(context.Context, *UserPurchasesRequest) (*UserPurchasesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UserPurchases not implemented")
+}
 func (UnimplementedLomsServer) mustEmbedUnimplementedLomsServer() {}
 
 // UnsafeLomsServer may be embedded to opt out of forward compatibility for this service.
@@ -225,6 +240,24 @@ func _Loms_Stocks_Handler(srv interface{}, ctx context.Context, dec func(interfa
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Loms_UserPurchases_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UserPurchasesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).UserPurchases(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loms_UserPurchases_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).UserPurchases(ctx, req.(*UserPurchasesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Loms_ServiceDesc is the grpc.ServiceDesc for Loms service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -252,6 +285,10 @@ var Loms_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "Stocks",
 			Handler:    _Loms_Stocks_Handler,
 		},
+		{
+			MethodName: "UserPurchases",
+			Handler:    _Loms_UserPurchases_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
@@ -8,16 +8,28 @@ import (
 	"net/http"
 	"os"
 	"os/signal"
+	"route256/libs/apperr"
+	"route256/libs/auth"
+	libkafka "route256/libs/kafka"
+	"route256/libs/tlsconfig"
 	api "route256/notifications/internal/api/notifications"
+	"route256/notifications/internal/bot"
+	"route256/notifications/internal/clients/console"
+	"route256/notifications/internal/clients/email"
+	"route256/notifications/internal/clients/loms"
 	"route256/notifications/internal/clients/telegram"
+	"route256/notifications/internal/clients/webhook"
 	"route256/notifications/internal/config"
 	"route256/notifications/internal/domain"
+	"route256/notifications/internal/gateway"
 	"route256/notifications/internal/kafka"
+	"route256/notifications/internal/model"
 	"route256/notifications/internal/pkg/cache/lru"
 	"route256/notifications/internal/pkg/logger"
 	"route256/notifications/internal/pkg/metrics"
 	"route256/notifications/internal/pkg/tracer"
 	"route256/notifications/internal/repository/postgres"
+	"route256/notifications/internal/templates"
 	"route256/notifications/pkg/notifications_v1"
 	"sync"
 	"syscall"
@@ -26,18 +38,21 @@ import (
 	"github.com/Shopify/sarama"
 	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
 	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus/promhttp"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/credentials"
 	"google.golang.org/grpc/reflection"
 )
 
 const (
 	grpcPort      = 50053
 	httpPort      = 8082
-	topic         = "orders"
 	groupID       = "notifications"
 	cacheCapacity = 100
+	cacheTTL      = time.Minute
+	// Pause before a new consumer session after a failed one
+	consumeRetryDelay = 5 * time.Second
 )
 
 func main() {
@@ -49,9 +64,15 @@ func main() {
 
 	ctx, cancel := context.WithCancel(context.Background())
 
-	tgClient, err := telegram.New(cfg.Telegram.APIKey, cfg.Telegram.ChatID)
+	notifiers, err := initNotifiers(cfg)
 	if err != nil {
-		log.Fatalf("Cannot connect to telegram: %v", err)
+		log.Fatalf("failed to create notifiers: %v", err)
+	}
+
+	// Templates are checked with sample data before the service starts
+	notificationTemplates, err := templates.Load(cfg.Templates.Dir)
+	if err != nil {
+		log.Fatalf("failed to load templates: %v", err)
 	}
 
 	// Connect to database
@@ -65,17 +86,68 @@ func main() {
 		log.Fatalf("failed to listen: %v", err)
 	}
 
+	producer, err := libkafka.NewProducer(cfg.Brokers)
+	if err != nil {
+		log.Fatalf("failed to create kafka producer: %v", err)
+	}
+
+	tlsOptions := tlsconfig.Options{
+		CertFile:          cfg.TLS.CertFile,
+		KeyFile:           cfg.TLS.KeyFile,
+		CAFile:            cfg.TLS.CAFile,
+		RequireClientCert: cfg.TLS.RequireClientCert,
+		ReloadInterval:    cfg.TLS.ReloadInterval,
+		Logger:            tlsconfig.Logger{Info: logger.Info, Error: logger.Error},
+	}
+	serverCreds, err := tlsconfig.ServerCredentials(tlsOptions)
+	if err != nil {
+		log.Fatalf("create server credentials: %v", err)
+	}
+	clientCreds, err := tlsconfig.ClientCredentials(tlsOptions)
+	if err != nil {
+		log.Fatalf("create client credentials: %v", err)
+	}
+
+	orders, err := initOrderClient(cfg, clientCreds)
+	if err != nil {
+		log.Fatalf("failed to connect to loms: %v", err)
+	}
+
 	service := domain.NewService(
 		postgres.NewMessageRepository(pool),
-		tgClient,
-		lru.NewLRUCache[domain.CacheKey, domain.CacheVal](cacheCapacity),
+		notifiers,
+		initHistoryCache(cfg),
+		postgres.NewDeadLetterRepository(pool),
+		producer,
+		postgres.NewDeliveryRepository(pool),
+		postgres.NewPreferencesRepository(pool),
+		notificationTemplates,
+		postgres.NewTelegramRepository(pool),
+		orders,
 	)
 
+	verifier, err := auth.NewVerifier(auth.Options{
+		HMACSecret: cfg.Auth.HMACSecret,
+		JWKSFile:   cfg.Auth.JWKSFile,
+		Issuer:     cfg.Auth.Issuer,
+		Audience:   cfg.Auth.Audience,
+	})
+	if err != nil {
+		log.Fatalf("create token verifier: %v", err)
+	}
+
 	s := grpc.NewServer(
+		grpc.Creds(serverCreds),
 		grpc.ChainUnaryInterceptor(
 			logger.MiddlewareGRPC,
 			tracer.MiddlewareGRPC,
 			metrics.MiddlewareGRPC,
+			apperr.UnaryServerInterceptor("notifications"),
+			auth.UnaryServerInterceptor(verifier, api.AuthPolicy),
+		),
+		grpc.ChainStreamInterceptor(
+			apperr.StreamServerInterceptor("notifications"),
+			auth.StreamServerInterceptor(verifier, api.AuthPolicy),
 		),
 	)
 
@@ -90,13 +162,27 @@ func main() {
 		}
 	}()
 
+	// Answer commands of users in Telegram, replies share the send queue with notifications
+	tgQueue, tgQueued := notifiers[model.ChannelTelegram].(*telegram.Queue)
+	if tgQueued && orders != nil {
+		go func() {
+			if err := bot.New(tgQueue, service).Run(ctx); err != nil {
+				logger.Error("telegram bot stopped: ", err)
+			}
+		}()
+	} else {
+		logger.Info("telegram bot is off, set telegram.api_key and loms.address to run it")
+	}
+
 	// run kafka consumer
 	go func() {
-		consumer := kafka.NewConsumerGroupHandler(service)
+		policy := retryPolicy(cfg)
 		client, err := initConsumerGroup(cfg.Brokers, groupID)
 		if err != nil {
 			log.Fatalf("failed to create consumer group: %v", err)
 		}
+		consumer := kafka.NewConsumerGroupHandler(service, producer, client, policy)
+		topics := append([]string{kafka.OrdersTopic, kafka.AbandonedCartsTopic}, policy.Topics()...)
 
 		consumptionIsPaused := false
 		wg := &sync.WaitGroup{}
@@ -104,8 +190,13 @@ func main() {
 		go func() {
 			defer wg.Done()
 			for {
-				if err := client.Consume(ctx, []string{topic}, &consumer); err != nil {
-					log.Fatalf("Error from consumer: %v", err)
+				if err := client.Consume(ctx, topics, &consumer); err != nil {
+					// Messages that were not marked are consumed again by the next session
+					logger.Error("error from consumer: ", err)
+					select {
+					case <-time.After(consumeRetryDelay):
+					case <-ctx.Done():
+					}
 				}
 
 				if ctx.Err() != nil {
@@ -137,30 +228,49 @@ func main() {
 		}
 
 		cancel()
-		wg.Wait()
 
+		// Consumer sessions end once the digests of their messages are sent,
+		// the queue sends the waiting ones without waiting for their windows
+		drained := make(chan struct{})
+		go func() {
+			defer close(drained)
+			if tgQueued {
+				tgQueue.Close()
+			}
+		}()
+		wg.Wait()
+		<-drained
 		if err = client.Close(); err != nil {
 			log.Fatalf("error closing client: %v", err)
 		}
+		if err = producer.Close(); err != nil {
+			log.Fatalf("error closing producer: %v", err)
+		}
 	}()
 
-	// Create connection to gRPC-gateway
+	// Create connection to gRPC-gateway, the server certificate is issued for localhost
 	conn, err := grpc.DialContext(
 		context.Background(),
-		lis.Addr().String(),
+		fmt.Sprintf("localhost:%d", grpcPort),
 		grpc.WithBlock(),
-		grpc.WithTransportCredentials(insecure.NewCredentials()),
+		grpc.WithTransportCredentials(clientCreds),
 	)
 	if err != nil {
 		log.Fatalf("Failed to dial server: %v", err)
 	}
 
-	mux := runtime.NewServeMux()
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(apperr.HTTPErrorHandler))
 	err = notifications_v1.RegisterNotificationsHandler(context.Background(), mux, conn)
 	if err != nil {
 		log.Fatalf("Failed to register gateway: %v", err)
 	}
 
+	// Live notifications as Server-Sent Events
+	err = mux.HandlePath(http.MethodGet, "/notifications/stream", gateway.SSEHandler(mux, notifications_v1.NewNotificationsClient(conn)))
+	if err != nil {
+		log.Fatalf("Failed to register stream handler: %v", err)
+	}
+
 	if err := mux.HandlePath(http.MethodGet, "/metrics", func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
 		promhttp.Handler().ServeHTTP(w, r)
 	}); err != nil {
@@ -179,6 +289,129 @@ func main() {
 	}
 }
 
+// Create notifiers of the channels. Telegram without a configured client is written to the console
+// for local development, email and webhook channels without one are left unregistered
+func initNotifiers(cfg *config.Config) (map[model.Channel]domain.Notifier, error) {
+	out, err := console.OpenFile(cfg.Console.File)
+	if err != nil {
+		return nil, err
+	}
+	notifiers := map[model.Channel]domain.Notifier{
+		model.ChannelTelegram: console.New(string(model.ChannelTelegram), out),
+	}
+
+	if cfg.Telegram.APIKey != "" {
+		tgClient, err := telegram.New(cfg.Telegram.APIKey)
+		if err != nil {
+			return nil, errors.Wrap(err, "connect to telegram")
+		}
+		notifiers[model.ChannelTelegram] = telegram.NewQueue(tgClient, telegram.QueueOptions{
+			GlobalRPS:     cfg.Telegram.Queue.GlobalRPS,
+			ChatRPS:       cfg.Telegram.Queue.ChatRPS,
+			ChatBurst:     cfg.Telegram.Queue.ChatBurst,
+			Capacity:      cfg.Telegram.Queue.Capacity,
+			MaxRetries:    cfg.Telegram.Queue.MaxRetries,
+			MaxRetryAfter: cfg.Telegram.Queue.MaxRetryAfter,
+			DigestWindow:  cfg.Telegram.Queue.DigestWindow,
+			DrainTimeout:  cfg.Telegram.Queue.DrainTimeout,
+		})
+	}
+
+	if cfg.Email.Host != "" {
+		emailClient, err := email.New(email.Options{
+			Host:     cfg.Email.Host,
+			Port:     cfg.Email.Port,
+			Username: cfg.Email.Username,
+			Password: cfg.Email.Password,
+			From:     cfg.Email.From,
+			Timeout:  cfg.Email.Timeout,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "create email client")
+		}
+		notifiers[model.ChannelEmail] = emailClient
+	}
+
+	if cfg.Webhook.Secret != "" {
+		webhookClient, err := webhook.New(webhook.Options{
+			Secret:               cfg.Webhook.Secret,
+			Timeout:              cfg.Webhook.Timeout,
+			MaxAttempts:          cfg.Webhook.MaxAttempts,
+			InitialBackoff:       cfg.Webhook.InitialBackoff,
+			AllowPrivateNetworks: cfg.Webhook.AllowPrivateNetworks,
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "create webhook client")
+		}
+		notifiers[model.ChannelWebhook] = webhookClient
+	}
+
+	for _, channel := range model.Channels() {
+		notifier, ok := notifiers[channel]
+		if !ok {
+			logger.Info("channel ", channel, " is not available")
+		} else if _, ok := notifier.(*console.Client); ok {
+			logger.Info("channel ", channel, " is written to the console")
+		}
+	}
+
+	return notifiers, nil
+}
+
+// Create the cache of notification histories, unset values are defaults
+func initHistoryCache(cfg *config.Config) domain.Cacher {
+	capacity := cacheCapacity
+	if cfg.HistoryCache.Capacity > 0 {
+		capacity = cfg.HistoryCache.Capacity
+	}
+	ttl := cacheTTL
+	if cfg.HistoryCache.TTL > 0 {
+		ttl = cfg.HistoryCache.TTL
+	}
+	return lru.NewLRUCache[domain.CacheKey, domain.CacheVal](capacity, lru.WithTTL(ttl), lru.WithName("history"))
+}
+
+// Connect to LOMS for the Telegram bot, nil when its address is not set
+func initOrderClient(cfg *config.Config, creds credentials.TransportCredentials) (domain.OrderClient, error) {
+	if cfg.Loms.Address == "" {
+		return nil, nil
+	}
+
+	opts := []grpc.DialOption{grpc.WithTransportCredentials(creds)}
+	if cfg.Loms.Token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(auth.TokenCredentials{Token: cfg.Loms.Token, AllowInsecure: cfg.Loms.InsecureToken}))
+	}
+	conn, err := grpc.Dial(cfg.Loms.Address, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return loms.New(conn), nil
+}
+
+// Take retries of failed messages from the config, unset values are defaults
+func retryPolicy(cfg *config.Config) kafka.RetryPolicy {
+	policy := kafka.DefaultRetryPolicy()
+	if cfg.Consumer.MaxAttempts > 0 {
+		policy.MaxAttempts = cfg.Consumer.MaxAttempts
+	}
+	if cfg.Consumer.InitialBackoff > 0 {
+		policy.InitialBackoff = cfg.Consumer.InitialBackoff
+	}
+	if cfg.Consumer.MaxBackoff > 0 {
+		policy.MaxBackoff = cfg.Consumer.MaxBackoff
+	}
+	if len(cfg.Consumer.RetryTopics) > 0 {
+		policy.RetryTopics = nil
+		for _, retry := range cfg.Consumer.RetryTopics {
+			policy.RetryTopics = append(policy.RetryTopics, kafka.RetryTopic{Topic: retry.Topic, Delay: retry.Delay})
+		}
+	}
+	if cfg.Consumer.DeadLetterTopic != "" {
+		policy.DeadLetterTopic = cfg.Consumer.DeadLetterTopic
+	}
+	return policy
+}
+
 func initConsumerGroup(brokers []string, groupID string) (sarama.ConsumerGroup, error) {
 	config := sarama.NewConfig()
 	config.Version = sarama.MaxVersion
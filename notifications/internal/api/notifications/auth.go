@@ -0,0 +1,15 @@
+// Authorization rules of the notifications API
+package notifications
+
+import "route256/libs/auth"
+
+// Users read their own history and manage their own preferences, admins act for anyone.
+// Dead letters and templates are for admins only
+var AuthPolicy = auth.Policy{
+	Default: auth.Rule{Roles: []auth.Role{auth.RoleAdmin}},
+	Methods: map[string]auth.Rule{
+		"ListDeadLetters":   {Roles: []auth.Role{auth.RoleAdmin}, RolesOnly: true},
+		"ReplayDeadLetters": {Roles: []auth.Role{auth.RoleAdmin}, RolesOnly: true},
+		"PreviewTemplate":   {Roles: []auth.Role{auth.RoleAdmin}, RolesOnly: true},
+	},
+}
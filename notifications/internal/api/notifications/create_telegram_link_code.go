@@ -0,0 +1,23 @@
+// CreateTelegramLinkCode
+package notifications
+
+import (
+	"context"
+	"route256/notifications/internal/converter/server"
+	"route256/notifications/internal/model"
+	"route256/notifications/pkg/notifications_v1"
+)
+
+// CreateTelegramLinkCode controller
+func (s *Server) CreateTelegramLinkCode(ctx context.Context, req *notifications_v1.CreateTelegramLinkCodeRequest) (*notifications_v1.TelegramLinkCode, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := s.service.CreateLinkCode(ctx, model.UserID(req.GetUser()))
+	if err != nil {
+		return nil, err
+	}
+	return server.LinkCodeToRes(code), nil
+}
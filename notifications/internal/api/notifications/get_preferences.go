@@ -0,0 +1,23 @@
+// GetPreferences
+package notifications
+
+import (
+	"context"
+	"route256/notifications/internal/converter/server"
+	"route256/notifications/internal/model"
+	"route256/notifications/pkg/notifications_v1"
+)
+
+// GetPreferences controller
+func (s *Server) GetPreferences(ctx context.Context, req *notifications_v1.GetPreferencesRequest) (*notifications_v1.Preferences, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	prefs, err := s.service.GetPreferences(ctx, model.UserID(req.GetUser()))
+	if err != nil {
+		return nil, err
+	}
+	return server.PreferencesToRes(prefs), nil
+}
@@ -0,0 +1,23 @@
+// ListDeadLetters
+package notifications
+
+import (
+	"context"
+	"route256/notifications/internal/converter/server"
+	"route256/notifications/internal/model"
+	"route256/notifications/pkg/notifications_v1"
+)
+
+// ListDeadLetters controller
+func (s *Server) ListDeadLetters(ctx context.Context, req *notifications_v1.ListDeadLettersRequest) (*notifications_v1.ListDeadLettersResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	letters, err := s.service.ListDeadLetters(ctx, model.DeadLetterID(req.GetAfterId()), uint64(req.GetLimit()), req.GetIncludeReplayed())
+	if err != nil {
+		return nil, err
+	}
+	return server.ListDeadLettersToResp(letters), nil
+}
@@ -0,0 +1,27 @@
+// PreviewTemplate
+package notifications
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"route256/notifications/pkg/notifications_v1"
+)
+
+// PreviewTemplate controller
+func (s *Server) PreviewTemplate(ctx context.Context, req *notifications_v1.PreviewTemplateRequest) (*notifications_v1.PreviewTemplateResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	text, err := s.service.PreviewTemplate(
+		ctx,
+		model.Channel(req.GetChannel()),
+		model.Locale(req.GetLocale()),
+		model.NotificationKind(req.GetKind()),
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &notifications_v1.PreviewTemplateResponse{Text: text}, nil
+}
@@ -0,0 +1,32 @@
+// ReplayDeadLetters
+package notifications
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"route256/notifications/pkg/notifications_v1"
+)
+
+// ReplayDeadLetters controller
+func (s *Server) ReplayDeadLetters(ctx context.Context, req *notifications_v1.ReplayDeadLettersRequest) (*notifications_v1.ReplayDeadLettersResponse, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	ids := make([]model.DeadLetterID, 0, len(req.GetIds()))
+	for _, id := range req.GetIds() {
+		ids = append(ids, model.DeadLetterID(id))
+	}
+
+	replayed, err := s.service.ReplayDeadLetters(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	res := &notifications_v1.ReplayDeadLettersResponse{Replayed: make([]int64, 0, len(replayed))}
+	for _, id := range replayed {
+		res.Replayed = append(res.Replayed, int64(id))
+	}
+	return res, nil
+}
@@ -0,0 +1,23 @@
+// SetPreferences
+package notifications
+
+import (
+	"context"
+	"route256/notifications/internal/converter/server"
+	"route256/notifications/pkg/notifications_v1"
+)
+
+// SetPreferences controller
+func (s *Server) SetPreferences(ctx context.Context, req *notifications_v1.SetPreferencesRequest) (*notifications_v1.Preferences, error) {
+	err := req.Validate()
+	if err != nil {
+		return nil, err
+	}
+
+	prefs := server.SetPreferencesReqToPreferences(req)
+	err = s.service.SetPreferences(ctx, prefs)
+	if err != nil {
+		return nil, err
+	}
+	return server.PreferencesToRes(prefs), nil
+}
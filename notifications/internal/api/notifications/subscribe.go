@@ -0,0 +1,28 @@
+// Subscribe
+package notifications
+
+import (
+	"route256/notifications/internal/converter/server"
+	"route256/notifications/internal/model"
+	"route256/notifications/pkg/notifications_v1"
+
+	"google.golang.org/grpc/metadata"
+)
+
+// Subscribe controller
+func (s *Server) Subscribe(req *notifications_v1.SubscribeRequest, stream notifications_v1.Notifications_SubscribeServer) error {
+	err := req.Validate()
+	if err != nil {
+		return err
+	}
+
+	// Headers tell the gateway the stream is accepted before the first message
+	err = stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+
+	return s.service.Subscribe(stream.Context(), model.UserID(req.GetUser()), model.MessageID(req.GetLastEventId()), func(message model.OrderStatusMessage) error {
+		return stream.Send(server.MessageToRes(message))
+	})
+}
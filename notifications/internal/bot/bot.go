@@ -0,0 +1,246 @@
+// Telegram bot answering commands of linked users
+package bot
+
+import (
+	"context"
+	"fmt"
+	"route256/libs/apperr"
+	"route256/notifications/internal/domain"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/logger"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	// Time to answer one command
+	commandTimeout = 10 * time.Second
+	// Orders shown by /orders
+	ordersLimit = 10
+	// Notifications shown by /history
+	historyLimit = 10
+)
+
+const helpText = `Commands:
+/link <code> - link this chat to your account, get the code from the service
+/orders - your recent orders
+/status <order id> - status of the order
+/cancel <order id> - cancel the order
+/history - your latest notifications
+/unlink - unlink this chat`
+
+// Describe the Telegram Bot API the bot works through
+type API interface {
+	// Receive messages sent to the bot until the context is done
+	Updates(ctx context.Context) (<-chan model.ChatMessage, error)
+	// Send a plain text message to the chat
+	Reply(ctx context.Context, chatID model.ChatID, text string) error
+}
+
+// Describe the business logic behind the commands
+type Service interface {
+	LinkChat(ctx context.Context, chatID model.ChatID, code string) (model.UserID, error)
+	UnlinkChat(ctx context.Context, chatID model.ChatID) error
+	ChatUser(ctx context.Context, chatID model.ChatID) (model.UserID, error)
+	RecentOrders(ctx context.Context, userID model.UserID, limit uint64) ([]model.Order, error)
+	UserOrder(ctx context.Context, userID model.UserID, orderID model.OrderID) (model.Order, error)
+	CancelUserOrder(ctx context.Context, userID model.UserID, orderID model.OrderID) error
+	GetHistory(ctx context.Context, filter model.HistoryFilter) (model.HistoryPage, error)
+}
+
+// Answer commands sent to the bot
+type Bot struct {
+	api     API
+	service Service
+}
+
+// Create a bot
+func New(api API, service Service) *Bot {
+	return &Bot{api: api, service: service}
+}
+
+// Answer messages one by one until the context is done
+func (b *Bot) Run(ctx context.Context) error {
+	updates, err := b.api.Updates(ctx)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case message, ok := <-updates:
+			if !ok {
+				return nil
+			}
+			b.handle(ctx, message)
+		}
+	}
+}
+
+// Answer one message, the reply waits for its turn in the chat after the command is done
+func (b *Bot) handle(ctx context.Context, message model.ChatMessage) {
+	commandCtx, cancel := context.WithTimeout(ctx, commandTimeout)
+	reply := b.answer(commandCtx, message)
+	cancel()
+
+	err := b.api.Reply(ctx, message.ChatID, reply)
+	if err != nil {
+		logger.Errorf(ctx, "bot", "reply to chat %d: %v", message.ChatID, err)
+	}
+}
+
+// Run the command of the message and describe its result
+func (b *Bot) answer(ctx context.Context, message model.ChatMessage) string {
+	command, args := parseCommand(message.Text)
+
+	switch command {
+	case "/start", "/link":
+		// Deep links open the bot with /start <code>
+		if len(args) == 0 {
+			return helpText
+		}
+		_, err := b.service.LinkChat(ctx, message.ChatID, args[0])
+		if err != nil {
+			return b.failure(ctx, err)
+		}
+		return "This chat is linked to your account. Send /orders to see your orders."
+	case "/unlink":
+		err := b.service.UnlinkChat(ctx, message.ChatID)
+		if err != nil {
+			return b.failure(ctx, err)
+		}
+		return "This chat is unlinked."
+	case "/orders":
+		return b.withUser(ctx, message.ChatID, func(userID model.UserID) (string, error) {
+			return b.orders(ctx, userID)
+		})
+	case "/status":
+		return b.withOrder(ctx, message.ChatID, command, args, func(userID model.UserID, orderID model.OrderID) (string, error) {
+			order, err := b.service.UserOrder(ctx, userID, orderID)
+			if err != nil {
+				return "", err
+			}
+			return formatOrder(order), nil
+		})
+	case "/cancel":
+		return b.withOrder(ctx, message.ChatID, command, args, func(userID model.UserID, orderID model.OrderID) (string, error) {
+			err := b.service.CancelUserOrder(ctx, userID, orderID)
+			if err != nil {
+				return "", err
+			}
+			return fmt.Sprintf("Order %d is cancelled.", orderID), nil
+		})
+	case "/history":
+		return b.withUser(ctx, message.ChatID, func(userID model.UserID) (string, error) {
+			return b.history(ctx, userID)
+		})
+	default:
+		return helpText
+	}
+}
+
+// Run the command for the user the chat is linked to
+func (b *Bot) withUser(ctx context.Context, chatID model.ChatID, command func(userID model.UserID) (string, error)) string {
+	userID, err := b.service.ChatUser(ctx, chatID)
+	if err != nil {
+		return b.failure(ctx, err)
+	}
+
+	reply, err := command(userID)
+	if err != nil {
+		return b.failure(ctx, err)
+	}
+	return reply
+}
+
+// Run the command about the order in the arguments
+func (b *Bot) withOrder(ctx context.Context, chatID model.ChatID, name string, args []string, command func(userID model.UserID, orderID model.OrderID) (string, error)) string {
+	if len(args) == 0 {
+		return fmt.Sprintf("Send the order id, e.g. %s 42", name)
+	}
+	orderID, err := strconv.ParseInt(args[0], 10, 64)
+	if err != nil || orderID <= 0 {
+		return fmt.Sprintf("%q is not an order id.", args[0])
+	}
+
+	return b.withUser(ctx, chatID, func(userID model.UserID) (string, error) {
+		return command(userID, model.OrderID(orderID))
+	})
+}
+
+// List the user's recent orders
+func (b *Bot) orders(ctx context.Context, userID model.UserID) (string, error) {
+	orders, err := b.service.RecentOrders(ctx, userID, ordersLimit)
+	if err != nil {
+		return "", err
+	}
+	if len(orders) == 0 {
+		return "You have no orders yet.", nil
+	}
+
+	lines := make([]string, 0, len(orders))
+	for _, order := range orders {
+		lines = append(lines, formatOrder(order))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// List the user's latest notifications
+func (b *Bot) history(ctx context.Context, userID model.UserID) (string, error) {
+	page, err := b.service.GetHistory(ctx, model.HistoryFilter{UserID: userID, Limit: historyLimit, Newest: true})
+	if err != nil {
+		return "", err
+	}
+	if len(page.Messages) == 0 {
+		return "You have no notifications yet.", nil
+	}
+
+	lines := make([]string, 0, len(page.Messages))
+	for _, message := range page.Messages {
+		lines = append(lines, fmt.Sprintf("%s order %d: %s", message.CreatedAt.Format("2006-01-02 15:04"), message.OrderID, message.Status))
+	}
+	return strings.Join(lines, "\n"), nil
+}
+
+// Describe an error to the user, unexpected errors are logged
+func (b *Bot) failure(ctx context.Context, err error) string {
+	if errors.Is(err, domain.ErrChatNotLinked) {
+		return "This chat is not linked to an account. Get a code from the service and send /link <code>."
+	}
+
+	switch apperr.KindOf(err) {
+	case apperr.NotFound, apperr.InvalidArgument, apperr.InvalidState:
+		return capitalize(err.Error()) + "."
+	default:
+		logger.Errorf(ctx, "bot", "answer command: %v", err)
+		return "Something went wrong, try again later."
+	}
+}
+
+// Format the order for a reply
+func formatOrder(order model.Order) string {
+	return fmt.Sprintf("Order %d: %s, %d items, %s", order.ID, order.Status, order.ItemCount, order.Total)
+}
+
+// Split the text into a command and its arguments, the bot name of /command@bot is dropped
+func parseCommand(text string) (string, []string) {
+	fields := strings.Fields(text)
+	if len(fields) == 0 {
+		return "", nil
+	}
+
+	command, _, _ := strings.Cut(strings.ToLower(fields[0]), "@")
+	return command, fields[1:]
+}
+
+func capitalize(s string) string {
+	if s == "" {
+		return s
+	}
+	return strings.ToUpper(s[:1]) + s[1:]
+}
@@ -0,0 +1,165 @@
+package bot
+
+import (
+	"context"
+	"route256/notifications/internal/domain"
+	"route256/notifications/internal/model"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Serve the messages as updates and record replies
+type fakeAPI struct {
+	messages []model.ChatMessage
+	mu       sync.Mutex
+	replies  map[model.ChatID][]string
+}
+
+func newFakeAPI(messages ...model.ChatMessage) *fakeAPI {
+	return &fakeAPI{messages: messages, replies: make(map[model.ChatID][]string)}
+}
+
+func (a *fakeAPI) Updates(_ context.Context) (<-chan model.ChatMessage, error) {
+	updates := make(chan model.ChatMessage, len(a.messages))
+	for _, message := range a.messages {
+		updates <- message
+	}
+	close(updates)
+	return updates, nil
+}
+
+func (a *fakeAPI) Reply(_ context.Context, chatID model.ChatID, text string) error {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	a.replies[chatID] = append(a.replies[chatID], text)
+	return nil
+}
+
+// Chat 1 is linked to user 7 who has order 5, code CODE links a chat to user 7
+type fakeService struct {
+	chats     map[model.ChatID]model.UserID
+	cancelled []model.OrderID
+	filter    model.HistoryFilter
+}
+
+func newFakeService() *fakeService {
+	return &fakeService{chats: map[model.ChatID]model.UserID{1: 7}}
+}
+
+func (s *fakeService) LinkChat(_ context.Context, chatID model.ChatID, code string) (model.UserID, error) {
+	if code != "CODE" {
+		return 0, domain.ErrInvalidLinkCode
+	}
+	s.chats[chatID] = 7
+	return 7, nil
+}
+
+func (s *fakeService) UnlinkChat(_ context.Context, chatID model.ChatID) error {
+	delete(s.chats, chatID)
+	return nil
+}
+
+func (s *fakeService) ChatUser(_ context.Context, chatID model.ChatID) (model.UserID, error) {
+	userID, ok := s.chats[chatID]
+	if !ok {
+		return 0, domain.ErrChatNotLinked
+	}
+	return userID, nil
+}
+
+func (s *fakeService) RecentOrders(_ context.Context, userID model.UserID, _ uint64) ([]model.Order, error) {
+	return []model.Order{{ID: 5, UserID: userID, Status: model.PaidStatus, ItemCount: 2, Total: model.Money{Amount: 150050, Currency: "RUB"}}}, nil
+}
+
+func (s *fakeService) UserOrder(_ context.Context, userID model.UserID, orderID model.OrderID) (model.Order, error) {
+	if orderID != 5 {
+		return model.Order{}, domain.ErrOrderNotFound
+	}
+	return model.Order{ID: 5, UserID: userID, Status: model.WaitStatus, ItemCount: 1, Total: model.Money{Amount: 1000, Currency: "RUB"}}, nil
+}
+
+func (s *fakeService) CancelUserOrder(_ context.Context, _ model.UserID, orderID model.OrderID) error {
+	if orderID != 5 {
+		return domain.ErrOrderNotFound
+	}
+	s.cancelled = append(s.cancelled, orderID)
+	return nil
+}
+
+func (s *fakeService) GetHistory(_ context.Context, filter model.HistoryFilter) (model.HistoryPage, error) {
+	s.filter = filter
+	return model.HistoryPage{Messages: []model.OrderStatusMessage{
+		{OrderID: 5, Status: model.CreatedStatus, CreatedAt: time.Date(2023, 9, 1, 10, 30, 0, 0, time.UTC)},
+	}}, nil
+}
+
+func TestBot_Run_Commands_Answered(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	api := newFakeAPI(
+		model.ChatMessage{ChatID: 1, Text: "/orders"},
+		model.ChatMessage{ChatID: 1, Text: "/status@route256_bot 5"},
+		model.ChatMessage{ChatID: 1, Text: "/cancel 5"},
+		model.ChatMessage{ChatID: 1, Text: "/history"},
+	)
+	service := newFakeService()
+
+	// Act
+	err := New(api, service).Run(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{
+		"Order 5: payed, 2 items, 1500.50 RUB",
+		"Order 5: awaiting payment, 1 items, 10.00 RUB",
+		"Order 5 is cancelled.",
+		"2023-09-01 10:30 order 5: new",
+	}, api.replies[1])
+	require.Equal(t, []model.OrderID{5}, service.cancelled)
+	require.True(t, service.filter.Newest)
+}
+
+func TestBot_Run_UnlinkedChat_AskedToLink(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	api := newFakeAPI(
+		model.ChatMessage{ChatID: 2, Text: "/orders"},
+		model.ChatMessage{ChatID: 2, Text: "/link WRONG"},
+		model.ChatMessage{ChatID: 2, Text: "/start CODE"},
+		model.ChatMessage{ChatID: 2, Text: "/status 6"},
+	)
+	service := newFakeService()
+
+	// Act
+	err := New(api, service).Run(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	replies := api.replies[2]
+	require.Len(t, replies, 4)
+	require.Contains(t, replies[0], "/link <code>")
+	require.Equal(t, "Link code is invalid or expired.", replies[1])
+	require.Contains(t, replies[2], "linked")
+	require.Equal(t, "Order not found.", replies[3])
+}
+
+func TestBot_Run_InvalidArguments_Help(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	api := newFakeAPI(
+		model.ChatMessage{ChatID: 1, Text: "hello"},
+		model.ChatMessage{ChatID: 1, Text: "/cancel"},
+		model.ChatMessage{ChatID: 1, Text: "/status abc"},
+	)
+
+	// Act
+	err := New(api, newFakeService()).Run(context.Background())
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{helpText, "Send the order id, e.g. /cancel 42", `"abc" is not an order id.`}, api.replies[1])
+}
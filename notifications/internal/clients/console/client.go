@@ -0,0 +1,50 @@
+// Notifier writing messages to the console or a file for local development
+package console
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Define console client
+type Client struct {
+	channel string
+
+	mu sync.Mutex
+	w  io.Writer
+}
+
+// Create a client writing messages of the channel to the writer
+func New(channel string, w io.Writer) *Client {
+	return &Client{channel: channel, w: w}
+}
+
+// Open the file for appending messages, an empty path is stdout
+func OpenFile(path string) (io.WriteCloser, error) {
+	if path == "" {
+		return os.Stdout, nil
+	}
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0o644)
+	if err != nil {
+		return nil, errors.Wrap(err, "open notifications file")
+	}
+	return f, nil
+}
+
+// Write message with the channel and the address
+func (c *Client) SendMessage(_ context.Context, address string, message string) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	_, err := fmt.Fprintf(c.w, "%s [%s %s] %s\n", time.Now().Format(time.RFC3339), c.channel, address, message)
+	if err != nil {
+		return errors.Wrap(err, "write notification")
+	}
+	return nil
+}
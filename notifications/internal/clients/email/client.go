@@ -0,0 +1,143 @@
+// SMTP email notifier
+package email
+
+import (
+	"context"
+	"crypto/tls"
+	"fmt"
+	"net"
+	"net/smtp"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	defaultTimeout = 10 * time.Second
+	subject        = "Route256 notification"
+)
+
+// Describe the SMTP server and the sender of emails
+type Options struct {
+	Host string
+	Port int
+	// Empty username sends without authentication, e.g. to a local SMTP stand-in
+	Username string
+	Password string
+	From     string
+	// Limit of one send including connecting
+	Timeout time.Duration
+}
+
+// Define email client
+type Client struct {
+	opts Options
+}
+
+// Create a client sending emails through the SMTP server
+func New(opts Options) (*Client, error) {
+	if opts.Host == "" || opts.Port == 0 {
+		return nil, errors.New("smtp host and port are required")
+	}
+	if opts.From == "" {
+		return nil, errors.New("sender address is required")
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+	return &Client{opts: opts}, nil
+}
+
+// Send HTML message to the email address. STARTTLS is used when the server offers it,
+// the send stops at the timeout or when the context is done
+func (c *Client) SendMessage(ctx context.Context, address string, message string) error {
+	if address == "" {
+		return errors.New("email address is required")
+	}
+
+	addr := net.JoinHostPort(c.opts.Host, fmt.Sprint(c.opts.Port))
+	ctx, cancel := context.WithTimeout(ctx, c.opts.Timeout)
+	defer cancel()
+
+	dialer := net.Dialer{}
+	conn, err := dialer.DialContext(ctx, "tcp", addr)
+	if err != nil {
+		return errors.Wrap(err, "connect to smtp server")
+	}
+	defer conn.Close()
+
+	deadline, _ := ctx.Deadline()
+	err = conn.SetDeadline(deadline)
+	if err != nil {
+		return errors.Wrap(err, "set smtp deadline")
+	}
+	// Cancellation of the context interrupts the session
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			_ = conn.SetDeadline(time.Now())
+		case <-done:
+		}
+	}()
+
+	client, err := smtp.NewClient(conn, c.opts.Host)
+	if err != nil {
+		return errors.Wrap(err, "start smtp session")
+	}
+	defer client.Close()
+
+	if ok, _ := client.Extension("STARTTLS"); ok {
+		err = client.StartTLS(&tls.Config{ServerName: c.opts.Host, MinVersion: tls.VersionTLS12})
+		if err != nil {
+			return errors.Wrap(err, "starttls")
+		}
+	}
+	if c.opts.Username != "" {
+		err = client.Auth(smtp.PlainAuth("", c.opts.Username, c.opts.Password, c.opts.Host))
+		if err != nil {
+			return errors.Wrap(err, "smtp auth")
+		}
+	}
+
+	err = client.Mail(c.opts.From)
+	if err != nil {
+		return errors.Wrap(err, "smtp mail from")
+	}
+	err = client.Rcpt(address)
+	if err != nil {
+		return errors.Wrap(err, "smtp rcpt to")
+	}
+
+	w, err := client.Data()
+	if err != nil {
+		return errors.Wrap(err, "smtp data")
+	}
+	_, err = w.Write(c.buildMessage(address, message))
+	if err != nil {
+		return errors.Wrap(err, "write email")
+	}
+	err = w.Close()
+	if err != nil {
+		return errors.Wrap(err, "send email")
+	}
+
+	return client.Quit()
+}
+
+// Build an HTML email with CRLF line endings
+func (c *Client) buildMessage(to string, body string) []byte {
+	var b strings.Builder
+	b.WriteString("From: " + c.opts.From + "\r\n")
+	b.WriteString("To: " + to + "\r\n")
+	b.WriteString("Subject: " + subject + "\r\n")
+	b.WriteString("Date: " + time.Now().Format(time.RFC1123Z) + "\r\n")
+	b.WriteString("MIME-Version: 1.0\r\n")
+	b.WriteString("Content-Type: text/html; charset=UTF-8\r\n")
+	b.WriteString("\r\n")
+	b.WriteString(strings.ReplaceAll(strings.ReplaceAll(body, "\r\n", "\n"), "\n", "\r\n"))
+	b.WriteString("\r\n")
+	return []byte(b.String())
+}
@@ -0,0 +1,114 @@
+package email
+
+import (
+	"bufio"
+	"context"
+	"net"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestClient_SendMessage_LocalServer_Delivered(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	host, port, received := startServer(t)
+	client, err := New(Options{Host: host, Port: port, From: "notifications@route256.local", Timeout: time.Second})
+	require.NoError(t, err)
+
+	// Act
+	err = client.SendMessage(context.Background(), "user@example.com", "order 1 is payed")
+
+	// Assert
+	require.NoError(t, err)
+	mail := <-received
+	require.Contains(t, mail, "MAIL FROM:<notifications@route256.local>")
+	require.Contains(t, mail, "RCPT TO:<user@example.com>")
+	require.Contains(t, mail, "To: user@example.com\r\n")
+	require.Contains(t, mail, "\r\n\r\norder 1 is payed\r\n")
+}
+
+func TestClient_SendMessage_NoServer_Error(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	port := lis.Addr().(*net.TCPAddr).Port
+	require.NoError(t, lis.Close())
+	client, err := New(Options{Host: "127.0.0.1", Port: port, From: "notifications@route256.local", Timeout: time.Second})
+	require.NoError(t, err)
+
+	// Act
+	err = client.SendMessage(context.Background(), "user@example.com", "order 1 is payed")
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestNew_MissingOptions_Error(t *testing.T) {
+	t.Parallel()
+
+	_, err := New(Options{Port: 25, From: "a@b.c"})
+	require.Error(t, err)
+	_, err = New(Options{Host: "localhost", Port: 25})
+	require.Error(t, err)
+	_, err = New(Options{Host: "localhost", Port: 25, From: "a@b.c"})
+	require.NoError(t, err)
+}
+
+// Start an SMTP stand-in accepting one email, the session transcript is sent to the channel
+func startServer(t *testing.T) (string, int, <-chan string) {
+	t.Helper()
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = lis.Close() })
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := lis.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		var transcript strings.Builder
+		r := bufio.NewReader(conn)
+		reply := func(line string) {
+			_, _ = conn.Write([]byte(line + "\r\n"))
+		}
+
+		reply("220 localhost ready")
+		inData := false
+		for {
+			line, err := r.ReadString('\n')
+			if err != nil {
+				return
+			}
+			transcript.WriteString(line)
+
+			switch {
+			case inData:
+				if line == ".\r\n" {
+					inData = false
+					reply("250 queued")
+				}
+			case strings.HasPrefix(line, "EHLO"), strings.HasPrefix(line, "HELO"):
+				reply("250 localhost")
+			case strings.HasPrefix(line, "DATA"):
+				inData = true
+				reply("354 end with .")
+			case strings.HasPrefix(line, "QUIT"):
+				reply("221 bye")
+				received <- transcript.String()
+				return
+			default:
+				reply("250 ok")
+			}
+		}
+	}()
+
+	addr := lis.Addr().(*net.TCPAddr)
+	return addr.IP.String(), addr.Port, received
+}
@@ -0,0 +1,78 @@
+// Client to interact with the external service loms
+package loms
+
+import (
+	"context"
+	"route256/libs/apperr"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/tracer"
+	"route256/notifications/pkg/loms_v1"
+
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var (
+	ErrOrderNotFound     = apperr.New(apperr.NotFound, "order not found")
+	ErrOrderNotCancelled = apperr.New(apperr.InvalidState, "order can not be cancelled")
+)
+
+// Implement interaction with the loms service
+type Client struct {
+	client loms_v1.LomsClient
+}
+
+// Create a new client instance over a connection shared by all requests
+func New(conn grpc.ClientConnInterface) *Client {
+	return &Client{client: loms_v1.NewLomsClient(conn)}
+}
+
+// Get the order from the service loms
+func (c *Client) GetOrder(ctx context.Context, orderID model.OrderID) (model.Order, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "clients/loms/get_order")
+	defer span.Finish()
+
+	resp, err := c.client.ListOrder(ctx, &loms_v1.ListOrderRequest{OrderID: int64(orderID)})
+	if status.Code(err) == codes.NotFound {
+		return model.Order{}, ErrOrderNotFound
+	}
+	if err != nil {
+		return model.Order{}, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "send request error"))
+	}
+
+	order := model.Order{
+		ID:     orderID,
+		UserID: model.UserID(resp.GetUser()),
+		Status: model.OrderStatus(resp.GetStatus()),
+		Total: model.Money{
+			Amount:   resp.GetTotal().GetAmount(),
+			Currency: resp.GetTotal().GetCurrency(),
+		},
+	}
+	for _, item := range resp.GetItems() {
+		order.ItemCount += item.GetCount()
+	}
+
+	return order, nil
+}
+
+// Cancel the order in the service loms
+func (c *Client) CancelOrder(ctx context.Context, orderID model.OrderID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "clients/loms/cancel_order")
+	defer span.Finish()
+
+	_, err := c.client.CancelOrder(ctx, &loms_v1.CancelOrderRequest{OrderID: int64(orderID)})
+	switch status.Code(err) {
+	case codes.OK:
+		return nil
+	case codes.NotFound:
+		return ErrOrderNotFound
+	case codes.FailedPrecondition:
+		return ErrOrderNotCancelled
+	default:
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "send request error"))
+	}
+}
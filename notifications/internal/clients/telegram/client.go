@@ -1,32 +1,37 @@
 package telegram
 
 import (
+	"strconv"
+
 	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+	"github.com/pkg/errors"
 )
 
 type Client struct {
 	bot *tgbotapi.BotAPI
-	ChatID int64
 }
 
 // Create a client for Telegram
-func New(apiKey string, chatId int64) (*Client, error) {
+func New(apiKey string) (*Client, error) {
 	bot, err := tgbotapi.NewBotAPI(apiKey)
 	if err != nil {
 		return nil, err
 	}
 
-
 	return &Client{
 		bot: bot,
-		ChatID: chatId,
 	}, nil
 }
 
-// Send message to chat
-func (c *Client) SendMessage(message string) error {
-	msg := tgbotapi.NewMessage(c.ChatID, message)
-	msg.ParseMode = "Markdown"
-	_, err := c.bot.Send(msg)
+// Send MarkdownV2 message to the chat with the id
+func (c *Client) SendMessage(address string, message string) error {
+	chatID, err := strconv.ParseInt(address, 10, 64)
+	if err != nil {
+		return errors.Wrapf(err, "invalid chat id %q", address)
+	}
+
+	msg := tgbotapi.NewMessage(chatID, message)
+	msg.ParseMode = "MarkdownV2"
+	_, err = c.bot.Send(msg)
 	return err
-}
\ No newline at end of file
+}
@@ -0,0 +1,51 @@
+// Telegram send queue metrics
+package telegram
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	queueDepth = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "notifications",
+			Subsystem: "telegram",
+			Name:      "queue_depth",
+			Help:      "Number of messages waiting in the send queue",
+		},
+	)
+	sent = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "telegram",
+			Name:      "messages_total",
+			Help:      "Number of messages taken by the send queue by result: sent, failed, cancelled by the caller or rejected when the queue is full",
+		},
+		[]string{"result"},
+	)
+	throttled = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "telegram",
+			Name:      "throttled_total",
+			Help:      "Number of sends answered with 429 Too Many Requests",
+		},
+	)
+	retryAfterSeconds = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "telegram",
+			Name:      "retry_after_seconds_total",
+			Help:      "Seconds the bot was paused as asked by 429 responses",
+		},
+	)
+	digestMerged = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "telegram",
+			Name:      "digest_merged_total",
+			Help:      "Number of messages merged into a queued digest",
+		},
+	)
+)
@@ -0,0 +1,420 @@
+package telegram
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/ratelimit"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+	"github.com/pkg/errors"
+)
+
+// Telegram allows a bot about 30 messages per second overall and about one per second in a chat
+const (
+	defaultGlobalRPS     = 25
+	defaultChatRPS       = 1
+	defaultChatBurst     = 3
+	defaultQueueCapacity = 1000
+	defaultMaxRetries    = 3
+	defaultMaxRetryAfter = 30 * time.Second
+	defaultDrainTimeout  = 30 * time.Second
+)
+
+// Separator of the messages merged into a digest
+const digestSeparator = "\n\n"
+
+var (
+	ErrQueueFull   = errors.New("telegram send queue is full")
+	ErrQueueClosed = errors.New("telegram send queue is closed")
+)
+
+// Describe the client the queue sends messages with
+type sender interface {
+	SendMessage(address string, message string) error
+	Reply(chatID model.ChatID, text string) error
+}
+
+// Options of the send queue, defaults are used for empty values
+type QueueOptions struct {
+	// Messages per second of the bot and the number sent at once
+	GlobalRPS   float64
+	GlobalBurst int
+	// Messages per second of one chat and the number sent at once
+	ChatRPS   float64
+	ChatBurst int
+	// Number of queued messages, sending fails with ErrQueueFull above it
+	Capacity int
+	// Number of times a message answered with 429 Too Many Requests is sent again after retry_after
+	MaxRetries int
+	// Longest retry_after the message waits for, a longer one fails the message at once
+	MaxRetryAfter time.Duration
+	// Messages with the same digest key queued within the window are sent as one, digests are off when 0
+	DigestWindow time.Duration
+	// Time Close keeps sending queued messages, the ones left fail with ErrQueueClosed
+	DrainTimeout time.Duration
+}
+
+// Queue of messages in front of the client that keeps sending under the global and per-chat limits.
+// Every chat is sent in order by its own goroutine, a digest waiting for its window lets later
+// messages of the chat go first. A 429 response pauses the chat and the whole bot for retry_after
+type Queue struct {
+	client  *Client
+	sender  sender
+	opts    QueueOptions
+	global  *ratelimit.Limiter
+	chats   *ratelimit.KeyedLimiter
+	ctx     context.Context
+	cancel  context.CancelFunc
+	wg      sync.WaitGroup
+	mu      sync.Mutex
+	pending map[string]*chatQueue
+	size    int
+	// New messages are not taken, queued ones are sent without waiting for digest windows
+	closed bool
+}
+
+// Messages of one chat waiting to be sent
+type chatQueue struct {
+	items []*queued
+	// Wakes the chat's goroutine waiting for a digest window when a message is queued
+	wake chan struct{}
+}
+
+// Message waiting in the queue of its chat
+type queued struct {
+	key     string
+	texts   []string
+	readyAt time.Time
+	// Sends the text with the client
+	send func(text string) error
+	// Receives the result of a message the caller waits for, nil for digests
+	done chan error
+	// Get the result of a digest, one for every merged message
+	acks []func(err error)
+}
+
+// Create a send queue in front of the client
+func NewQueue(client *Client, opts QueueOptions) *Queue {
+	q := newQueue(client, opts)
+	q.client = client
+	return q
+}
+
+func newQueue(sender sender, opts QueueOptions) *Queue {
+	if opts.GlobalRPS <= 0 {
+		opts.GlobalRPS = defaultGlobalRPS
+	}
+	if opts.GlobalBurst <= 0 {
+		opts.GlobalBurst = int(opts.GlobalRPS)
+	}
+	if opts.ChatRPS <= 0 {
+		opts.ChatRPS = defaultChatRPS
+	}
+	if opts.ChatBurst <= 0 {
+		opts.ChatBurst = defaultChatBurst
+	}
+	if opts.Capacity <= 0 {
+		opts.Capacity = defaultQueueCapacity
+	}
+	if opts.MaxRetries <= 0 {
+		opts.MaxRetries = defaultMaxRetries
+	}
+	if opts.MaxRetryAfter <= 0 {
+		opts.MaxRetryAfter = defaultMaxRetryAfter
+	}
+	if opts.DrainTimeout <= 0 {
+		opts.DrainTimeout = defaultDrainTimeout
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	return &Queue{
+		sender:  sender,
+		opts:    opts,
+		global:  ratelimit.New("telegram", opts.GlobalRPS, opts.GlobalBurst),
+		chats:   ratelimit.NewKeyed("telegram_chat", opts.ChatRPS, opts.ChatBurst),
+		ctx:     ctx,
+		cancel:  cancel,
+		pending: make(map[string]*chatQueue),
+	}
+}
+
+// Queue the MarkdownV2 message and wait until it is sent or the context is done
+func (q *Queue) SendMessage(ctx context.Context, address string, message string) error {
+	return q.wait(ctx, address, &queued{
+		texts:   []string{message},
+		readyAt: time.Now(),
+		send:    func(text string) error { return q.sender.SendMessage(address, text) },
+		done:    make(chan error, 1),
+	})
+}
+
+// Queue the message to be merged with the messages of the same key queued within the digest window.
+// The message is not waited for, sent is called with the result once its digest is sent.
+// Without the window it is SendMessage
+func (q *Queue) SendDigest(ctx context.Context, address string, key string, message string, sent func(err error)) error {
+	if q.opts.DigestWindow <= 0 {
+		err := q.SendMessage(ctx, address, message)
+		if err != nil {
+			return err
+		}
+		sent(nil)
+		return nil
+	}
+
+	return q.enqueue(address, &queued{
+		key:     key,
+		texts:   []string{message},
+		readyAt: time.Now().Add(q.opts.DigestWindow),
+		send:    func(text string) error { return q.sender.SendMessage(address, text) },
+		acks:    []func(err error){sent},
+	})
+}
+
+// Get the longest time a message waits in the queue for its digest: the window
+// and the retry_after pauses of its retries
+func (q *Queue) MaxDigestDelay() time.Duration {
+	return q.opts.DigestWindow + time.Duration(q.opts.MaxRetries)*q.opts.MaxRetryAfter
+}
+
+// Queue a plain text answer to the chat and wait until it is sent or the context is done
+func (q *Queue) Reply(ctx context.Context, chatID model.ChatID, text string) error {
+	return q.wait(ctx, strconv.FormatInt(int64(chatID), 10), &queued{
+		texts:   []string{text},
+		readyAt: time.Now(),
+		send:    func(text string) error { return q.sender.Reply(chatID, text) },
+		done:    make(chan error, 1),
+	})
+}
+
+// Receive messages sent to the bot until the context is done
+func (q *Queue) Updates(ctx context.Context) (<-chan model.ChatMessage, error) {
+	return q.client.Updates(ctx)
+}
+
+// Stop taking messages and send the queued ones, digests are sent without waiting for their windows.
+// Messages still queued after the drain timeout fail with ErrQueueClosed
+func (q *Queue) Close() {
+	q.mu.Lock()
+	q.closed = true
+	for _, chat := range q.pending {
+		select {
+		case chat.wake <- struct{}{}:
+		default:
+		}
+	}
+	q.mu.Unlock()
+
+	timer := time.AfterFunc(q.opts.DrainTimeout, q.cancel)
+	defer timer.Stop()
+	q.wg.Wait()
+	q.cancel()
+}
+
+// Queue the message and wait for its result. A message the caller stops waiting for
+// is dropped if it is still queued, so it is not sent after the caller gave up
+func (q *Queue) wait(ctx context.Context, address string, item *queued) error {
+	err := q.enqueue(address, item)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err = <-item.done:
+		return err
+	case <-ctx.Done():
+		q.remove(address, item)
+		return ctx.Err()
+	}
+}
+
+// Put the message at the end of its chat's queue, start sending the chat if it is idle.
+// A digest is merged into the queued digest of the same key instead
+func (q *Queue) enqueue(address string, item *queued) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if q.closed || q.ctx.Err() != nil {
+		return ErrQueueClosed
+	}
+	chat, running := q.pending[address]
+	if running && item.done == nil {
+		for _, other := range chat.items {
+			if other.done == nil && other.key == item.key {
+				other.texts = append(other.texts, item.texts...)
+				other.acks = append(other.acks, item.acks...)
+				digestMerged.Inc()
+				return nil
+			}
+		}
+	}
+	if q.size >= q.opts.Capacity {
+		sent.WithLabelValues("rejected").Inc()
+		return ErrQueueFull
+	}
+
+	if !running {
+		chat = &chatQueue{wake: make(chan struct{}, 1)}
+		q.pending[address] = chat
+		q.wg.Add(1)
+		go q.drain(address, chat)
+	}
+	chat.items = append(chat.items, item)
+	q.size++
+	queueDepth.Inc()
+
+	select {
+	case chat.wake <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+// Take the message out of its chat's queue if it is not being sent yet
+func (q *Queue) remove(address string, item *queued) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	chat, ok := q.pending[address]
+	if !ok {
+		return
+	}
+	for i, other := range chat.items {
+		if other == item {
+			chat.items = append(chat.items[:i], chat.items[i+1:]...)
+			q.size--
+			queueDepth.Dec()
+			sent.WithLabelValues("cancelled").Inc()
+			return
+		}
+	}
+}
+
+// Send the queued messages of the chat until none are left.
+// The first message that is ready goes first, a digest waits for the rest of its window
+// unless the queue is closing
+func (q *Queue) drain(address string, chat *chatQueue) {
+	defer q.wg.Done()
+
+	for {
+		q.mu.Lock()
+		if len(chat.items) == 0 {
+			delete(q.pending, address)
+			q.mu.Unlock()
+			return
+		}
+		if q.ctx.Err() != nil {
+			items := chat.items
+			delete(q.pending, address)
+			q.size -= len(items)
+			queueDepth.Sub(float64(len(items)))
+			q.mu.Unlock()
+			for _, item := range items {
+				q.finish(item, ErrQueueClosed)
+			}
+			return
+		}
+
+		item, wait := chat.next(time.Now(), q.closed)
+		if item == nil {
+			q.mu.Unlock()
+			q.sleep(wait, chat.wake)
+			continue
+		}
+		q.size--
+		queueDepth.Dec()
+		q.mu.Unlock()
+
+		q.finish(item, q.send(address, item))
+	}
+}
+
+// Take the first message that is ready, otherwise return how long until one is.
+// On flush every message is ready
+func (c *chatQueue) next(now time.Time, flush bool) (*queued, time.Duration) {
+	var wait time.Duration
+	for i, item := range c.items {
+		until := item.readyAt.Sub(now)
+		if until <= 0 || flush {
+			c.items = append(c.items[:i], c.items[i+1:]...)
+			return item, 0
+		}
+		if wait == 0 || until < wait {
+			wait = until
+		}
+	}
+	return nil, wait
+}
+
+// Send the message under the limits, a 429 response pauses the chat
+// and the whole bot for retry_after and the message is sent again
+func (q *Queue) send(address string, item *queued) error {
+	text := strings.Join(item.texts, digestSeparator)
+	for attempt := 0; ; attempt++ {
+		if err := q.chats.Wait(q.ctx, address); err != nil {
+			return ErrQueueClosed
+		}
+		if err := q.global.Wait(q.ctx); err != nil {
+			return ErrQueueClosed
+		}
+
+		err := item.send(text)
+		pause := retryAfter(err)
+		if pause == 0 {
+			return err
+		}
+
+		throttled.Inc()
+		if attempt >= q.opts.MaxRetries {
+			return errors.Wrapf(err, "throttled after %d retries", attempt)
+		}
+		if pause > q.opts.MaxRetryAfter {
+			return errors.Wrapf(err, "retry_after %s is longer than %s", pause, q.opts.MaxRetryAfter)
+		}
+		retryAfterSeconds.Add(pause.Seconds())
+		// Telegram throttles the bot as a whole, other chats wait as well
+		q.chats.Pause(address, pause)
+		q.global.Pause(pause)
+	}
+}
+
+// Report the result of the message to the caller or to every message merged into the digest
+func (q *Queue) finish(item *queued, err error) {
+	if err != nil {
+		sent.WithLabelValues("failed").Inc()
+	} else {
+		sent.WithLabelValues("sent").Inc()
+	}
+
+	if item.done != nil {
+		item.done <- err
+		return
+	}
+	for _, ack := range item.acks {
+		ack(err)
+	}
+}
+
+// Wait for the duration, a newly queued message or the queue closing
+func (q *Queue) sleep(d time.Duration, wake <-chan struct{}) {
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+	case <-wake:
+	case <-q.ctx.Done():
+	}
+}
+
+// Take the pause Telegram asks for in a 429 response, 0 for other results
+func retryAfter(err error) time.Duration {
+	var apiErr tgbotapi.Error
+	if errors.As(err, &apiErr) && apiErr.RetryAfter > 0 {
+		return time.Duration(apiErr.RetryAfter) * time.Second
+	}
+	return 0
+}
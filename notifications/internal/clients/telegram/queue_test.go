@@ -0,0 +1,373 @@
+package telegram
+
+import (
+	"context"
+	"fmt"
+	"route256/notifications/internal/model"
+	"sync"
+	"testing"
+	"time"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// Sender recording sent messages and answering with the queued errors first.
+// With a gate every send waits until the gate is opened
+type fakeSender struct {
+	mu       sync.Mutex
+	errs     []error
+	messages []string
+	calls    chan struct{}
+	sent     chan struct{}
+	gate     chan struct{}
+}
+
+func newFakeSender(errs ...error) *fakeSender {
+	return &fakeSender{errs: errs, calls: make(chan struct{}, 10), sent: make(chan struct{}, 10)}
+}
+
+func (f *fakeSender) SendMessage(address string, message string) error {
+	return f.send(address + ": " + message)
+}
+
+func (f *fakeSender) Reply(chatID model.ChatID, text string) error {
+	return f.send(fmt.Sprintf("reply %d: %s", chatID, text))
+}
+
+func (f *fakeSender) send(message string) error {
+	f.calls <- struct{}{}
+	if f.gate != nil {
+		<-f.gate
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	if len(f.errs) > 0 {
+		err := f.errs[0]
+		f.errs = f.errs[1:]
+		return err
+	}
+	f.messages = append(f.messages, message)
+	f.sent <- struct{}{}
+	return nil
+}
+
+func (f *fakeSender) Messages() []string {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	return append([]string(nil), f.messages...)
+}
+
+// Collect the results of digests
+func newAcks() (chan error, func(err error)) {
+	acks := make(chan error, 10)
+	return acks, func(err error) { acks <- err }
+}
+
+func tooManyRequests(retryAfter int) error {
+	return tgbotapi.Error{Message: "Too Many Requests", ResponseParameters: tgbotapi.ResponseParameters{RetryAfter: retryAfter}}
+}
+
+func TestQueue_SendMessage_Sent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender()
+	queue := newQueue(sender, QueueOptions{})
+	t.Cleanup(queue.Close)
+
+	// Act
+	err := queue.SendMessage(context.Background(), "1", "order 1 is payed")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"1: order 1 is payed"}, sender.Messages())
+}
+
+func TestQueue_SendMessage_Error_Returned(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sendErr := errors.New("chat not found")
+	queue := newQueue(newFakeSender(sendErr), QueueOptions{})
+	t.Cleanup(queue.Close)
+
+	// Act
+	err := queue.SendMessage(context.Background(), "1", "order 1 is payed")
+
+	// Assert
+	require.ErrorIs(t, err, sendErr)
+}
+
+func TestQueue_SendMessage_TooManyRequests_RetriedAfterPause(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender(tooManyRequests(1))
+	queue := newQueue(sender, QueueOptions{})
+	t.Cleanup(queue.Close)
+	start := time.Now()
+
+	// Act
+	err := queue.SendMessage(context.Background(), "1", "order 1 is payed")
+
+	// Assert
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+	require.Equal(t, []string{"1: order 1 is payed"}, sender.Messages())
+}
+
+func TestQueue_SendMessage_TooManyRequestsAfterRetries_Error(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	queue := newQueue(newFakeSender(tooManyRequests(1), tooManyRequests(1)), QueueOptions{MaxRetries: 1})
+	t.Cleanup(queue.Close)
+
+	// Act
+	err := queue.SendMessage(context.Background(), "1", "order 1 is payed")
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, time.Second, retryAfter(err))
+}
+
+func TestQueue_SendMessage_RetryAfterOverLimit_Error(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender(tooManyRequests(5))
+	queue := newQueue(sender, QueueOptions{MaxRetryAfter: time.Second})
+	t.Cleanup(queue.Close)
+	start := time.Now()
+
+	// Act
+	err := queue.SendMessage(context.Background(), "1", "order 1 is payed")
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, 5*time.Second, retryAfter(err))
+	require.Less(t, time.Since(start), time.Second)
+	require.Empty(t, sender.Messages())
+}
+
+func TestQueue_MaxDigestDelay_WindowAndRetryPauses(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	queue := newQueue(newFakeSender(), QueueOptions{MaxRetries: 2, MaxRetryAfter: 10 * time.Second, DigestWindow: 5 * time.Second})
+	t.Cleanup(queue.Close)
+
+	// Act
+	delay := queue.MaxDigestDelay()
+
+	// Assert
+	require.Equal(t, 25*time.Second, delay)
+}
+
+func TestQueue_SendMessage_TooManyRequests_OtherChatsPaused(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender(tooManyRequests(1))
+	queue := newQueue(sender, QueueOptions{})
+	t.Cleanup(queue.Close)
+	start := time.Now()
+	throttled := make(chan error, 1)
+	go func() {
+		throttled <- queue.SendMessage(context.Background(), "1", "order 1 is payed")
+	}()
+	<-sender.calls
+
+	// Act
+	err := queue.SendMessage(context.Background(), "2", "order 2 is payed")
+
+	// Assert
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, time.Since(start), time.Second)
+	require.NoError(t, <-throttled)
+}
+
+func TestQueue_SendMessage_ContextDone_NotSent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender()
+	sender.gate = make(chan struct{})
+	queue := newQueue(sender, QueueOptions{})
+	first := make(chan error, 1)
+	go func() {
+		first <- queue.SendMessage(context.Background(), "1", "order 1 is payed")
+	}()
+	<-sender.calls
+	ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+	t.Cleanup(cancel)
+
+	// Act
+	err := queue.SendMessage(ctx, "1", "order 2 is payed")
+
+	// Assert
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	close(sender.gate)
+	require.NoError(t, <-first)
+	queue.Close()
+	require.Equal(t, []string{"1: order 1 is payed"}, sender.Messages())
+}
+
+func TestQueue_Reply_SentAsReply(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender()
+	queue := newQueue(sender, QueueOptions{})
+	t.Cleanup(queue.Close)
+
+	// Act
+	err := queue.Reply(context.Background(), 1, "Order 1: payed")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"reply 1: Order 1: payed"}, sender.Messages())
+}
+
+func TestQueue_SendDigest_SameKey_Merged(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender()
+	queue := newQueue(sender, QueueOptions{DigestWindow: 50 * time.Millisecond})
+	t.Cleanup(queue.Close)
+	acks, ack := newAcks()
+
+	// Act
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:1", "order 1 is payed", ack))
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:2", "order 2 is payed", ack))
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:1", "order 1 is delivered", ack))
+
+	// Assert
+	for i := 0; i < 3; i++ {
+		require.NoError(t, <-acks)
+	}
+	require.Equal(t, []string{
+		"1: order 1 is payed" + digestSeparator + "order 1 is delivered",
+		"1: order 2 is payed",
+	}, sender.Messages())
+}
+
+func TestQueue_SendDigest_Failed_ErrorToEveryMessage(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sendErr := errors.New("chat not found")
+	queue := newQueue(newFakeSender(sendErr), QueueOptions{DigestWindow: 10 * time.Millisecond})
+	t.Cleanup(queue.Close)
+	acks, ack := newAcks()
+
+	// Act
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:1", "order 1 is payed", ack))
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:1", "order 1 is delivered", ack))
+
+	// Assert
+	require.ErrorIs(t, <-acks, sendErr)
+	require.ErrorIs(t, <-acks, sendErr)
+}
+
+func TestQueue_SendDigest_WaitingDigest_LaterMessageSentFirst(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender()
+	queue := newQueue(sender, QueueOptions{DigestWindow: time.Hour})
+	t.Cleanup(queue.Close)
+	_, ack := newAcks()
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:1", "order 1 is payed", ack))
+	ctx, cancel := context.WithTimeout(context.Background(), time.Second)
+	t.Cleanup(cancel)
+
+	// Act
+	err := queue.SendMessage(ctx, "1", "your code is 1234")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"1: your code is 1234"}, sender.Messages())
+}
+
+func TestQueue_SendDigest_Full_ErrQueueFull(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	queue := newQueue(newFakeSender(), QueueOptions{Capacity: 1, DigestWindow: time.Hour})
+	t.Cleanup(queue.Close)
+	_, ack := newAcks()
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:1", "order 1 is payed", ack))
+
+	// Act
+	err := queue.SendDigest(context.Background(), "1", "order:2", "order 2 is payed", ack)
+
+	// Assert
+	require.ErrorIs(t, err, ErrQueueFull)
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:1", "order 1 is delivered", ack))
+}
+
+func TestQueue_Close_WaitingDigest_SentAtOnce(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender()
+	queue := newQueue(sender, QueueOptions{DigestWindow: time.Hour})
+	acks, ack := newAcks()
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:1", "order 1 is payed", ack))
+
+	// Act
+	queue.Close()
+
+	// Assert
+	require.NoError(t, <-acks)
+	require.Equal(t, []string{"1: order 1 is payed"}, sender.Messages())
+}
+
+func TestQueue_Close_DrainTimeout_ErrQueueClosed(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	sender := newFakeSender()
+	sender.gate = make(chan struct{})
+	queue := newQueue(sender, QueueOptions{DigestWindow: time.Hour, DrainTimeout: 10 * time.Millisecond})
+	first := make(chan error, 1)
+	go func() {
+		first <- queue.SendMessage(context.Background(), "1", "your code is 1234")
+	}()
+	<-sender.calls
+	acks, ack := newAcks()
+	require.NoError(t, queue.SendDigest(context.Background(), "1", "order:1", "order 1 is payed", ack))
+	closed := make(chan struct{})
+
+	// Act
+	go func() {
+		defer close(closed)
+		queue.Close()
+	}()
+	time.Sleep(50 * time.Millisecond)
+	close(sender.gate)
+	<-closed
+
+	// Assert
+	require.NoError(t, <-first)
+	require.ErrorIs(t, <-acks, ErrQueueClosed)
+	require.Equal(t, []string{"1: your code is 1234"}, sender.Messages())
+}
+
+func TestQueue_SendDigest_Closed_ErrQueueClosed(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	queue := newQueue(newFakeSender(), QueueOptions{DigestWindow: time.Hour})
+	queue.Close()
+	_, ack := newAcks()
+
+	// Act
+	err := queue.SendDigest(context.Background(), "1", "order:1", "order 1 is payed", ack)
+
+	// Assert
+	require.ErrorIs(t, err, ErrQueueClosed)
+}
+
+func TestQueue_SendMessage_Closed_ErrQueueClosed(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	queue := newQueue(newFakeSender(), QueueOptions{})
+	queue.Close()
+
+	// Act
+	err := queue.SendMessage(context.Background(), "1", "order 1 is payed")
+
+	// Assert
+	require.ErrorIs(t, err, ErrQueueClosed)
+}
@@ -0,0 +1,57 @@
+package telegram
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+
+	tgbotapi "github.com/go-telegram-bot-api/telegram-bot-api"
+	"github.com/pkg/errors"
+)
+
+// Seconds a long poll for updates waits for new messages
+const updatesTimeout = 60
+
+// Receive text messages sent to the bot until the context is done
+func (c *Client) Updates(ctx context.Context) (<-chan model.ChatMessage, error) {
+	config := tgbotapi.NewUpdate(0)
+	config.Timeout = updatesTimeout
+
+	updates, err := c.bot.GetUpdatesChan(config)
+	if err != nil {
+		return nil, errors.Wrap(err, "get updates")
+	}
+
+	out := make(chan model.ChatMessage)
+	go func() {
+		defer close(out)
+		defer c.bot.StopReceivingUpdates()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case update, ok := <-updates:
+				if !ok {
+					return
+				}
+				if update.Message == nil || update.Message.Text == "" {
+					continue
+				}
+
+				select {
+				case out <- model.ChatMessage{ChatID: model.ChatID(update.Message.Chat.ID), Text: update.Message.Text}:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+
+	return out, nil
+}
+
+// Send a plain text message to the chat
+func (c *Client) Reply(chatID model.ChatID, text string) error {
+	_, err := c.bot.Send(tgbotapi.NewMessage(int64(chatID), text))
+	return err
+}
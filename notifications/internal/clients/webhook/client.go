@@ -0,0 +1,169 @@
+// Signed HTTP webhook notifier
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"route256/notifications/internal/pkg/netguard"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Headers of webhook requests
+const (
+	// Unix time of the request in seconds, a part of the signed payload
+	HeaderTimestamp = "X-Route256-Timestamp"
+	// "sha256=" and hex HMAC-SHA256 of "<timestamp>.<body>" with the shared secret
+	HeaderSignature = "X-Route256-Signature"
+)
+
+const (
+	defaultTimeout        = 5 * time.Second
+	defaultMaxAttempts    = 3
+	defaultInitialBackoff = 500 * time.Millisecond
+)
+
+// Describe webhook requests
+type Options struct {
+	// Secret receivers verify signatures with
+	Secret string
+	// Limit of one request
+	Timeout time.Duration
+	// Attempts of a request failed with a network error, 429 or 5xx, the backoff doubles after each
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	// Allow webhooks on private, loopback and link-local addresses, for local development only
+	AllowPrivateNetworks bool
+}
+
+// Describe the body of a webhook request
+type payload struct {
+	Message string    `json:"message"`
+	SentAt  time.Time `json:"sent_at"`
+}
+
+// Define webhook client
+type Client struct {
+	opts Options
+	http *http.Client
+}
+
+// Create a client posting signed notifications to webhooks
+func New(opts Options) (*Client, error) {
+	if opts.Secret == "" {
+		return nil, errors.New("webhook secret is required")
+	}
+	if opts.Timeout <= 0 {
+		opts.Timeout = defaultTimeout
+	}
+	if opts.MaxAttempts <= 0 {
+		opts.MaxAttempts = defaultMaxAttempts
+	}
+	if opts.InitialBackoff <= 0 {
+		opts.InitialBackoff = defaultInitialBackoff
+	}
+
+	dialer := &net.Dialer{Timeout: opts.Timeout}
+	if !opts.AllowPrivateNetworks {
+		dialer.Control = netguard.Control
+	}
+
+	return &Client{
+		opts: opts,
+		http: &http.Client{
+			Timeout: opts.Timeout,
+			// No proxy, connections are checked by the dialer
+			Transport: &http.Transport{
+				DialContext:         dialer.DialContext,
+				TLSHandshakeTimeout: opts.Timeout,
+				MaxIdleConns:        100,
+				IdleConnTimeout:     90 * time.Second,
+			},
+		},
+	}, nil
+}
+
+// Post message to the webhook URL, retries stop when the context is done
+func (c *Client) SendMessage(ctx context.Context, address string, message string) error {
+	target, err := url.Parse(address)
+	if err != nil {
+		return errors.Wrap(err, "parse webhook url")
+	}
+	if !c.opts.AllowPrivateNetworks && !netguard.PublicHost(target.Hostname()) {
+		return errors.Wrapf(netguard.ErrNotPublic, "webhook host %s", target.Hostname())
+	}
+
+	body, err := json.Marshal(payload{Message: message, SentAt: time.Now().UTC()})
+	if err != nil {
+		return errors.Wrap(err, "marshal webhook payload")
+	}
+
+	backoff := c.opts.InitialBackoff
+	for attempt := 1; ; attempt++ {
+		retry, err := c.post(ctx, address, body)
+		if err == nil {
+			return nil
+		}
+		if !retry || attempt >= c.opts.MaxAttempts {
+			return errors.Wrapf(err, "webhook failed after %d attempt(s)", attempt)
+		}
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return errors.Wrapf(ctx.Err(), "webhook failed after %d attempt(s): %v", attempt, err)
+		}
+		backoff *= 2
+	}
+}
+
+// Send one signed request, tell if a failed request is worth retrying
+func (c *Client) post(ctx context.Context, address string, body []byte) (bool, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, address, bytes.NewReader(body))
+	if err != nil {
+		return false, errors.Wrap(err, "build webhook request")
+	}
+
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(HeaderTimestamp, timestamp)
+	req.Header.Set(HeaderSignature, Sign(c.opts.Secret, timestamp, body))
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return true, errors.Wrap(err, "post webhook")
+	}
+	defer resp.Body.Close()
+	_, _ = io.Copy(io.Discard, io.LimitReader(resp.Body, 1<<16))
+
+	switch {
+	case resp.StatusCode >= 200 && resp.StatusCode < 300:
+		return false, nil
+	case resp.StatusCode == http.StatusTooManyRequests || resp.StatusCode >= 500:
+		return true, fmt.Errorf("webhook responded %s", resp.Status)
+	default:
+		return false, fmt.Errorf("webhook responded %s", resp.Status)
+	}
+}
+
+// Get the signature of the body sent at the timestamp
+func Sign(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,158 @@
+package webhook
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"route256/notifications/internal/pkg/netguard"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSecret = "test-secret"
+
+func TestClient_SendMessage_Signed(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var got payload
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, err := io.ReadAll(r.Body)
+		require.NoError(t, err)
+		require.Equal(t, Sign(testSecret, r.Header.Get(HeaderTimestamp), body), r.Header.Get(HeaderSignature))
+		require.NoError(t, json.Unmarshal(body, &got))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	t.Cleanup(server.Close)
+	client, err := New(Options{Secret: testSecret, AllowPrivateNetworks: true})
+	require.NoError(t, err)
+
+	// Act
+	err = client.SendMessage(context.Background(), server.URL, "order 1 is payed")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, "order 1 is payed", got.Message)
+}
+
+func TestClient_SendMessage_ServerError_Retried(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if atomic.AddInt32(&calls, 1) < 3 {
+			w.WriteHeader(http.StatusServiceUnavailable)
+			return
+		}
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	client, err := New(Options{Secret: testSecret, MaxAttempts: 3, InitialBackoff: time.Millisecond, AllowPrivateNetworks: true})
+	require.NoError(t, err)
+
+	// Act
+	err = client.SendMessage(context.Background(), server.URL, "order 1 is payed")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, int32(3), atomic.LoadInt32(&calls))
+}
+
+func TestClient_SendMessage_ClientError_NotRetried(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusBadRequest)
+	}))
+	t.Cleanup(server.Close)
+	client, err := New(Options{Secret: testSecret, MaxAttempts: 3, InitialBackoff: time.Millisecond, AllowPrivateNetworks: true})
+	require.NoError(t, err)
+
+	// Act
+	err = client.SendMessage(context.Background(), server.URL, "order 1 is payed")
+
+	// Assert
+	require.Error(t, err)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
+
+func TestClient_SendMessage_Timeout_Error(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	release := make(chan struct{})
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		<-release
+	}))
+	t.Cleanup(server.Close)
+	t.Cleanup(func() { close(release) })
+	client, err := New(Options{Secret: testSecret, Timeout: 50 * time.Millisecond, MaxAttempts: 1, AllowPrivateNetworks: true})
+	require.NoError(t, err)
+
+	// Act
+	err = client.SendMessage(context.Background(), server.URL, "order 1 is payed")
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestClient_SendMessage_PrivateAddress_Refused(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	t.Cleanup(server.Close)
+	client, err := New(Options{Secret: testSecret})
+	require.NoError(t, err)
+
+	cases := []struct {
+		name    string
+		address string
+	}{
+		{name: "loopback", address: server.URL},
+		{name: "localhost", address: "http://localhost/hook"},
+		{name: "private", address: "http://10.0.0.1/hook"},
+		{name: "link-local", address: "http://169.254.169.254/latest/meta-data"},
+	}
+
+	for _, tt := range cases {
+		// Act
+		err = client.SendMessage(context.Background(), tt.address, "order 1 is payed")
+
+		// Assert
+		require.ErrorIs(t, err, netguard.ErrNotPublic, tt.name)
+	}
+	require.Zero(t, atomic.LoadInt32(&calls))
+}
+
+func TestClient_SendMessage_ContextDone_RetriesStopped(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	var calls int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&calls, 1)
+		w.WriteHeader(http.StatusServiceUnavailable)
+	}))
+	t.Cleanup(server.Close)
+	client, err := New(Options{Secret: testSecret, MaxAttempts: 5, InitialBackoff: time.Minute, AllowPrivateNetworks: true})
+	require.NoError(t, err)
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	t.Cleanup(cancel)
+
+	// Act
+	started := time.Now()
+	err = client.SendMessage(ctx, server.URL, "order 1 is payed")
+
+	// Assert
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	require.Less(t, time.Since(started), 5*time.Second)
+	require.Equal(t, int32(1), atomic.LoadInt32(&calls))
+}
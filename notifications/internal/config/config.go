@@ -3,6 +3,7 @@ package config
 
 import (
 	"os"
+	"time"
 
 	"github.com/pkg/errors"
 	"gopkg.in/yaml.v3"
@@ -13,13 +14,89 @@ const pathToConfig = "config.yaml"
 // Service configuraton
 type Config struct {
 	Postgres struct {
-		ConnectionString string `yaml:"connection_string"`
+		ConnectionString       string `yaml:"connection_string"`
+		TestDBConnectionString string `yaml:"test_db_connection_string"`
 	} `yaml:"postgres"`
-	Brokers  []string `yaml:"brokers"`
+	Brokers []string `yaml:"brokers"`
+	// Telegram without a configured client is written by the console notifier,
+	// email and webhook channels without one are not available
 	Telegram struct {
 		APIKey string `yaml:"api_key"`
-		ChatID int64  `yaml:"chat_id"`
+		// Send queue in front of the client, defaults are used for empty values
+		Queue struct {
+			GlobalRPS     float64       `yaml:"global_rps"`
+			ChatRPS       float64       `yaml:"chat_rps"`
+			ChatBurst     int           `yaml:"chat_burst"`
+			Capacity      int           `yaml:"capacity"`
+			MaxRetries    int           `yaml:"max_retries"`
+			MaxRetryAfter time.Duration `yaml:"max_retry_after"`
+			DigestWindow  time.Duration `yaml:"digest_window"`
+			DrainTimeout  time.Duration `yaml:"drain_timeout"`
+		} `yaml:"queue"`
 	} `yaml:"telegram"`
+	Email struct {
+		Host     string        `yaml:"host"`
+		Port     int           `yaml:"port"`
+		Username string        `yaml:"username"`
+		Password string        `yaml:"password"`
+		From     string        `yaml:"from"`
+		Timeout  time.Duration `yaml:"timeout"`
+	} `yaml:"email"`
+	Webhook struct {
+		Secret         string        `yaml:"secret"`
+		Timeout        time.Duration `yaml:"timeout"`
+		MaxAttempts    int           `yaml:"max_attempts"`
+		InitialBackoff time.Duration `yaml:"initial_backoff"`
+		// Webhooks on private, loopback and link-local addresses are refused unless allowed
+		AllowPrivateNetworks bool `yaml:"allow_private_networks"`
+	} `yaml:"webhook"`
+	Templates struct {
+		// Directory with <locale>/<channel>.tmpl files, empty is the built-in templates
+		Dir string `yaml:"dir"`
+	} `yaml:"templates"`
+	Console struct {
+		// Empty file is stdout
+		File string `yaml:"file"`
+	} `yaml:"console"`
+	// LOMS the Telegram bot calls, the bot is off without the address or telegram.api_key
+	Loms struct {
+		Address string `yaml:"address"`
+		// Service token, e.g. minted with go run ./cmd/token in checkout
+		Token string `yaml:"token"`
+		// Send the token without TLS, only for local development
+		InsecureToken bool `yaml:"insecure_token"`
+	} `yaml:"loms"`
+	// Cache of notification histories, defaults are used for empty values
+	HistoryCache struct {
+		Capacity int           `yaml:"capacity"`
+		TTL      time.Duration `yaml:"ttl"`
+	} `yaml:"history_cache"`
+	// Retries of messages that failed to be handled, defaults are used for empty values
+	Consumer struct {
+		MaxAttempts    int           `yaml:"max_attempts"`
+		InitialBackoff time.Duration `yaml:"initial_backoff"`
+		MaxBackoff     time.Duration `yaml:"max_backoff"`
+		RetryTopics    []struct {
+			Topic string        `yaml:"topic"`
+			Delay time.Duration `yaml:"delay"`
+		} `yaml:"retry_topics"`
+		DeadLetterTopic string `yaml:"dead_letter_topic"`
+	} `yaml:"consumer"`
+	// Keys and expected claims of bearer tokens, one of the keys is set
+	Auth struct {
+		HMACSecret string `yaml:"hmac_secret"`
+		JWKSFile   string `yaml:"jwks_file"`
+		Issuer     string `yaml:"issuer"`
+		Audience   string `yaml:"audience"`
+	} `yaml:"auth"`
+	// Certificate files of the service, TLS is off when they are empty
+	TLS struct {
+		CertFile          string        `yaml:"cert_file"`
+		KeyFile           string        `yaml:"key_file"`
+		CAFile            string        `yaml:"ca_file"`
+		RequireClientCert bool          `yaml:"require_client_cert"`
+		ReloadInterval    time.Duration `yaml:"reload_interval"`
+	} `yaml:"tls"`
 }
 
 // Create a new instance of the config
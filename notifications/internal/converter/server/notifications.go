@@ -2,28 +2,193 @@
 package server
 
 import (
+	"encoding/base64"
+	"fmt"
+	"route256/libs/apperr"
 	"route256/notifications/internal/model"
 	"route256/notifications/pkg/notifications_v1"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
+)
+
+var (
+	ErrInvalidPageToken = apperr.New(apperr.InvalidArgument, "invalid page token")
 )
 
 // Convert message to response object
 func MessageToRes(item model.OrderStatusMessage) *notifications_v1.Message {
 	return &notifications_v1.Message{
-		UserId:  int64(item.UserID),
-		OrderId: int64(item.OrderID),
-		Status:  string(item.Status),
-		Message: item.Message,
+		Id:        int64(item.ID),
+		UserId:    int64(item.UserID),
+		OrderId:   int64(item.OrderID),
+		Status:    string(item.Status),
+		Message:   item.Message,
+		CreatedAt: timestamppb.New(item.CreatedAt),
 	}
 }
 
-// Convert messages info to response object
-func ListMessagesToResp(messages []model.OrderStatusMessage) *notifications_v1.GetHistoryWithPeriodResponse {
-	items := []*notifications_v1.Message{}
-	for _, message := range messages {
+// Convert history page to response object
+func HistoryPageToResp(page model.HistoryPage) *notifications_v1.GetHistoryWithPeriodResponse {
+	items := make([]*notifications_v1.Message, 0, len(page.Messages))
+	for _, message := range page.Messages {
 		items = append(items, MessageToRes(message))
 	}
 
-	return &notifications_v1.GetHistoryWithPeriodResponse{
+	res := &notifications_v1.GetHistoryWithPeriodResponse{
 		Messages: items,
 	}
+	if page.Next != nil {
+		res.NextPageToken = encodePageToken(*page.Next)
+	}
+	return res
+}
+
+// Convert request to history filter, timestamps take precedence over dates
+func HistoryReqToFilter(req *notifications_v1.GetHistoryWithPeriodRequest) (model.HistoryFilter, error) {
+	filter := model.HistoryFilter{
+		UserID:  model.UserID(req.GetUser()),
+		OrderID: model.OrderID(req.GetOrderId()),
+		Limit:   uint64(req.GetPageSize()),
+	}
+
+	switch {
+	case req.GetFromTime() != nil:
+		filter.From = req.GetFromTime().AsTime()
+	case req.GetFrom() != nil:
+		filter.From = dateToTime(req.GetFrom())
+	}
+	switch {
+	case req.GetToTime() != nil:
+		filter.To = req.GetToTime().AsTime()
+	case req.GetTo() != nil:
+		// The whole day is included
+		filter.To = dateToTime(req.GetTo()).AddDate(0, 0, 1)
+	}
+
+	for _, status := range req.GetStatuses() {
+		filter.Statuses = append(filter.Statuses, model.OrderStatus(status))
+	}
+
+	if req.GetPageToken() != "" {
+		cursor, err := decodePageToken(req.GetPageToken())
+		if err != nil {
+			return model.HistoryFilter{}, err
+		}
+		filter.After = &cursor
+	}
+
+	return filter, nil
+}
+
+// Convert date to the start of the day in UTC
+func dateToTime(date *notifications_v1.Date) time.Time {
+	return time.Date(int(date.GetYear()), time.Month(date.GetMonth()), int(date.GetDay()), 0, 0, 0, 0, time.UTC)
+}
+
+// Encode history cursor to an opaque page token
+func encodePageToken(cursor model.HistoryCursor) string {
+	raw := fmt.Sprintf("%d:%d", cursor.CreatedAt.UnixMicro(), cursor.ID)
+	return base64.RawURLEncoding.EncodeToString([]byte(raw))
+}
+
+// Decode page token to history cursor
+func decodePageToken(token string) (model.HistoryCursor, error) {
+	raw, err := base64.RawURLEncoding.DecodeString(token)
+	if err != nil {
+		return model.HistoryCursor{}, ErrInvalidPageToken
+	}
+
+	var micros, id int64
+	_, err = fmt.Sscanf(string(raw), "%d:%d", &micros, &id)
+	if err != nil {
+		return model.HistoryCursor{}, ErrInvalidPageToken
+	}
+
+	return model.HistoryCursor{CreatedAt: time.UnixMicro(micros).UTC(), ID: model.MessageID(id)}, nil
+}
+
+// Convert dead letter to response object
+func DeadLetterToRes(letter model.DeadLetter) *notifications_v1.DeadLetter {
+	res := &notifications_v1.DeadLetter{
+		Id:        int64(letter.ID),
+		Topic:     letter.Topic,
+		Partition: letter.Partition,
+		Offset:    letter.Offset,
+		Key:       string(letter.Key),
+		Value:     string(letter.Value),
+		Error:     letter.Error,
+		Attempts:  letter.Attempts,
+		FailedAt:  timestamppb.New(letter.FailedAt),
+	}
+	if !letter.ReplayedAt.IsZero() {
+		res.ReplayedAt = timestamppb.New(letter.ReplayedAt)
+	}
+	return res
+}
+
+// Convert dead letters to response object
+func ListDeadLettersToResp(letters []model.DeadLetter) *notifications_v1.ListDeadLettersResponse {
+	items := make([]*notifications_v1.DeadLetter, 0, len(letters))
+	for _, letter := range letters {
+		items = append(items, DeadLetterToRes(letter))
+	}
+
+	return &notifications_v1.ListDeadLettersResponse{
+		DeadLetters: items,
+	}
+}
+
+// Convert preferences to response object
+func PreferencesToRes(prefs model.Preferences) *notifications_v1.Preferences {
+	res := &notifications_v1.Preferences{
+		User:     int64(prefs.UserID),
+		Locale:   string(prefs.Locale),
+		Channels: make([]*notifications_v1.ChannelPreference, 0, len(prefs.Channels)),
+		Statuses: make([]*notifications_v1.StatusPreference, 0, len(prefs.Statuses)),
+	}
+	for _, channel := range prefs.Channels {
+		res.Channels = append(res.Channels, &notifications_v1.ChannelPreference{
+			Channel: string(channel.Channel),
+			Address: channel.Address,
+			Enabled: channel.Enabled,
+		})
+	}
+	for _, status := range prefs.Statuses {
+		res.Statuses = append(res.Statuses, &notifications_v1.StatusPreference{
+			Status:  string(status.Status),
+			Enabled: status.Enabled,
+		})
+	}
+	return res
+}
+
+// Convert request to preferences
+func SetPreferencesReqToPreferences(req *notifications_v1.SetPreferencesRequest) model.Preferences {
+	prefs := model.Preferences{
+		UserID: model.UserID(req.GetUser()),
+		Locale: model.Locale(req.GetLocale()),
+	}
+	for _, channel := range req.GetChannels() {
+		prefs.Channels = append(prefs.Channels, model.ChannelPreference{
+			Channel: model.Channel(channel.GetChannel()),
+			Address: channel.GetAddress(),
+			Enabled: channel.GetEnabled(),
+		})
+	}
+	for _, status := range req.GetStatuses() {
+		prefs.Statuses = append(prefs.Statuses, model.StatusPreference{
+			Status:  model.OrderStatus(status.GetStatus()),
+			Enabled: status.GetEnabled(),
+		})
+	}
+	return prefs
+}
+
+// Convert link code to response object
+func LinkCodeToRes(code model.LinkCode) *notifications_v1.TelegramLinkCode {
+	return &notifications_v1.TelegramLinkCode{
+		Code:      code.Code,
+		ExpiresAt: timestamppb.New(code.ExpiresAt),
+	}
 }
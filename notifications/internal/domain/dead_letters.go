@@ -0,0 +1,65 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/apperr"
+	"route256/notifications/internal/model"
+	"strconv"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrDeadLetterNotFound = apperr.New(apperr.NotFound, "dead letter not found")
+)
+
+// Keep a message that failed to be handled after all retries
+func (s *Service) SaveDeadLetter(ctx context.Context, letter model.DeadLetter) error {
+	return s.deadLetters.SaveDeadLetter(ctx, letter)
+}
+
+// List dead letters after the given id, replayed ones only on request
+func (s *Service) ListDeadLetters(ctx context.Context, afterID model.DeadLetterID, limit uint64, includeReplayed bool) ([]model.DeadLetter, error) {
+	return s.deadLetters.ListDeadLetters(ctx, afterID, limit, includeReplayed)
+}
+
+// Publish dead letters back to the topics they were consumed from.
+// Letters replayed before, also by a concurrent call, are skipped, ids of the published ones are returned
+func (s *Service) ReplayDeadLetters(ctx context.Context, ids []model.DeadLetterID) ([]model.DeadLetterID, error) {
+	letters, err := s.deadLetters.GetDeadLetters(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	found := make(map[model.DeadLetterID]struct{}, len(letters))
+	for _, letter := range letters {
+		found[letter.ID] = struct{}{}
+	}
+	for _, id := range ids {
+		if _, ok := found[id]; !ok {
+			return nil, ErrDeadLetterNotFound.WithDetails(map[string]string{
+				"id": strconv.FormatInt(int64(id), 10),
+			})
+		}
+	}
+
+	replayed := make([]model.DeadLetterID, 0, len(letters))
+	for _, letter := range letters {
+		if !letter.ReplayedAt.IsZero() {
+			continue
+		}
+
+		ok, err := s.deadLetters.ReplayDeadLetter(ctx, letter.ID, time.Now().UTC(), func(letter model.DeadLetter) error {
+			return s.publisher.Publish(ctx, letter.Topic, letter.Key, letter.Value)
+		})
+		if err != nil {
+			return replayed, errors.Wrapf(err, "replay dead letter %d", letter.ID)
+		}
+		if ok {
+			replayed = append(replayed, letter.ID)
+		}
+	}
+
+	return replayed, nil
+}
@@ -0,0 +1,160 @@
+package domain
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// In-memory repository of dead letters, a letter is marked replayed only when it is published
+type fakeDeadLetterRepository struct {
+	mu      sync.Mutex
+	letters map[model.DeadLetterID]model.DeadLetter
+}
+
+func newFakeDeadLetterRepository(letters ...model.DeadLetter) *fakeDeadLetterRepository {
+	r := &fakeDeadLetterRepository{letters: make(map[model.DeadLetterID]model.DeadLetter)}
+	for _, letter := range letters {
+		r.letters[letter.ID] = letter
+	}
+	return r
+}
+
+func (r *fakeDeadLetterRepository) SaveDeadLetter(_ context.Context, letter model.DeadLetter) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.letters[letter.ID] = letter
+	return nil
+}
+
+func (r *fakeDeadLetterRepository) ListDeadLetters(_ context.Context, _ model.DeadLetterID, _ uint64, _ bool) ([]model.DeadLetter, error) {
+	return nil, nil
+}
+
+func (r *fakeDeadLetterRepository) GetDeadLetters(_ context.Context, ids []model.DeadLetterID) ([]model.DeadLetter, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	letters := make([]model.DeadLetter, 0, len(ids))
+	for _, id := range ids {
+		if letter, ok := r.letters[id]; ok {
+			letters = append(letters, letter)
+		}
+	}
+	return letters, nil
+}
+
+func (r *fakeDeadLetterRepository) ReplayDeadLetter(_ context.Context, id model.DeadLetterID, replayedAt time.Time, publish func(letter model.DeadLetter) error) (bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	letter := r.letters[id]
+	if !letter.ReplayedAt.IsZero() {
+		return false, nil
+	}
+	err := publish(letter)
+	if err != nil {
+		return false, err
+	}
+	letter.ReplayedAt = replayedAt
+	r.letters[id] = letter
+	return true, nil
+}
+
+func (r *fakeDeadLetterRepository) get(id model.DeadLetterID) model.DeadLetter {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.letters[id]
+}
+
+// Publisher keeping published values
+type fakePublisher struct {
+	mu        sync.Mutex
+	err       error
+	published []string
+}
+
+func (p *fakePublisher) Publish(_ context.Context, topic string, _ []byte, value []byte) error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	if p.err != nil {
+		return p.err
+	}
+	p.published = append(p.published, topic+":"+string(value))
+	return nil
+}
+
+func newDeadLetterService(deadLetters DeadLetterRepository, publisher MessagePublisher) *Service {
+	return NewService(&fakeMessageRepository{}, nil, nil, deadLetters, publisher, nil, nil, nil, nil, nil)
+}
+
+func TestService_ReplayDeadLetters_ConcurrentCalls_PublishedOnce(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	deadLetters := newFakeDeadLetterRepository(model.DeadLetter{ID: 1, Topic: "orders", Value: []byte("paid")})
+	publisher := &fakePublisher{}
+	service := newDeadLetterService(deadLetters, publisher)
+
+	// Act
+	var wg sync.WaitGroup
+	replayed := make([][]model.DeadLetterID, 4)
+	for i := range replayed {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			ids, err := service.ReplayDeadLetters(ctx, []model.DeadLetterID{1})
+			require.NoError(t, err)
+			replayed[i] = ids
+		}(i)
+	}
+	wg.Wait()
+
+	// Assert
+	require.Equal(t, []string{"orders:paid"}, publisher.published)
+	total := 0
+	for _, ids := range replayed {
+		total += len(ids)
+	}
+	require.Equal(t, 1, total)
+	require.False(t, deadLetters.get(1).ReplayedAt.IsZero())
+}
+
+func TestService_ReplayDeadLetters_PublishFails_NotMarked(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	deadLetters := newFakeDeadLetterRepository(model.DeadLetter{ID: 1, Topic: "orders", Value: []byte("paid")})
+	service := newDeadLetterService(deadLetters, &fakePublisher{err: errors.New("kafka is down")})
+
+	// Act
+	replayed, err := service.ReplayDeadLetters(ctx, []model.DeadLetterID{1})
+
+	// Assert
+	require.Error(t, err)
+	require.Empty(t, replayed)
+	require.True(t, deadLetters.get(1).ReplayedAt.IsZero())
+}
+
+func TestService_ReplayDeadLetters_UnknownID_NotFound(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	publisher := &fakePublisher{}
+	service := newDeadLetterService(newFakeDeadLetterRepository(model.DeadLetter{ID: 1, Topic: "orders"}), publisher)
+
+	// Act
+	_, err := service.ReplayDeadLetters(ctx, []model.DeadLetterID{1, 2})
+
+	// Assert
+	require.ErrorIs(t, err, ErrDeadLetterNotFound)
+	require.Empty(t, publisher.published)
+}
@@ -0,0 +1,165 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/logger"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// Time a claimed delivery is not taken by another consumer, longer than sending takes.
+// A notification merged into a digest is claimed for the longest time the digest waits on top of it
+const deliveryLease = 30 * time.Second
+
+var (
+	ErrDeliveryInProgress = errors.New("notification of the event is being sent")
+	ErrNoNotifier         = errors.New("no notifier for the channel")
+)
+
+// Render the notification for every enabled channel of the user in the user's locale and send them concurrently.
+// Users without channels are not notified.
+// Every channel records its result, the first error is returned and only failed channels are sent again on retry.
+// Channels that took the notification for a digest are returned as pending until the digest is sent
+func (s *Service) notify(ctx context.Context, eventID model.EventID, prefs model.Preferences, kind model.NotificationKind, data model.NotificationData) (model.PendingDeliveries, error) {
+	channels, err := s.resolveChannels(ctx, prefs)
+	if err != nil {
+		return nil, err
+	}
+	if len(channels) == 0 {
+		logger.Info("user ", prefs.UserID, " has no channels, ", eventID, " is not sent")
+		return nil, nil
+	}
+
+	errs := make([]error, len(channels))
+	results := make([]<-chan error, len(channels))
+	wg := sync.WaitGroup{}
+	for i, channel := range channels {
+		notifier, ok := s.notifiers[channel.Channel]
+		if !ok {
+			errs[i] = errors.Wrapf(ErrNoNotifier, "notify through %s", channel.Channel)
+			continue
+		}
+
+		wg.Add(1)
+		go func(i int, channel model.ChannelPreference) {
+			defer wg.Done()
+			text, err := s.templates.Render(channel.Channel, prefs.Locale, kind, data)
+			if err == nil {
+				results[i], err = s.deliver(ctx, eventID, channel, notifier, digestKey(data), text)
+			}
+			if err != nil {
+				errs[i] = errors.Wrapf(err, "notify through %s", channel.Channel)
+			}
+		}(i, channel)
+	}
+	wg.Wait()
+
+	var pending model.PendingDeliveries
+	for _, result := range results {
+		if result != nil {
+			pending = append(pending, result)
+		}
+	}
+
+	var result error
+	for _, err := range errs {
+		if err == nil {
+			continue
+		}
+		if result == nil {
+			result = err
+		} else {
+			logger.Errorf(ctx, "domain/notify", "%v", err)
+		}
+	}
+
+	return pending, result
+}
+
+// Get enabled channels of the user, a Telegram chat that is no longer linked to the user is skipped
+func (s *Service) resolveChannels(ctx context.Context, prefs model.Preferences) ([]model.ChannelPreference, error) {
+	enabled := prefs.EnabledChannels()
+	channels := make([]model.ChannelPreference, 0, len(enabled))
+	for _, channel := range enabled {
+		if channel.Channel == model.ChannelTelegram {
+			linked, err := s.chatLinked(ctx, channel.Address, prefs.UserID)
+			if err != nil {
+				return nil, err
+			}
+			if !linked {
+				logger.Info("telegram chat ", channel.Address, " is not linked to user ", prefs.UserID)
+				continue
+			}
+		}
+		channels = append(channels, channel)
+	}
+	return channels, nil
+}
+
+// Send the notification of the event through the channel once. A sent notification is skipped,
+// a notification being sent by another consumer is ErrDeliveryInProgress and is retried later.
+// Notifications with a digest key may be merged by a DigestNotifier, such a delivery stays
+// pending until the digest is sent and the result of sending is received from the returned channel
+func (s *Service) deliver(ctx context.Context, eventID model.EventID, channel model.ChannelPreference, notifier Notifier, digest string, text string) (<-chan error, error) {
+	lease := deliveryLease
+	digestNotifier, digested := notifier.(DigestNotifier)
+	digested = digested && digest != ""
+	if digested {
+		lease += digestNotifier.MaxDigestDelay()
+	}
+
+	claimed, state, err := s.delivery.ClaimDelivery(ctx, eventID, channel.Channel, lease)
+	if err != nil {
+		return nil, err
+	}
+	if !claimed {
+		if state == model.DeliverySent {
+			return nil, nil
+		}
+		return nil, ErrDeliveryInProgress
+	}
+
+	if digested {
+		result := make(chan error, 1)
+		err = digestNotifier.SendDigest(ctx, channel.Address, digest, text, func(err error) {
+			// The event is handled by the time the digest is sent
+			markErr := s.markDelivery(context.Background(), eventID, channel.Channel, err)
+			if err == nil && markErr != nil {
+				logger.Errorf(ctx, "domain/deliver", "send digest of %s: %v", eventID, markErr)
+			}
+			result <- err
+		})
+		if err == nil {
+			return result, nil
+		}
+		return nil, s.markDelivery(ctx, eventID, channel.Channel, err)
+	}
+
+	err = notifier.SendMessage(ctx, channel.Address, text)
+	return nil, s.markDelivery(ctx, eventID, channel.Channel, err)
+}
+
+// Mark the delivery with the result of sending, the sending error is returned
+func (s *Service) markDelivery(ctx context.Context, eventID model.EventID, channel model.Channel, err error) error {
+	if err != nil {
+		markErr := s.delivery.MarkDeliveryFailed(ctx, eventID, channel, err.Error())
+		if markErr != nil {
+			logger.Errorf(ctx, "domain/deliver", "mark delivery of %s failed: %v", eventID, markErr)
+		}
+		return err
+	}
+
+	return s.delivery.MarkDeliverySent(ctx, eventID, channel)
+}
+
+// Get the key status updates of the same order are merged by, empty for notifications not about an order
+func digestKey(data model.NotificationData) string {
+	if data.OrderID == 0 {
+		return ""
+	}
+	return fmt.Sprintf("order:%d", data.OrderID)
+}
@@ -0,0 +1,260 @@
+package domain
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// Key of a delivery of an event notification through a channel
+type deliveryKey struct {
+	eventID model.EventID
+	channel model.Channel
+}
+
+// In-memory repository of deliveries claimed like the postgres one, leases never expire
+type fakeDeliveryRepository struct {
+	mu     sync.Mutex
+	states map[deliveryKey]model.DeliveryState
+	leases map[deliveryKey]time.Duration
+}
+
+func newFakeDeliveryRepository() *fakeDeliveryRepository {
+	return &fakeDeliveryRepository{
+		states: make(map[deliveryKey]model.DeliveryState),
+		leases: make(map[deliveryKey]time.Duration),
+	}
+}
+
+func (r *fakeDeliveryRepository) ClaimDelivery(_ context.Context, eventID model.EventID, channel model.Channel, lease time.Duration) (bool, model.DeliveryState, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	key := deliveryKey{eventID: eventID, channel: channel}
+	state, ok := r.states[key]
+	if ok && state != model.DeliveryFailed {
+		return false, state, nil
+	}
+	r.states[key] = model.DeliveryPending
+	r.leases[key] = lease
+	return true, model.DeliveryPending, nil
+}
+
+func (r *fakeDeliveryRepository) MarkDeliverySent(_ context.Context, eventID model.EventID, channel model.Channel) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.states[deliveryKey{eventID: eventID, channel: channel}] = model.DeliverySent
+	return nil
+}
+
+func (r *fakeDeliveryRepository) MarkDeliveryFailed(_ context.Context, eventID model.EventID, channel model.Channel, _ string) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.states[deliveryKey{eventID: eventID, channel: channel}] = model.DeliveryFailed
+	return nil
+}
+
+func (r *fakeDeliveryRepository) state(eventID model.EventID, channel model.Channel) model.DeliveryState {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.states[deliveryKey{eventID: eventID, channel: channel}]
+}
+
+func (r *fakeDeliveryRepository) lease(eventID model.EventID, channel model.Channel) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.leases[deliveryKey{eventID: eventID, channel: channel}]
+}
+
+// Notifier keeping sent messages, the first sends fail while failures are left
+type fakeNotifier struct {
+	mu       sync.Mutex
+	failures int
+	sent     []string
+}
+
+func (n *fakeNotifier) SendMessage(_ context.Context, address string, message string) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	if n.failures > 0 {
+		n.failures--
+		return errors.New("channel is down")
+	}
+	n.sent = append(n.sent, address+": "+message)
+	return nil
+}
+
+func (n *fakeNotifier) messages() []string {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	return append([]string(nil), n.sent...)
+}
+
+func newDeliveryService(delivery DeliveryRepository) *Service {
+	return NewService(&fakeMessageRepository{}, nil, nil, nil, nil, delivery, nil, nil, nil, nil)
+}
+
+func TestService_Deliver_FirstDelivery_SentAndMarked(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	delivery := newFakeDeliveryRepository()
+	notifier := &fakeNotifier{}
+	service := newDeliveryService(delivery)
+	channel := model.ChannelPreference{Channel: model.ChannelEmail, Enabled: true, Address: "user@example.com"}
+
+	// Act
+	_, err := service.deliver(ctx, "order:1:paid", channel, notifier, "", "Order 1 is paid")
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, []string{"user@example.com: Order 1 is paid"}, notifier.messages())
+	require.Equal(t, model.DeliverySent, delivery.state("order:1:paid", model.ChannelEmail))
+}
+
+func TestService_Deliver_DuplicateEvent_SentOnce(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	delivery := newFakeDeliveryRepository()
+	notifier := &fakeNotifier{}
+	service := newDeliveryService(delivery)
+	channel := model.ChannelPreference{Channel: model.ChannelEmail, Enabled: true, Address: "user@example.com"}
+	_, err := service.deliver(ctx, "order:1:paid", channel, notifier, "", "Order 1 is paid")
+	require.NoError(t, err)
+
+	// Act
+	_, err = service.deliver(ctx, "order:1:paid", channel, notifier, "", "Order 1 is paid")
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, notifier.messages(), 1)
+}
+
+func TestService_Deliver_ClaimedByAnotherConsumer_InProgress(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	delivery := newFakeDeliveryRepository()
+	notifier := &fakeNotifier{}
+	service := newDeliveryService(delivery)
+	channel := model.ChannelPreference{Channel: model.ChannelEmail, Enabled: true, Address: "user@example.com"}
+	claimed, _, err := delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelEmail, deliveryLease)
+	require.NoError(t, err)
+	require.True(t, claimed)
+
+	// Act
+	_, err = service.deliver(ctx, "order:1:paid", channel, notifier, "", "Order 1 is paid")
+
+	// Assert
+	require.ErrorIs(t, err, ErrDeliveryInProgress)
+	require.Empty(t, notifier.messages())
+}
+
+func TestService_Deliver_FailedSend_SentOnRetry(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	delivery := newFakeDeliveryRepository()
+	notifier := &fakeNotifier{failures: 1}
+	service := newDeliveryService(delivery)
+	channel := model.ChannelPreference{Channel: model.ChannelEmail, Enabled: true, Address: "user@example.com"}
+
+	// Act
+	_, firstErr := service.deliver(ctx, "order:1:paid", channel, notifier, "", "Order 1 is paid")
+	stateAfterFailure := delivery.state("order:1:paid", model.ChannelEmail)
+	_, retryErr := service.deliver(ctx, "order:1:paid", channel, notifier, "", "Order 1 is paid")
+
+	// Assert
+	require.Error(t, firstErr)
+	require.Equal(t, model.DeliveryFailed, stateAfterFailure)
+	require.NoError(t, retryErr)
+	require.Equal(t, []string{"user@example.com: Order 1 is paid"}, notifier.messages())
+	require.Equal(t, model.DeliverySent, delivery.state("order:1:paid", model.ChannelEmail))
+}
+
+// Notifier keeping digests until the test sends them
+type fakeDigestNotifier struct {
+	fakeNotifier
+	acks []func(err error)
+}
+
+func (n *fakeDigestNotifier) SendDigest(_ context.Context, _ string, _ string, _ string, sent func(err error)) error {
+	n.mu.Lock()
+	defer n.mu.Unlock()
+
+	n.acks = append(n.acks, sent)
+	return nil
+}
+
+func (n *fakeDigestNotifier) MaxDigestDelay() time.Duration {
+	return time.Minute
+}
+
+// Send the queued digests with the result
+func (n *fakeDigestNotifier) flush(err error) {
+	n.mu.Lock()
+	acks := n.acks
+	n.acks = nil
+	n.mu.Unlock()
+
+	for _, ack := range acks {
+		ack(err)
+	}
+}
+
+func TestService_Deliver_Digest_MarkedWhenSent(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		sendErr error
+		state   model.DeliveryState
+	}{
+		{
+			name:  "sent digest marks the delivery sent",
+			state: model.DeliverySent,
+		},
+		{
+			name:    "failed digest marks the delivery failed",
+			sendErr: errors.New("chat not found"),
+			state:   model.DeliveryFailed,
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			ctx := context.Background()
+			delivery := newFakeDeliveryRepository()
+			notifier := &fakeDigestNotifier{}
+			service := newDeliveryService(delivery)
+			channel := model.ChannelPreference{Channel: model.ChannelTelegram, Enabled: true, Address: "100"}
+
+			// Act
+			result, err := service.deliver(ctx, "order:1:paid", channel, notifier, "order:1", "Order 1 is paid")
+			stateBeforeFlush := delivery.state("order:1:paid", model.ChannelTelegram)
+			notifier.flush(tt.sendErr)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, model.DeliveryPending, stateBeforeFlush)
+			require.Equal(t, tt.sendErr, <-result)
+			require.Equal(t, tt.state, delivery.state("order:1:paid", model.ChannelTelegram))
+			require.Equal(t, deliveryLease+time.Minute, delivery.lease("order:1:paid", model.ChannelTelegram))
+		})
+	}
+}
@@ -3,30 +3,88 @@ package domain
 import (
 	"context"
 	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/broadcast"
 	"time"
 )
 
 // Describe repository for working with messages
 type MessageRepository interface {
-	Save(ctx context.Context, message model.OrderStatusMessage) (model.MessageID, error)
-	GetHistoryWithPeriod(ctx context.Context, userID model.UserID, from time.Time, to time.Time) ([]model.OrderStatusMessage, error)
+	Save(ctx context.Context, message model.OrderStatusMessage) (model.OrderStatusMessage, bool, error)
+	GetHistory(ctx context.Context, filter model.HistoryFilter) ([]model.OrderStatusMessage, error)
+	GetRecentOrders(ctx context.Context, userID model.UserID, limit uint64) ([]model.OrderID, error)
 }
 
-// Describe a client that will notify user about events
+// Describe repository of Telegram chats linked to users
+type TelegramRepository interface {
+	SaveLinkCode(ctx context.Context, code model.LinkCode) error
+	UseLinkCode(ctx context.Context, code string) (model.LinkCode, bool, error)
+	LinkChat(ctx context.Context, chatID model.ChatID, userID model.UserID) error
+	GetChatUser(ctx context.Context, chatID model.ChatID) (model.UserID, bool, error)
+	UnlinkChat(ctx context.Context, chatID model.ChatID) error
+}
+
+// Describe the LOMS client managing orders
+type OrderClient interface {
+	GetOrder(ctx context.Context, orderID model.OrderID) (model.Order, error)
+	CancelOrder(ctx context.Context, orderID model.OrderID) error
+}
+
+// Describe repository tracking sending of notifications per event
+type DeliveryRepository interface {
+	ClaimDelivery(ctx context.Context, eventID model.EventID, channel model.Channel, lease time.Duration) (bool, model.DeliveryState, error)
+	MarkDeliverySent(ctx context.Context, eventID model.EventID, channel model.Channel) error
+	MarkDeliveryFailed(ctx context.Context, eventID model.EventID, channel model.Channel, reason string) error
+}
+
+// Describe repository of notification preferences of users
+type PreferencesRepository interface {
+	GetPreferences(ctx context.Context, userID model.UserID) (model.Preferences, error)
+	SetPreferences(ctx context.Context, prefs model.Preferences) error
+}
+
+// Describe templates of notifications
+type Renderer interface {
+	Render(channel model.Channel, locale model.Locale, kind model.NotificationKind, data model.NotificationData) (string, error)
+}
+
+// Describe repository of messages that failed to be handled
+type DeadLetterRepository interface {
+	SaveDeadLetter(ctx context.Context, letter model.DeadLetter) error
+	ListDeadLetters(ctx context.Context, afterID model.DeadLetterID, limit uint64, includeReplayed bool) ([]model.DeadLetter, error)
+	GetDeadLetters(ctx context.Context, ids []model.DeadLetterID) ([]model.DeadLetter, error)
+	ReplayDeadLetter(ctx context.Context, id model.DeadLetterID, replayedAt time.Time, publish func(letter model.DeadLetter) error) (bool, error)
+}
+
+// Describe a publisher putting messages back to their topics
+type MessagePublisher interface {
+	Publish(ctx context.Context, topic string, key []byte, value []byte) error
+}
+
+// Describe a client that will notify user about events through a channel,
+// the address is the user's address in the channel
 type Notifier interface {
-	SendMessage(message string) error
+	SendMessage(ctx context.Context, address string, message string) error
+}
+
+// Describe a notifier that can merge messages with the same key sent within a short time into one.
+// A message is taken for sending, sent is called with the result once its digest is sent
+// no later than the max digest delay after it is taken
+type DigestNotifier interface {
+	SendDigest(ctx context.Context, address string, key string, message string, sent func(err error)) error
+	MaxDigestDelay() time.Duration
 }
 
-// Describe a cache key
+// Describe a cache key, the version of the user's history makes keys
+// cached before the user's last saved message unreachable
 type CacheKey struct {
-	UserID int64
-	From   time.Time
-	To     time.Time
+	UserID  int64
+	Version uint64
+	Filter  string
 }
 
 // Describe a cache value
 type CacheVal struct {
-	items []model.OrderStatusMessage
+	page model.HistoryPage
 }
 
 // Describe cache
@@ -40,16 +98,45 @@ type Cacher interface {
 
 // Implement business-logic
 type Service struct {
-	message  MessageRepository
-	notifier Notifier
-	cache    Cacher
+	message     MessageRepository
+	notifiers   map[model.Channel]Notifier
+	cache       Cacher
+	versions    *historyVersions
+	stream      *broadcast.Hub[model.UserID, model.OrderStatusMessage]
+	deadLetters DeadLetterRepository
+	publisher   MessagePublisher
+	delivery    DeliveryRepository
+	preferences PreferencesRepository
+	templates   Renderer
+	chats       TelegramRepository
+	orders      OrderClient
 }
 
 // Create new service instance
-func NewService(message MessageRepository, notifier Notifier, cache Cacher) *Service {
+func NewService(
+	message MessageRepository,
+	notifiers map[model.Channel]Notifier,
+	cache Cacher,
+	deadLetters DeadLetterRepository,
+	publisher MessagePublisher,
+	delivery DeliveryRepository,
+	preferences PreferencesRepository,
+	templates Renderer,
+	chats TelegramRepository,
+	orders OrderClient,
+) *Service {
 	return &Service{
-		message:  message,
-		notifier: notifier,
-		cache:    cache,
+		message:     message,
+		notifiers:   notifiers,
+		cache:       cache,
+		versions:    newHistoryVersions(historyVersionsCapacity),
+		stream:      broadcast.NewHub[model.UserID, model.OrderStatusMessage](subscriberBuffer),
+		deadLetters: deadLetters,
+		publisher:   publisher,
+		delivery:    delivery,
+		preferences: preferences,
+		templates:   templates,
+		chats:       chats,
+		orders:      orders,
 	}
 }
@@ -2,31 +2,82 @@ package domain
 
 import (
 	"context"
+	"fmt"
+	"route256/libs/apperr"
 	"route256/notifications/internal/model"
+	"strings"
 	"time"
 )
 
-// Get user notifications history
-func (s *Service) GetHistoryWithPeriod(ctx context.Context, userID model.UserID, from time.Time, to time.Time) ([]model.OrderStatusMessage, error) {
+const (
+	// Page size of requests without one
+	defaultHistoryPageSize = 100
+)
+
+var (
+	ErrInvalidPeriod = apperr.New(apperr.InvalidArgument, "history period starts after it ends")
+)
+
+// Get a page of user notifications history
+func (s *Service) GetHistory(ctx context.Context, filter model.HistoryFilter) (model.HistoryPage, error) {
+	if !filter.From.IsZero() && !filter.To.IsZero() && filter.From.After(filter.To) {
+		return model.HistoryPage{}, ErrInvalidPeriod
+	}
+	if filter.Limit == 0 {
+		filter.Limit = defaultHistoryPageSize
+	}
+
 	cacheKey := CacheKey{
-		UserID: int64(userID),
-		From:   from,
-		To:     to,
+		UserID:  int64(filter.UserID),
+		Version: s.versions.get(filter.UserID),
+		Filter:  historyFilterKey(filter),
 	}
 	// try to get from cache
 	cacheVal, ok := s.cache.Get(cacheKey)
 	if ok {
-		return cacheVal.items, nil
+		return cacheVal.page, nil
 	}
-	// if not in cache, get from db
-	messages, err := s.message.GetHistoryWithPeriod(ctx, userID, from, to)
+
+	// if not in cache, get from db, one message more tells whether a next page exists
+	query := filter
+	query.Limit = filter.Limit + 1
+	messages, err := s.message.GetHistory(ctx, query)
 	if err != nil {
-		return nil, err
+		return model.HistoryPage{}, err
 	}
 
-	if len(messages) != 0 {
-		// Save to cache
-		s.cache.Set(cacheKey, CacheVal{items: messages})
+	page := model.HistoryPage{Messages: messages}
+	if uint64(len(messages)) > filter.Limit {
+		page.Messages = messages[:filter.Limit]
+		last := page.Messages[len(page.Messages)-1]
+		page.Next = &model.HistoryCursor{CreatedAt: last.CreatedAt, ID: last.ID}
 	}
-	return messages, nil
+
+	// Save to cache, empty histories too
+	s.cache.Set(cacheKey, CacheVal{page: page})
+	return page, nil
+}
+
+// Build a comparable cache key of the filter
+func historyFilterKey(filter model.HistoryFilter) string {
+	statuses := make([]string, 0, len(filter.Statuses))
+	for _, status := range filter.Statuses {
+		statuses = append(statuses, string(status))
+	}
+
+	after := ""
+	if filter.After != nil {
+		after = fmt.Sprintf("%s/%d", filter.After.CreatedAt.Format(time.RFC3339Nano), filter.After.ID)
+	}
+
+	return fmt.Sprintf("%s|%s|%d|%s|%d|%s|%d|%t",
+		filter.From.Format(time.RFC3339Nano),
+		filter.To.Format(time.RFC3339Nano),
+		filter.OrderID,
+		strings.Join(statuses, ","),
+		filter.AfterID,
+		after,
+		filter.Limit,
+		filter.Newest,
+	)
 }
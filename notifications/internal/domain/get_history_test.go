@@ -0,0 +1,166 @@
+package domain
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/cache/lru"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// In-memory message repository keeping one message per event and counting history reads
+type fakeMessageRepository struct {
+	mu       sync.Mutex
+	messages []model.OrderStatusMessage
+	reads    int
+}
+
+func (r *fakeMessageRepository) Save(_ context.Context, message model.OrderStatusMessage) (model.OrderStatusMessage, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	for _, saved := range r.messages {
+		if saved.EventID == message.EventID {
+			return saved, false, nil
+		}
+	}
+	message.ID = model.MessageID(len(r.messages) + 1)
+	message.CreatedAt = time.Date(2023, 9, 1, 0, 0, len(r.messages), 0, time.UTC)
+	r.messages = append(r.messages, message)
+	return message, true, nil
+}
+
+func (r *fakeMessageRepository) GetHistory(_ context.Context, filter model.HistoryFilter) ([]model.OrderStatusMessage, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.reads++
+	var out []model.OrderStatusMessage
+	for _, message := range r.messages {
+		if message.UserID != filter.UserID {
+			continue
+		}
+		if message.ID <= filter.AfterID || filter.After != nil && message.ID <= filter.After.ID {
+			continue
+		}
+		if uint64(len(out)) == filter.Limit {
+			break
+		}
+		out = append(out, message)
+	}
+	return out, nil
+}
+
+func (r *fakeMessageRepository) GetRecentOrders(_ context.Context, userID model.UserID, limit uint64) ([]model.OrderID, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	var out []model.OrderID
+	seen := make(map[model.OrderID]struct{})
+	for i := len(r.messages) - 1; i >= 0 && uint64(len(out)) < limit; i-- {
+		message := r.messages[i]
+		if _, ok := seen[message.OrderID]; ok || message.UserID != userID {
+			continue
+		}
+		seen[message.OrderID] = struct{}{}
+		out = append(out, message.OrderID)
+	}
+	return out, nil
+}
+
+func newHistoryService(repo MessageRepository) *Service {
+	cache := lru.NewLRUCache[CacheKey, CacheVal](10, lru.WithTTL(time.Minute))
+	return NewService(repo, nil, cache, nil, nil, nil, nil, nil, nil, nil)
+}
+
+func TestService_GetHistory_SavedMessage_VisibleImmediately(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	repo := &fakeMessageRepository{}
+	service := newHistoryService(repo)
+	filter := model.HistoryFilter{UserID: 1}
+	message := model.OrderStatusMessage{EventID: "order:1:new", UserID: 1, OrderID: 1, Status: model.CreatedStatus}
+
+	before, err := service.GetHistory(ctx, filter)
+	require.NoError(t, err)
+
+	// Act
+	_, err = service.Save(ctx, message)
+	require.NoError(t, err)
+	after, err := service.GetHistory(ctx, filter)
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, before.Messages)
+	require.Len(t, after.Messages, 1)
+	require.Equal(t, message.EventID, after.Messages[0].EventID)
+}
+
+func TestService_GetHistory_EmptyHistory_Cached(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	repo := &fakeMessageRepository{}
+	service := newHistoryService(repo)
+	filter := model.HistoryFilter{UserID: 1}
+
+	// Act
+	_, err := service.GetHistory(ctx, filter)
+	require.NoError(t, err)
+	page, err := service.GetHistory(ctx, filter)
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, page.Messages)
+	require.Equal(t, 1, repo.reads)
+}
+
+func TestService_GetHistory_OtherUserSaved_Cached(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	repo := &fakeMessageRepository{}
+	service := newHistoryService(repo)
+	filter := model.HistoryFilter{UserID: 1}
+	_, err := service.GetHistory(ctx, filter)
+	require.NoError(t, err)
+
+	// Act
+	_, err = service.Save(ctx, model.OrderStatusMessage{EventID: "order:2:new", UserID: 2, OrderID: 2, Status: model.CreatedStatus})
+	require.NoError(t, err)
+	_, err = service.GetHistory(ctx, filter)
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 1, repo.reads)
+}
+
+func TestService_GetHistory_MoreThanPage_NextCursor(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	repo := &fakeMessageRepository{}
+	service := newHistoryService(repo)
+	for _, eventID := range []model.EventID{"order:1:new", "order:1:payed", "order:2:new"} {
+		_, err := service.Save(ctx, model.OrderStatusMessage{EventID: eventID, UserID: 1})
+		require.NoError(t, err)
+	}
+
+	// Act
+	first, err := service.GetHistory(ctx, model.HistoryFilter{UserID: 1, Limit: 2})
+	require.NoError(t, err)
+	require.NotNil(t, first.Next)
+	second, err := service.GetHistory(ctx, model.HistoryFilter{UserID: 1, Limit: 2, After: first.Next})
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, first.Messages, 2)
+	require.Equal(t, first.Messages[1].ID, first.Next.ID)
+	require.Len(t, second.Messages, 1)
+	require.Equal(t, model.EventID("order:2:new"), second.Messages[0].EventID)
+	require.Nil(t, second.Next)
+}
@@ -0,0 +1,50 @@
+package domain
+
+import (
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/cache/lru"
+	"sync"
+)
+
+// Users whose history versions are kept, the least recently used ones are evicted
+const historyVersionsCapacity = 100_000
+
+// Versions of users' histories, a version changes on every saved message.
+// Versions are taken from one growing sequence, so a user evicted and seen again
+// gets a version never used before and results cached under old versions are not served
+type historyVersions struct {
+	mu       sync.Mutex
+	last     uint64
+	versions *lru.LRUCache[model.UserID, uint64]
+}
+
+func newHistoryVersions(capacity int) *historyVersions {
+	return &historyVersions{
+		versions: lru.NewLRUCache[model.UserID, uint64](capacity, lru.WithName("history_versions")),
+	}
+}
+
+// Get the current version of the user's history
+func (v *historyVersions) get(userID model.UserID) uint64 {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	if version, ok := v.versions.Get(userID); ok {
+		return version
+	}
+	return v.next(userID)
+}
+
+// Move the user's history to a new version
+func (v *historyVersions) bump(userID model.UserID) {
+	v.mu.Lock()
+	defer v.mu.Unlock()
+
+	v.next(userID)
+}
+
+func (v *historyVersions) next(userID model.UserID) uint64 {
+	v.last++
+	_ = v.versions.Set(userID, v.last)
+	return v.last
+}
@@ -0,0 +1,39 @@
+package domain
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHistoryVersions_Bump_NewVersion(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	versions := newHistoryVersions(10)
+	before := versions.get(7)
+
+	// Act
+	versions.bump(7)
+
+	// Assert
+	require.NotEqual(t, before, versions.get(7))
+	require.Equal(t, versions.get(7), versions.get(7))
+}
+
+func TestHistoryVersions_EvictedUser_VersionNotReused(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	versions := newHistoryVersions(2)
+	seen := map[uint64]struct{}{versions.get(7): {}}
+	versions.bump(7)
+	seen[versions.get(7)] = struct{}{}
+
+	// Act
+	versions.bump(8)
+	versions.bump(9)
+	version := versions.get(7)
+
+	// Assert
+	require.NotContains(t, seen, version)
+	require.LessOrEqual(t, versions.versions.Count(), 2)
+}
@@ -0,0 +1,25 @@
+package domain
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+)
+
+// Remind user about goods left in the cart
+func (s *Service) NotifyAbandonedCart(ctx context.Context, message model.AbandonedCartMessage) (model.PendingDeliveries, error) {
+	prefs, err := s.preferences.GetPreferences(ctx, message.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	var count int
+	for _, item := range message.Items {
+		count += int(item.Count)
+	}
+
+	return s.notify(ctx, message.EventID, prefs, model.KindAbandonedCart, model.NotificationData{
+		UserID:    message.UserID,
+		ItemCount: count,
+		UpdatedAt: message.UpdatedAt,
+	})
+}
@@ -0,0 +1,305 @@
+package domain
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Renderer writing the kind and the message of the notification
+type fakeRenderer struct{}
+
+func (fakeRenderer) Render(_ model.Channel, _ model.Locale, kind model.NotificationKind, data model.NotificationData) (string, error) {
+	return string(kind) + " " + data.Message, nil
+}
+
+// In-memory repository of preferences
+type fakePreferencesRepository struct {
+	mu    sync.Mutex
+	prefs map[model.UserID]model.Preferences
+}
+
+func (r *fakePreferencesRepository) GetPreferences(_ context.Context, userID model.UserID) (model.Preferences, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	prefs, ok := r.prefs[userID]
+	if !ok {
+		return model.Preferences{UserID: userID}, nil
+	}
+	return prefs, nil
+}
+
+func (r *fakePreferencesRepository) SetPreferences(_ context.Context, prefs model.Preferences) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.prefs == nil {
+		r.prefs = make(map[model.UserID]model.Preferences)
+	}
+	r.prefs[prefs.UserID] = prefs
+	return nil
+}
+
+func newNotifyService(notifiers map[model.Channel]Notifier, chats TelegramRepository, prefs PreferencesRepository) *Service {
+	return NewService(&fakeMessageRepository{}, notifiers, nil, nil, nil, newFakeDeliveryRepository(), prefs, fakeRenderer{}, chats, nil)
+}
+
+func TestService_Notify_NoChannels_NotSent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	telegram := &fakeNotifier{}
+	service := newNotifyService(map[model.Channel]Notifier{model.ChannelTelegram: telegram}, newFakeTelegramRepository(), nil)
+	prefs := model.Preferences{
+		UserID:   7,
+		Channels: []model.ChannelPreference{{Channel: model.ChannelTelegram, Address: "100", Enabled: false}},
+	}
+
+	// Act
+	_, err := service.notify(ctx, "order:1:payed", prefs, model.StatusKind(model.PaidStatus), model.NotificationData{UserID: 7, OrderID: 1})
+
+	// Assert
+	require.NoError(t, err)
+	require.Empty(t, telegram.messages())
+}
+
+func TestService_Notify_TelegramChat(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		address string
+		sent    []string
+	}{
+		{
+			name:    "linked chat is notified",
+			address: "100",
+			sent:    []string{"100: payed paid"},
+		},
+		{
+			name:    "chat of another user is skipped",
+			address: "200",
+		},
+		{
+			name:    "unlinked chat is skipped",
+			address: "300",
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			ctx := context.Background()
+			chats := newFakeTelegramRepository()
+			require.NoError(t, chats.LinkChat(ctx, 100, 7))
+			require.NoError(t, chats.LinkChat(ctx, 200, 8))
+			telegram := &fakeNotifier{}
+			service := newNotifyService(map[model.Channel]Notifier{model.ChannelTelegram: telegram}, chats, nil)
+			prefs := model.Preferences{
+				UserID:   7,
+				Channels: []model.ChannelPreference{{Channel: model.ChannelTelegram, Address: tt.address, Enabled: true}},
+			}
+
+			// Act
+			_, err := service.notify(ctx, "order:1:payed", prefs, model.StatusKind(model.PaidStatus), model.NotificationData{UserID: 7, OrderID: 1, Message: "paid"})
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tt.sent, telegram.messages())
+		})
+	}
+}
+
+func TestService_Notify_NoNotifier_ErrorAndOtherChannelsSent(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	email := &fakeNotifier{}
+	service := newNotifyService(map[model.Channel]Notifier{model.ChannelEmail: email}, newFakeTelegramRepository(), nil)
+	prefs := model.Preferences{
+		UserID: 7,
+		Channels: []model.ChannelPreference{
+			{Channel: model.ChannelWebhook, Address: "https://example.com/hook", Enabled: true},
+			{Channel: model.ChannelEmail, Address: "user@example.com", Enabled: true},
+		},
+	}
+
+	// Act
+	_, err := service.notify(ctx, "order:1:payed", prefs, model.StatusKind(model.PaidStatus), model.NotificationData{UserID: 7, OrderID: 1, Message: "paid"})
+
+	// Assert
+	require.ErrorIs(t, err, ErrNoNotifier)
+	require.Equal(t, []string{"user@example.com: payed paid"}, email.messages())
+}
+
+// Notifier returning once every notifier of the group is called
+type barrierNotifier struct {
+	arrived *sync.WaitGroup
+	release chan struct{}
+}
+
+func (n barrierNotifier) SendMessage(ctx context.Context, _ string, _ string) error {
+	n.arrived.Done()
+	select {
+	case <-n.release:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func TestService_Notify_Channels_SentConcurrently(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+	arrived := &sync.WaitGroup{}
+	arrived.Add(2)
+	release := make(chan struct{})
+	notifiers := map[model.Channel]Notifier{
+		model.ChannelEmail:   barrierNotifier{arrived: arrived, release: release},
+		model.ChannelWebhook: barrierNotifier{arrived: arrived, release: release},
+	}
+	service := newNotifyService(notifiers, newFakeTelegramRepository(), nil)
+	prefs := model.Preferences{
+		UserID: 7,
+		Channels: []model.ChannelPreference{
+			{Channel: model.ChannelEmail, Address: "user@example.com", Enabled: true},
+			{Channel: model.ChannelWebhook, Address: "https://example.com/hook", Enabled: true},
+		},
+	}
+
+	// Act
+	// A channel is released only after both are called, sending them one by one would time out
+	go func() {
+		arrived.Wait()
+		close(release)
+	}()
+	_, err := service.notify(ctx, "order:1:payed", prefs, model.StatusKind(model.PaidStatus), model.NotificationData{UserID: 7, OrderID: 1})
+
+	// Assert
+	require.NoError(t, err)
+}
+
+func TestService_SetPreferences_Channels(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		channel model.ChannelPreference
+		err     error
+	}{
+		{
+			name:    "public webhook is saved",
+			channel: model.ChannelPreference{Channel: model.ChannelWebhook, Address: "https://example.com/hook", Enabled: true},
+		},
+		{
+			name:    "loopback webhook is rejected",
+			channel: model.ChannelPreference{Channel: model.ChannelWebhook, Address: "http://127.0.0.1:8080/hook", Enabled: true},
+			err:     ErrInvalidChannelAddress,
+		},
+		{
+			name:    "metadata webhook is rejected",
+			channel: model.ChannelPreference{Channel: model.ChannelWebhook, Address: "http://169.254.169.254/latest", Enabled: true},
+			err:     ErrInvalidChannelAddress,
+		},
+		{
+			name:    "private webhook is rejected",
+			channel: model.ChannelPreference{Channel: model.ChannelWebhook, Address: "http://10.0.0.5/hook", Enabled: true},
+			err:     ErrInvalidChannelAddress,
+		},
+		{
+			name:    "channel without a notifier is rejected",
+			channel: model.ChannelPreference{Channel: model.ChannelEmail, Address: "user@example.com", Enabled: true},
+			err:     ErrChannelUnavailable,
+		},
+		{
+			name:    "disabled channel without a notifier is saved",
+			channel: model.ChannelPreference{Channel: model.ChannelEmail, Address: "user@example.com", Enabled: false},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			ctx := context.Background()
+			prefs := &fakePreferencesRepository{}
+			service := newNotifyService(map[model.Channel]Notifier{model.ChannelWebhook: &fakeNotifier{}}, newFakeTelegramRepository(), prefs)
+
+			// Act
+			err := service.SetPreferences(ctx, model.Preferences{UserID: 7, Channels: []model.ChannelPreference{tt.channel}})
+
+			// Assert
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestService_SetPreferences_TelegramChat(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		channel model.ChannelPreference
+		err     error
+	}{
+		{
+			name:    "linked chat is saved",
+			channel: model.ChannelPreference{Channel: model.ChannelTelegram, Address: "100", Enabled: true},
+		},
+		{
+			name:    "chat of another user is rejected",
+			channel: model.ChannelPreference{Channel: model.ChannelTelegram, Address: "200", Enabled: true},
+			err:     ErrChatNotLinkedToUser,
+		},
+		{
+			name:    "chat that is not linked is rejected",
+			channel: model.ChannelPreference{Channel: model.ChannelTelegram, Address: "300", Enabled: true},
+			err:     ErrChatNotLinkedToUser,
+		},
+		{
+			name:    "disabled chat is saved",
+			channel: model.ChannelPreference{Channel: model.ChannelTelegram, Address: "300", Enabled: false},
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			ctx := context.Background()
+			chats := newFakeTelegramRepository()
+			require.NoError(t, chats.LinkChat(ctx, 100, 7))
+			require.NoError(t, chats.LinkChat(ctx, 200, 8))
+			prefs := &fakePreferencesRepository{}
+			service := newNotifyService(map[model.Channel]Notifier{model.ChannelTelegram: &fakeNotifier{}}, chats, prefs)
+
+			// Act
+			err := service.SetPreferences(ctx, model.Preferences{UserID: 7, Channels: []model.ChannelPreference{tt.channel}})
+
+			// Assert
+			if tt.err != nil {
+				require.ErrorIs(t, err, tt.err)
+				return
+			}
+			require.NoError(t, err)
+			saved, err := prefs.GetPreferences(ctx, 7)
+			require.NoError(t, err)
+			require.Equal(t, []model.ChannelPreference{tt.channel}, saved.Channels)
+		})
+	}
+}
@@ -2,22 +2,24 @@ package domain
 
 import (
 	"context"
-	"fmt"
 	"route256/notifications/internal/model"
 )
 
-// Notify user about order status
-func (s *Service) NotifyUser(ctx context.Context, message model.OrderStatusMessage) error {
-	strMessage := fmt.Sprintf(
-		"Your id: %v, your order: %v have the following status: %v. More information: %v",
-		message.UserID,
-		message.OrderID,
-		message.Status,
-		message.Message,
-	)
-	err := s.notifier.SendMessage(strMessage)
+// Notify user about order status through the enabled channels, unless the user opted out of the status.
+// Notifications taken for digests are returned pending until the digests are sent
+func (s *Service) NotifyUser(ctx context.Context, message model.OrderStatusMessage) (model.PendingDeliveries, error) {
+	prefs, err := s.preferences.GetPreferences(ctx, message.UserID)
 	if err != nil {
-		return err
+		return nil, err
 	}
-	return nil
+	if !prefs.Wants(message.Status) {
+		return nil, nil
+	}
+
+	return s.notify(ctx, message.EventID, prefs, model.StatusKind(message.Status), model.NotificationData{
+		UserID:  message.UserID,
+		OrderID: message.OrderID,
+		Status:  message.Status,
+		Message: message.Message,
+	})
 }
@@ -0,0 +1,85 @@
+package domain
+
+import (
+	"context"
+	"net/mail"
+	"net/url"
+	"route256/libs/apperr"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/netguard"
+	"strconv"
+)
+
+var (
+	ErrInvalidChannelAddress = apperr.New(apperr.InvalidArgument, "channel address is not valid")
+	ErrDuplicatePreference   = apperr.New(apperr.InvalidArgument, "preference is set more than once")
+	ErrChatNotLinkedToUser   = apperr.New(apperr.InvalidArgument, "telegram chat is not linked to the user")
+	ErrChannelUnavailable    = apperr.New(apperr.InvalidArgument, "channel is not available")
+)
+
+// Get channels and statuses the user is notified about
+func (s *Service) GetPreferences(ctx context.Context, userID model.UserID) (model.Preferences, error) {
+	return s.preferences.GetPreferences(ctx, userID)
+}
+
+// Replace channels and statuses the user is notified about
+func (s *Service) SetPreferences(ctx context.Context, prefs model.Preferences) error {
+	channels := make(map[model.Channel]struct{}, len(prefs.Channels))
+	for _, channel := range prefs.Channels {
+		if _, ok := channels[channel.Channel]; ok {
+			return ErrDuplicatePreference.WithDetails(map[string]string{"channel": string(channel.Channel)})
+		}
+		channels[channel.Channel] = struct{}{}
+
+		if !channel.Enabled && channel.Address == "" {
+			continue
+		}
+		if _, ok := s.notifiers[channel.Channel]; channel.Enabled && !ok {
+			return ErrChannelUnavailable.WithDetails(map[string]string{"channel": string(channel.Channel)})
+		}
+		if !validAddress(channel.Channel, channel.Address) {
+			return ErrInvalidChannelAddress.WithDetails(map[string]string{
+				"channel": string(channel.Channel),
+				"address": channel.Address,
+			})
+		}
+		if channel.Channel == model.ChannelTelegram && channel.Enabled {
+			// The chat is linked by sending a link code to the bot
+			linked, err := s.chatLinked(ctx, channel.Address, prefs.UserID)
+			if err != nil {
+				return err
+			}
+			if !linked {
+				return ErrChatNotLinkedToUser.WithDetails(map[string]string{"address": channel.Address})
+			}
+		}
+	}
+
+	statuses := make(map[model.OrderStatus]struct{}, len(prefs.Statuses))
+	for _, status := range prefs.Statuses {
+		if _, ok := statuses[status.Status]; ok {
+			return ErrDuplicatePreference.WithDetails(map[string]string{"status": string(status.Status)})
+		}
+		statuses[status.Status] = struct{}{}
+	}
+
+	return s.preferences.SetPreferences(ctx, prefs)
+}
+
+// Check the address format of the channel: a chat id, an email address or an absolute http(s) URL
+// of a host that is not internal
+func validAddress(channel model.Channel, address string) bool {
+	switch channel {
+	case model.ChannelTelegram:
+		chatID, err := strconv.ParseInt(address, 10, 64)
+		return err == nil && chatID != 0
+	case model.ChannelEmail:
+		parsed, err := mail.ParseAddress(address)
+		return err == nil && parsed.Address == address
+	case model.ChannelWebhook:
+		parsed, err := url.Parse(address)
+		return err == nil && (parsed.Scheme == "http" || parsed.Scheme == "https") && parsed.Host != "" &&
+			netguard.PublicHost(parsed.Hostname())
+	}
+	return false
+}
@@ -0,0 +1,11 @@
+package domain
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+)
+
+// Render the template of the notification kind for the channel with sample data
+func (s *Service) PreviewTemplate(_ context.Context, channel model.Channel, locale model.Locale, kind model.NotificationKind) (string, error) {
+	return s.templates.Render(channel, locale, kind, model.SampleNotificationData())
+}
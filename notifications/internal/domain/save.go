@@ -5,11 +5,19 @@ import (
 	"route256/notifications/internal/model"
 )
 
-// Save message to database
+// Save message to database and send it to the user's subscribers,
+// a redelivered event is not sent again
 func (s *Service) Save(ctx context.Context, message model.OrderStatusMessage) (model.MessageID, error) {
-	id, err := s.message.Save(ctx, message)
+	saved, inserted, err := s.message.Save(ctx, message)
 	if err != nil {
 		return 0, err
 	}
-	return id, nil
+	if !inserted {
+		return saved.ID, nil
+	}
+	// The version moves after the message is stored, so a history read
+	// before it is cached under the old version and never served again
+	s.versions.bump(saved.UserID)
+	s.stream.Publish(saved.UserID, saved)
+	return saved.ID, nil
 }
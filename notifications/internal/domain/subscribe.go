@@ -0,0 +1,79 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/apperr"
+	"route256/notifications/internal/model"
+)
+
+const (
+	// Messages waiting to be sent to a subscriber before it is dropped
+	subscriberBuffer = 64
+	// Messages read at once when a subscriber resumes
+	resumePageSize = 100
+)
+
+var (
+	ErrSlowSubscriber = apperr.New(apperr.ResourceExhausted, "subscriber does not keep up, resume with the last received id")
+)
+
+// Send the user's new messages until the context is done. With lastID the messages
+// saved after it are sent first. A subscriber that does not keep up gets ErrSlowSubscriber
+func (s *Service) Subscribe(ctx context.Context, userID model.UserID, lastID model.MessageID, send func(model.OrderStatusMessage) error) error {
+	// Subscribe before reading the history, so nothing saved meanwhile is lost
+	sub := s.stream.Subscribe(userID)
+	defer sub.Close()
+
+	replayed, err := s.resume(ctx, userID, lastID, send)
+	if err != nil {
+		return err
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-sub.Done():
+			return ErrSlowSubscriber
+		case message := <-sub.Values():
+			// Messages saved while the history was read come twice
+			if _, ok := replayed[message.ID]; ok {
+				continue
+			}
+			err = send(message)
+			if err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// Send the user's messages saved after lastID, ids of the sent ones are returned
+func (s *Service) resume(ctx context.Context, userID model.UserID, lastID model.MessageID, send func(model.OrderStatusMessage) error) (map[model.MessageID]struct{}, error) {
+	replayed := make(map[model.MessageID]struct{})
+	if lastID == 0 {
+		return replayed, nil
+	}
+
+	filter := model.HistoryFilter{UserID: userID, AfterID: lastID, Limit: resumePageSize}
+	for {
+		messages, err := s.message.GetHistory(ctx, filter)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, message := range messages {
+			err = send(message)
+			if err != nil {
+				return nil, err
+			}
+			replayed[message.ID] = struct{}{}
+		}
+
+		if uint64(len(messages)) < filter.Limit {
+			return replayed, nil
+		}
+		last := messages[len(messages)-1]
+		filter.After = &model.HistoryCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+}
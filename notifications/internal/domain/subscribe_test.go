@@ -0,0 +1,116 @@
+package domain
+
+import (
+	"context"
+	"fmt"
+	"route256/notifications/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_Subscribe_LastID_ResumesThenStreams(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := &fakeMessageRepository{}
+	service := newHistoryService(repo)
+	for _, eventID := range []model.EventID{"order:1:new", "order:1:payed", "order:2:new"} {
+		_, err := service.Save(ctx, model.OrderStatusMessage{EventID: eventID, UserID: 1})
+		require.NoError(t, err)
+	}
+
+	got := make(chan model.MessageID)
+	done := make(chan error)
+	go func() {
+		done <- service.Subscribe(ctx, 1, 1, func(message model.OrderStatusMessage) error {
+			got <- message.ID
+			return nil
+		})
+	}()
+
+	// Act
+	resumed := []model.MessageID{<-got, <-got}
+	_, err := service.Save(ctx, model.OrderStatusMessage{EventID: "order:2:payed", UserID: 1})
+	require.NoError(t, err)
+	_, err = service.Save(ctx, model.OrderStatusMessage{EventID: "order:3:new", UserID: 2})
+	require.NoError(t, err)
+	live := <-got
+	cancel()
+
+	// Assert
+	require.Equal(t, []model.MessageID{2, 3}, resumed)
+	require.Equal(t, model.MessageID(4), live)
+	require.NoError(t, <-done)
+}
+
+func TestService_Subscribe_SlowSubscriber_Dropped(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	repo := &fakeMessageRepository{}
+	service := newHistoryService(repo)
+	for _, eventID := range []model.EventID{"order:1:new", "order:1:payed"} {
+		_, err := service.Save(ctx, model.OrderStatusMessage{EventID: eventID, UserID: 1})
+		require.NoError(t, err)
+	}
+
+	got := make(chan model.MessageID)
+	done := make(chan error)
+	go func() {
+		done <- service.Subscribe(ctx, 1, 1, func(message model.OrderStatusMessage) error {
+			got <- message.ID
+			return nil
+		})
+	}()
+	<-got
+
+	// Act
+	for i := 0; i < subscriberBuffer+2; i++ {
+		_, err := service.Save(ctx, model.OrderStatusMessage{EventID: model.EventID(fmt.Sprintf("order:%d:new", 10+i)), UserID: 1})
+		require.NoError(t, err)
+	}
+	go func() {
+		for range got {
+		}
+	}()
+
+	// Assert
+	require.ErrorIs(t, <-done, ErrSlowSubscriber)
+}
+
+func TestService_Subscribe_RedeliveredEvent_NotStreamedAgain(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx, cancel := context.WithCancel(context.Background())
+	repo := &fakeMessageRepository{}
+	service := newHistoryService(repo)
+	for _, eventID := range []model.EventID{"order:1:new", "order:1:payed"} {
+		_, err := service.Save(ctx, model.OrderStatusMessage{EventID: eventID, UserID: 1})
+		require.NoError(t, err)
+	}
+
+	got := make(chan model.MessageID)
+	done := make(chan error)
+	go func() {
+		done <- service.Subscribe(ctx, 1, 1, func(message model.OrderStatusMessage) error {
+			got <- message.ID
+			return nil
+		})
+	}()
+	resumed := <-got
+
+	// Act
+	redelivered, err := service.Save(ctx, model.OrderStatusMessage{EventID: "order:1:new", UserID: 1})
+	require.NoError(t, err)
+	_, err = service.Save(ctx, model.OrderStatusMessage{EventID: "order:2:new", UserID: 1})
+	require.NoError(t, err)
+	live := <-got
+	cancel()
+
+	// Assert
+	require.Equal(t, model.MessageID(2), resumed)
+	require.Equal(t, model.MessageID(1), redelivered)
+	require.Equal(t, model.MessageID(3), live)
+	require.NoError(t, <-done)
+}
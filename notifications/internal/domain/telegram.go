@@ -0,0 +1,109 @@
+package domain
+
+import (
+	"context"
+	"crypto/rand"
+	"math/big"
+	"route256/libs/apperr"
+	"route256/notifications/internal/model"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	linkCodeTTL    = 10 * time.Minute
+	linkCodeLength = 8
+	// Letters and digits that are not confused with each other
+	linkCodeAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+)
+
+var (
+	ErrInvalidLinkCode = apperr.New(apperr.InvalidArgument, "link code is invalid or expired")
+	ErrChatNotLinked   = apperr.New(apperr.NotFound, "chat is not linked to a user")
+)
+
+// Create a one-time code the user sends to the bot to link a chat
+func (s *Service) CreateLinkCode(ctx context.Context, userID model.UserID) (model.LinkCode, error) {
+	code, err := randomCode(linkCodeLength)
+	if err != nil {
+		return model.LinkCode{}, err
+	}
+
+	linkCode := model.LinkCode{
+		Code:      code,
+		UserID:    userID,
+		ExpiresAt: time.Now().UTC().Add(linkCodeTTL),
+	}
+	err = s.chats.SaveLinkCode(ctx, linkCode)
+	if err != nil {
+		return model.LinkCode{}, err
+	}
+
+	return linkCode, nil
+}
+
+// Link the chat to the user of the code, the code can not be used again
+func (s *Service) LinkChat(ctx context.Context, chatID model.ChatID, code string) (model.UserID, error) {
+	linkCode, ok, err := s.chats.UseLinkCode(ctx, strings.ToUpper(code))
+	if err != nil {
+		return 0, err
+	}
+	if !ok || !time.Now().UTC().Before(linkCode.ExpiresAt) {
+		return 0, ErrInvalidLinkCode
+	}
+
+	err = s.chats.LinkChat(ctx, chatID, linkCode.UserID)
+	if err != nil {
+		return 0, err
+	}
+
+	return linkCode.UserID, nil
+}
+
+// Remove the link of the chat
+func (s *Service) UnlinkChat(ctx context.Context, chatID model.ChatID) error {
+	return s.chats.UnlinkChat(ctx, chatID)
+}
+
+// Get the user the chat is linked to
+func (s *Service) ChatUser(ctx context.Context, chatID model.ChatID) (model.UserID, error) {
+	userID, ok, err := s.chats.GetChatUser(ctx, chatID)
+	if err != nil {
+		return 0, err
+	}
+	if !ok {
+		return 0, ErrChatNotLinked
+	}
+	return userID, nil
+}
+
+// Generate a code of random letters of the alphabet
+func randomCode(length int) (string, error) {
+	max := big.NewInt(int64(len(linkCodeAlphabet)))
+	code := make([]byte, length)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, max)
+		if err != nil {
+			return "", errors.Wrap(err, "generate link code")
+		}
+		code[i] = linkCodeAlphabet[n.Int64()]
+	}
+	return string(code), nil
+}
+
+// Check if the Telegram chat of the address is linked to the user
+func (s *Service) chatLinked(ctx context.Context, address string, userID model.UserID) (bool, error) {
+	chatID, err := strconv.ParseInt(address, 10, 64)
+	if err != nil {
+		return false, nil
+	}
+
+	chatUser, ok, err := s.chats.GetChatUser(ctx, model.ChatID(chatID))
+	if err != nil {
+		return false, err
+	}
+	return ok && chatUser == userID, nil
+}
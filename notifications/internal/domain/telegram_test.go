@@ -0,0 +1,145 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/apperr"
+	"route256/notifications/internal/model"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// In-memory repository of linked chats
+type fakeTelegramRepository struct {
+	mu    sync.Mutex
+	codes map[string]model.LinkCode
+	chats map[model.ChatID]model.UserID
+}
+
+func newFakeTelegramRepository() *fakeTelegramRepository {
+	return &fakeTelegramRepository{
+		codes: make(map[string]model.LinkCode),
+		chats: make(map[model.ChatID]model.UserID),
+	}
+}
+
+func (r *fakeTelegramRepository) SaveLinkCode(_ context.Context, code model.LinkCode) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.codes[code.Code] = code
+	return nil
+}
+
+func (r *fakeTelegramRepository) UseLinkCode(_ context.Context, code string) (model.LinkCode, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	linkCode, ok := r.codes[code]
+	delete(r.codes, code)
+	return linkCode, ok, nil
+}
+
+func (r *fakeTelegramRepository) LinkChat(_ context.Context, chatID model.ChatID, userID model.UserID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	r.chats[chatID] = userID
+	return nil
+}
+
+func (r *fakeTelegramRepository) GetChatUser(_ context.Context, chatID model.ChatID) (model.UserID, bool, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	userID, ok := r.chats[chatID]
+	return userID, ok, nil
+}
+
+func (r *fakeTelegramRepository) UnlinkChat(_ context.Context, chatID model.ChatID) error {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.chats, chatID)
+	return nil
+}
+
+// Orders of LOMS by id
+type fakeOrderClient struct {
+	orders    map[model.OrderID]model.Order
+	cancelled []model.OrderID
+}
+
+func (c *fakeOrderClient) GetOrder(_ context.Context, orderID model.OrderID) (model.Order, error) {
+	order, ok := c.orders[orderID]
+	if !ok {
+		return model.Order{}, apperr.New(apperr.NotFound, "order not found")
+	}
+	return order, nil
+}
+
+func (c *fakeOrderClient) CancelOrder(_ context.Context, orderID model.OrderID) error {
+	c.cancelled = append(c.cancelled, orderID)
+	return nil
+}
+
+func newTelegramService(chats TelegramRepository, orders OrderClient) *Service {
+	return NewService(&fakeMessageRepository{}, nil, nil, nil, nil, nil, nil, nil, chats, orders)
+}
+
+func TestService_LinkChat_Code_LinkedOnce(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	chats := newFakeTelegramRepository()
+	service := newTelegramService(chats, nil)
+	code, err := service.CreateLinkCode(ctx, 7)
+	require.NoError(t, err)
+
+	// Act
+	userID, err := service.LinkChat(ctx, 100, code.Code)
+	require.NoError(t, err)
+	_, reuseErr := service.LinkChat(ctx, 200, code.Code)
+
+	// Assert
+	require.Equal(t, model.UserID(7), userID)
+	chatUser, err := service.ChatUser(ctx, 100)
+	require.NoError(t, err)
+	require.Equal(t, model.UserID(7), chatUser)
+	require.ErrorIs(t, reuseErr, ErrInvalidLinkCode)
+	_, err = service.ChatUser(ctx, 200)
+	require.ErrorIs(t, err, ErrChatNotLinked)
+}
+
+func TestService_LinkChat_ExpiredCode_Invalid(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	chats := newFakeTelegramRepository()
+	service := newTelegramService(chats, nil)
+	err := chats.SaveLinkCode(ctx, model.LinkCode{Code: "ABCD2345", UserID: 7, ExpiresAt: time.Now().UTC().Add(-time.Second)})
+	require.NoError(t, err)
+
+	// Act
+	_, err = service.LinkChat(ctx, 100, "abcd2345")
+
+	// Assert
+	require.ErrorIs(t, err, ErrInvalidLinkCode)
+}
+
+func TestService_CancelUserOrder_OtherUsersOrder_NotFound(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	orders := &fakeOrderClient{orders: map[model.OrderID]model.Order{5: {ID: 5, UserID: 8, Status: model.WaitStatus}}}
+	service := newTelegramService(nil, orders)
+
+	// Act
+	err := service.CancelUserOrder(ctx, 7, 5)
+
+	// Assert
+	require.ErrorIs(t, err, ErrOrderNotFound)
+	require.Empty(t, orders.cancelled)
+}
@@ -0,0 +1,66 @@
+package domain
+
+import (
+	"context"
+	"route256/libs/apperr"
+	"route256/notifications/internal/model"
+	"strconv"
+)
+
+var (
+	ErrOrderNotFound = apperr.New(apperr.NotFound, "order not found")
+)
+
+// Get the user's orders the latest notifications are about, orders LOMS does not know are skipped
+func (s *Service) RecentOrders(ctx context.Context, userID model.UserID, limit uint64) ([]model.Order, error) {
+	ids, err := s.message.GetRecentOrders(ctx, userID, limit)
+	if err != nil {
+		return nil, err
+	}
+
+	orders := make([]model.Order, 0, len(ids))
+	for _, id := range ids {
+		order, err := s.orders.GetOrder(ctx, id)
+		if apperr.KindOf(err) == apperr.NotFound {
+			continue
+		}
+		if err != nil {
+			return nil, err
+		}
+		if order.UserID == userID {
+			orders = append(orders, order)
+		}
+	}
+
+	return orders, nil
+}
+
+// Get the order of the user, orders of other users are not found
+func (s *Service) UserOrder(ctx context.Context, userID model.UserID, orderID model.OrderID) (model.Order, error) {
+	order, err := s.orders.GetOrder(ctx, orderID)
+	if apperr.KindOf(err) == apperr.NotFound {
+		return model.Order{}, orderNotFound(orderID)
+	}
+	if err != nil {
+		return model.Order{}, err
+	}
+	if order.UserID != userID {
+		return model.Order{}, orderNotFound(orderID)
+	}
+	return order, nil
+}
+
+// Cancel the order of the user
+func (s *Service) CancelUserOrder(ctx context.Context, userID model.UserID, orderID model.OrderID) error {
+	_, err := s.UserOrder(ctx, userID, orderID)
+	if err != nil {
+		return err
+	}
+	return s.orders.CancelOrder(ctx, orderID)
+}
+
+func orderNotFound(orderID model.OrderID) error {
+	return ErrOrderNotFound.WithDetails(map[string]string{
+		"order_id": strconv.FormatInt(int64(orderID), 10),
+	})
+}
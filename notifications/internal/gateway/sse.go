@@ -0,0 +1,194 @@
+// Server-Sent Events endpoint of the HTTP gateway
+package gateway
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"route256/notifications/pkg/notifications_v1"
+	"strconv"
+	"time"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// Comments sent to idle streams so proxies keep them open
+	heartbeatInterval = 15 * time.Second
+	// Cookie with the bearer token, EventSource can not set the Authorization header
+	tokenCookie = "access_token"
+)
+
+// Serve the Subscribe stream as Server-Sent Events, e.g. GET /notifications/stream?user=1.
+// The Last-Event-ID header or the last_event_id parameter resumes the stream after that message.
+// The token is taken from the Authorization header or the access_token cookie
+func SSEHandler(mux *runtime.ServeMux, client notifications_v1.NotificationsClient) runtime.HandlerFunc {
+	marshaler := &runtime.JSONPb{}
+
+	return func(w http.ResponseWriter, r *http.Request, _ map[string]string) {
+		ctx := r.Context()
+
+		req, err := subscribeRequest(r)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.InvalidArgument, err.Error()))
+			return
+		}
+
+		flusher, ok := w.(http.Flusher)
+		if !ok {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, status.Error(codes.Internal, "streaming is not supported"))
+			return
+		}
+
+		if authorization := authorization(r); authorization != "" {
+			ctx = metadata.AppendToOutgoingContext(ctx, "authorization", authorization)
+		}
+		ctx, cancel := context.WithCancel(ctx)
+		defer cancel()
+
+		stream, err := client.Subscribe(ctx, req)
+		if err != nil {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		// The server sends headers once the stream is accepted, errors before that
+		// are answered with an HTTP status. A stream ended without headers has
+		// its status returned by the first receive
+		var first *notifications_v1.Message
+		header, err := stream.Header()
+		if err == nil && header == nil {
+			first, err = stream.Recv()
+		}
+		ended := err == io.EOF
+		if err != nil && !ended {
+			runtime.HTTPError(ctx, mux, marshaler, w, r, err)
+			return
+		}
+
+		w.Header().Set("Content-Type", "text/event-stream")
+		w.Header().Set("Cache-Control", "no-cache")
+		w.Header().Set("Connection", "keep-alive")
+		w.WriteHeader(http.StatusOK)
+		if first != nil {
+			err = writeMessage(w, marshaler, first)
+			if err != nil {
+				return
+			}
+		}
+		flusher.Flush()
+		if ended {
+			return
+		}
+
+		messages, errs := receive(stream)
+		heartbeat := time.NewTicker(heartbeatInterval)
+		defer heartbeat.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-heartbeat.C:
+				_, err = io.WriteString(w, ": ping\n\n")
+			case message := <-messages:
+				err = writeMessage(w, marshaler, message)
+			case err = <-errs:
+				if err == io.EOF {
+					return
+				}
+				writeError(w, marshaler, err)
+				flusher.Flush()
+				return
+			}
+			if err != nil {
+				// The client went away
+				return
+			}
+			flusher.Flush()
+		}
+	}
+}
+
+// Take the token from the Authorization header or the token cookie
+func authorization(r *http.Request) string {
+	if authorization := r.Header.Get("Authorization"); authorization != "" {
+		return authorization
+	}
+	cookie, err := r.Cookie(tokenCookie)
+	if err != nil || cookie.Value == "" {
+		return ""
+	}
+	return "Bearer " + cookie.Value
+}
+
+// Take the subscription from the query and the Last-Event-ID header
+func subscribeRequest(r *http.Request) (*notifications_v1.SubscribeRequest, error) {
+	user, err := strconv.ParseInt(r.URL.Query().Get("user"), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("invalid user: %w", err)
+	}
+
+	req := &notifications_v1.SubscribeRequest{User: user}
+
+	lastEventID := r.Header.Get("Last-Event-ID")
+	if lastEventID == "" {
+		lastEventID = r.URL.Query().Get("last_event_id")
+	}
+	if lastEventID != "" {
+		req.LastEventId, err = strconv.ParseInt(lastEventID, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("invalid last event id: %w", err)
+		}
+	}
+
+	return req, nil
+}
+
+// Read the stream in the background, the error channel gets io.EOF when it ends
+func receive(stream notifications_v1.Notifications_SubscribeClient) (<-chan *notifications_v1.Message, <-chan error) {
+	messages := make(chan *notifications_v1.Message)
+	errs := make(chan error, 1)
+
+	go func() {
+		for {
+			message, err := stream.Recv()
+			if err != nil {
+				errs <- err
+				return
+			}
+			select {
+			case messages <- message:
+			case <-stream.Context().Done():
+				errs <- stream.Context().Err()
+				return
+			}
+		}
+	}()
+
+	return messages, errs
+}
+
+// Write a message as a message event with its id
+func writeMessage(w io.Writer, marshaler runtime.Marshaler, message *notifications_v1.Message) error {
+	data, err := marshaler.Marshal(message)
+	if err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(w, "id: %d\nevent: message\ndata: %s\n\n", message.GetId(), data)
+	return err
+}
+
+// Write a stream error as an error event with the gRPC code and message
+func writeError(w io.Writer, marshaler runtime.Marshaler, err error) {
+	st := status.Convert(err)
+	data, merr := marshaler.Marshal(st.Proto())
+	if merr != nil {
+		return
+	}
+	fmt.Fprintf(w, "event: error\ndata: %s\n\n", data)
+}
@@ -0,0 +1,248 @@
+package gateway
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"route256/libs/apperr"
+	"route256/notifications/pkg/notifications_v1"
+	"strings"
+	"testing"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"google.golang.org/grpc/test/bufconn"
+)
+
+// Stream the messages or fail before accepting the stream
+type fakeNotificationsServer struct {
+	notifications_v1.UnimplementedNotificationsServer
+	messages       []*notifications_v1.Message
+	err            error
+	requests       chan *notifications_v1.SubscribeRequest
+	authorizations chan []string
+}
+
+func (s *fakeNotificationsServer) Subscribe(req *notifications_v1.SubscribeRequest, stream notifications_v1.Notifications_SubscribeServer) error {
+	s.requests <- req
+	if s.authorizations != nil {
+		s.authorizations <- metadata.ValueFromIncomingContext(stream.Context(), "authorization")
+	}
+	if s.err != nil {
+		return s.err
+	}
+	err := stream.SendHeader(metadata.MD{})
+	if err != nil {
+		return err
+	}
+	for _, message := range s.messages {
+		err = stream.Send(message)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func newClient(t *testing.T, srv notifications_v1.NotificationsServer) notifications_v1.NotificationsClient {
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	notifications_v1.RegisterNotificationsServer(s, srv)
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.DialContext(
+		context.Background(),
+		"bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return notifications_v1.NewNotificationsClient(conn)
+}
+
+// Client of a stream that ended without headers but still has messages to receive
+type headerlessClient struct {
+	notifications_v1.NotificationsClient
+	messages []*notifications_v1.Message
+}
+
+func (c headerlessClient) Subscribe(ctx context.Context, _ *notifications_v1.SubscribeRequest, _ ...grpc.CallOption) (notifications_v1.Notifications_SubscribeClient, error) {
+	return &headerlessStream{ctx: ctx, messages: c.messages}, nil
+}
+
+type headerlessStream struct {
+	grpc.ClientStream
+	ctx      context.Context
+	messages []*notifications_v1.Message
+}
+
+func (s *headerlessStream) Header() (metadata.MD, error) {
+	return nil, nil
+}
+
+func (s *headerlessStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *headerlessStream) Recv() (*notifications_v1.Message, error) {
+	if len(s.messages) == 0 {
+		return nil, io.EOF
+	}
+	message := s.messages[0]
+	s.messages = s.messages[1:]
+	return message, nil
+}
+
+func TestSSEHandler_Messages_WrittenAsEvents(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	srv := &fakeNotificationsServer{
+		messages: []*notifications_v1.Message{{Id: 4, UserId: 1, Status: "new"}, {Id: 5, UserId: 1, Status: "payed"}},
+		requests: make(chan *notifications_v1.SubscribeRequest, 1),
+	}
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(apperr.HTTPErrorHandler))
+	handler := SSEHandler(mux, newClient(t, srv))
+	req := httptest.NewRequest(http.MethodGet, "/notifications/stream?user=1&last_event_id=2", nil)
+	req.Header.Set("Last-Event-ID", "3")
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler(rec, req, nil)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	require.Equal(t, "text/event-stream", rec.Header().Get("Content-Type"))
+	require.Equal(t, int64(3), (<-srv.requests).GetLastEventId())
+	events := strings.Split(strings.TrimSpace(rec.Body.String()), "\n\n")
+	require.Len(t, events, 2)
+	require.True(t, strings.HasPrefix(events[0], "id: 4\nevent: message\ndata: {"))
+	require.True(t, strings.HasPrefix(events[1], "id: 5\nevent: message\ndata: {"))
+}
+
+func TestSSEHandler_NoHeaders_FirstMessageWritten(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	client := headerlessClient{messages: []*notifications_v1.Message{{Id: 4, UserId: 1, Status: "new"}, {Id: 5, UserId: 1, Status: "payed"}}}
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(apperr.HTTPErrorHandler))
+	handler := SSEHandler(mux, client)
+	req := httptest.NewRequest(http.MethodGet, "/notifications/stream?user=1", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler(rec, req, nil)
+
+	// Assert
+	require.Equal(t, http.StatusOK, rec.Code)
+	events := strings.Split(strings.TrimSpace(rec.Body.String()), "\n\n")
+	require.Len(t, events, 2)
+	require.True(t, strings.HasPrefix(events[0], "id: 4\nevent: message\ndata: {"))
+	require.True(t, strings.HasPrefix(events[1], "id: 5\nevent: message\ndata: {"))
+}
+
+func TestSSEHandler_Token_Forwarded(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name          string
+		header        string
+		cookie        string
+		authorization []string
+	}{
+		{
+			name:          "authorization header",
+			header:        "Bearer header-token",
+			authorization: []string{"Bearer header-token"},
+		},
+		{
+			name:          "token cookie",
+			cookie:        "cookie-token",
+			authorization: []string{"Bearer cookie-token"},
+		},
+		{
+			name:          "header is preferred to cookie",
+			header:        "Bearer header-token",
+			cookie:        "cookie-token",
+			authorization: []string{"Bearer header-token"},
+		},
+		{
+			name: "no token",
+		},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Arrange
+			srv := &fakeNotificationsServer{
+				requests:       make(chan *notifications_v1.SubscribeRequest, 1),
+				authorizations: make(chan []string, 1),
+			}
+			mux := runtime.NewServeMux(runtime.WithErrorHandler(apperr.HTTPErrorHandler))
+			handler := SSEHandler(mux, newClient(t, srv))
+			req := httptest.NewRequest(http.MethodGet, "/notifications/stream?user=1", nil)
+			if tt.header != "" {
+				req.Header.Set("Authorization", tt.header)
+			}
+			if tt.cookie != "" {
+				req.AddCookie(&http.Cookie{Name: "access_token", Value: tt.cookie})
+			}
+			rec := httptest.NewRecorder()
+
+			// Act
+			handler(rec, req, nil)
+
+			// Assert
+			require.Equal(t, http.StatusOK, rec.Code)
+			require.Equal(t, tt.authorization, <-srv.authorizations)
+		})
+	}
+}
+
+func TestSSEHandler_RejectedStream_HTTPStatus(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	srv := &fakeNotificationsServer{
+		err:      status.Error(codes.PermissionDenied, "request is for another user"),
+		requests: make(chan *notifications_v1.SubscribeRequest, 1),
+	}
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(apperr.HTTPErrorHandler))
+	handler := SSEHandler(mux, newClient(t, srv))
+	req := httptest.NewRequest(http.MethodGet, "/notifications/stream?user=2", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler(rec, req, nil)
+
+	// Assert
+	require.Equal(t, http.StatusForbidden, rec.Code)
+	require.Contains(t, rec.Body.String(), "PermissionDenied")
+}
+
+func TestSSEHandler_InvalidUser_BadRequest(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	srv := &fakeNotificationsServer{requests: make(chan *notifications_v1.SubscribeRequest, 1)}
+	mux := runtime.NewServeMux(runtime.WithErrorHandler(apperr.HTTPErrorHandler))
+	handler := SSEHandler(mux, newClient(t, srv))
+	req := httptest.NewRequest(http.MethodGet, "/notifications/stream?user=abc", nil)
+	rec := httptest.NewRecorder()
+
+	// Act
+	handler(rec, req, nil)
+
+	// Assert
+	require.Equal(t, http.StatusBadRequest, rec.Code)
+}
@@ -4,18 +4,48 @@ package kafka
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"route256/notifications/internal/model"
 	"route256/notifications/internal/pkg/logger"
+	"strconv"
 	"sync"
+	"time"
 
 	"github.com/Shopify/sarama"
 	"github.com/pkg/errors"
 )
 
+// Topics consumed by the notifications service
+const (
+	OrdersTopic         = "orders"
+	AbandonedCartsTopic = "abandoned_carts"
+)
+
+// Number of consumed messages of a claim waiting for their digests to be sent before they are marked
+const pendingMarks = 1000
+
+var (
+	// Message can not be decoded, retries do not help
+	errMalformedMessage = errors.New("malformed message")
+)
+
 // Define Service for send message to notify user
 type MessageSenderService interface {
 	Save(ctx context.Context, message model.OrderStatusMessage) (model.MessageID, error)
-	NotifyUser(ctx context.Context, message model.OrderStatusMessage) error
+	NotifyUser(ctx context.Context, message model.OrderStatusMessage) (model.PendingDeliveries, error)
+	NotifyAbandonedCart(ctx context.Context, message model.AbandonedCartMessage) (model.PendingDeliveries, error)
+	SaveDeadLetter(ctx context.Context, letter model.DeadLetter) error
+}
+
+// Define producer republishing failed messages
+type MessageProducer interface {
+	SendSyncMessage(message *sarama.ProducerMessage) (partition int32, offset int64, err error)
+}
+
+// Define consumer group stopping fetches of partitions of delay topics until their messages are due
+type PartitionPauser interface {
+	Pause(partitions map[string][]int32)
+	Resume(partitions map[string][]int32)
 }
 
 // Define Consumer group for order status messages
@@ -23,13 +53,19 @@ type ConsumerGroupHandler struct {
 	ready       chan bool
 	readyCloser sync.Once
 	service     MessageSenderService
+	producer    MessageProducer
+	partitions  PartitionPauser
+	policy      RetryPolicy
 }
 
 // Create a new consumer group
-func NewConsumerGroupHandler(Service MessageSenderService) ConsumerGroupHandler {
+func NewConsumerGroupHandler(Service MessageSenderService, producer MessageProducer, partitions PartitionPauser, policy RetryPolicy) ConsumerGroupHandler {
 	return ConsumerGroupHandler{
-		ready:   make(chan bool),
-		service: Service,
+		ready:      make(chan bool),
+		service:    Service,
+		producer:   producer,
+		partitions: partitions,
+		policy:     policy,
 	}
 }
 
@@ -51,31 +87,297 @@ func (cg *ConsumerGroupHandler) Cleanup(_ sarama.ConsumerGroupSession) error {
 	return nil
 }
 
-// Read messaged until the session is over and write it to Messages channel
+// Read messages until the session is over. A message that fails to be handled is republished
+// and marked, the claim ends only when it can not be republished. Messages are marked in order
+// once their digests are sent, the claim waits for the digests of consumed messages before it ends
 func (cg *ConsumerGroupHandler) ConsumeClaim(session sarama.ConsumerGroupSession, claim sarama.ConsumerGroupClaim) error {
+	marks := make(chan consumed, pendingMarks)
+	failed := make(chan error, 1)
+	marked := make(chan struct{})
+	go func() {
+		defer close(marked)
+		cg.mark(session, marks, failed)
+	}()
+	defer func() {
+		close(marks)
+		<-marked
+	}()
+
 	for {
 		select {
-		case message := <-claim.Messages():
-			pm := model.OrderStatusMessage{}
-			err := json.Unmarshal(message.Value, &pm)
-			if err != nil {
-				return errors.Wrap(err, "failed to unmarshal")
+		case message, ok := <-claim.Messages():
+			if !ok {
+				return nil
 			}
-
-			// Save message to storage
-			_, err = cg.service.Save(context.Background(), pm)
+			pending, err := cg.consume(session.Context(), message)
 			if err != nil {
-				return errors.Wrapf(err, "failed to save message")
+				// The message is not marked and is consumed again by the next session
+				if session.Context().Err() != nil {
+					return nil
+				}
+				return err
 			}
-
-			err = cg.service.NotifyUser(context.Background(), pm)
-			if err != nil {
-				return errors.Wrapf(err, "failed to send message")
+			select {
+			case marks <- consumed{message: message, pending: pending}:
+			case err = <-failed:
+				return err
 			}
-			logger.Info(pm)
-			session.MarkMessage(message, "")
+		case err := <-failed:
+			return err
 		case <-session.Context().Done():
 			return nil
 		}
 	}
 }
+
+// Consumed message waiting for its digests to be sent
+type consumed struct {
+	message *sarama.ConsumerMessage
+	pending model.PendingDeliveries
+}
+
+// Mark consumed messages in order once their digests are sent. A message with a failed digest
+// is republished like a message that failed to be handled, marking stops when it can not be
+func (cg *ConsumerGroupHandler) mark(session sarama.ConsumerGroupSession, marks <-chan consumed, failed chan<- error) {
+	for m := range marks {
+		err := m.pending.Wait()
+		if err != nil {
+			err = cg.republish(session.Context(), m.message, 1, errors.Wrap(err, "failed to send digest"))
+		}
+		if err != nil {
+			failed <- err
+			// Messages after it are consumed again by the next session
+			for range marks {
+			}
+			return
+		}
+		session.MarkMessage(m.message, "")
+	}
+}
+
+// Describe where a message was first consumed from
+type origin struct {
+	topic     string
+	partition int32
+	offset    int64
+}
+
+// Take the origin from headers of a republished message
+func originOf(message *sarama.ConsumerMessage) origin {
+	topic, ok := header(message, HeaderOriginalTopic)
+	if !ok {
+		return origin{topic: message.Topic, partition: message.Partition, offset: message.Offset}
+	}
+	return origin{
+		topic:     topic,
+		partition: int32(intHeader(message, HeaderOriginalPartition)),
+		offset:    intHeader(message, HeaderOriginalOffset),
+	}
+}
+
+// Identify an event published without an id by its position
+func (o origin) eventID() model.EventID {
+	return model.EventID(fmt.Sprintf("%s:%d:%d", o.topic, o.partition, o.offset))
+}
+
+// Handle the message with in-place retries, a message that still fails goes to the next delay topic
+// or to the dead letter topic. Notifications of the handled message taken for digests are returned pending,
+// a republished message notifies the channels that failed again
+func (cg *ConsumerGroupHandler) consume(ctx context.Context, message *sarama.ConsumerMessage) (model.PendingDeliveries, error) {
+	if message.Topic == cg.policy.DeadLetterTopic {
+		_, _, err := cg.withRetries(ctx, message, func(ctx context.Context, message *sarama.ConsumerMessage) (model.PendingDeliveries, error) {
+			return nil, cg.handleDeadLetter(ctx, message)
+		})
+		return nil, err
+	}
+
+	if cg.policy.isRetryTopic(message.Topic) {
+		notBefore := time.UnixMilli(intHeader(message, HeaderNotBefore))
+		if wait := time.Until(notBefore); wait > 0 {
+			return nil, cg.postpone(message, wait)
+		}
+	}
+
+	attempts, pending, err := cg.withRetries(ctx, message, cg.handle)
+	if err == nil || ctx.Err() != nil {
+		return pending, err
+	}
+	return nil, cg.republish(ctx, message, attempts, err)
+}
+
+// Call the handler until it succeeds, the attempts run out or the message turns out malformed.
+// Digests taken by every attempt are returned pending
+func (cg *ConsumerGroupHandler) withRetries(
+	ctx context.Context,
+	message *sarama.ConsumerMessage,
+	handle func(context.Context, *sarama.ConsumerMessage) (model.PendingDeliveries, error),
+) (int, model.PendingDeliveries, error) {
+	var pending model.PendingDeliveries
+	for attempt := 1; ; attempt++ {
+		taken, err := handle(ctx, message)
+		pending = append(pending, taken...)
+		if err == nil {
+			return attempt, pending, nil
+		}
+		if attempt >= cg.policy.MaxAttempts || errors.Is(err, errMalformedMessage) {
+			return attempt, pending, err
+		}
+
+		logger.Errorf(ctx, "kafka/consume", "attempt %d to handle message %s/%d/%d: %v",
+			attempt, message.Topic, message.Partition, message.Offset, err)
+		err = sleep(ctx, cg.policy.backoff(attempt))
+		if err != nil {
+			return attempt, pending, err
+		}
+	}
+}
+
+// Handle the message by the topic it was first consumed from
+func (cg *ConsumerGroupHandler) handle(ctx context.Context, message *sarama.ConsumerMessage) (model.PendingDeliveries, error) {
+	switch originOf(message).topic {
+	case AbandonedCartsTopic:
+		return cg.handleAbandonedCart(ctx, message)
+	default:
+		return cg.handleOrderStatus(ctx, message)
+	}
+}
+
+// Publish the failed message to the next delay topic, malformed messages and messages
+// that went through all delay topics go to the dead letter topic
+func (cg *ConsumerGroupHandler) republish(ctx context.Context, message *sarama.ConsumerMessage, attempts int, cause error) error {
+	now := time.Now().UTC()
+	o := originOf(message)
+	retry := intHeader(message, HeaderRetry)
+
+	headers := []sarama.RecordHeader{
+		{Key: []byte(HeaderOriginalTopic), Value: []byte(o.topic)},
+		{Key: []byte(HeaderOriginalPartition), Value: []byte(strconv.FormatInt(int64(o.partition), 10))},
+		{Key: []byte(HeaderOriginalOffset), Value: []byte(strconv.FormatInt(o.offset, 10))},
+		{Key: []byte(HeaderError), Value: []byte(cause.Error())},
+		{Key: []byte(HeaderAttempts), Value: []byte(strconv.FormatInt(intHeader(message, HeaderAttempts)+int64(attempts), 10))},
+		{Key: []byte(HeaderFailedAt), Value: []byte(now.Format(time.RFC3339))},
+	}
+
+	topic := cg.policy.DeadLetterTopic
+	if !errors.Is(cause, errMalformedMessage) && int(retry) < len(cg.policy.RetryTopics) {
+		next := cg.policy.RetryTopics[retry]
+		topic = next.Topic
+		headers = append(headers,
+			sarama.RecordHeader{Key: []byte(HeaderRetry), Value: []byte(strconv.FormatInt(retry+1, 10))},
+			sarama.RecordHeader{Key: []byte(HeaderNotBefore), Value: []byte(strconv.FormatInt(now.Add(next.Delay).UnixMilli(), 10))},
+		)
+	}
+
+	_, _, err := cg.producer.SendSyncMessage(producerMessage(topic, message, headers))
+	if err != nil {
+		return errors.Wrapf(err, "republish message to %s", topic)
+	}
+
+	logger.Errorf(ctx, "kafka/consume", "message %s/%d/%d is moved to %s: %v",
+		o.topic, o.partition, o.offset, topic, cause)
+	return nil
+}
+
+// Put a message that is not due yet back to its delay topic and stop fetching the partition until
+// the message is due. Messages of a delay topic share the delay, so the ones after it are not due either
+func (cg *ConsumerGroupHandler) postpone(message *sarama.ConsumerMessage, wait time.Duration) error {
+	partitions := map[string][]int32{message.Topic: {message.Partition}}
+	cg.partitions.Pause(partitions)
+	time.AfterFunc(wait, func() {
+		cg.partitions.Resume(partitions)
+	})
+
+	headers := make([]sarama.RecordHeader, 0, len(message.Headers))
+	for _, h := range message.Headers {
+		if h != nil {
+			headers = append(headers, *h)
+		}
+	}
+
+	_, _, err := cg.producer.SendSyncMessage(producerMessage(message.Topic, message, headers))
+	if err != nil {
+		return errors.Wrapf(err, "postpone message to %s", message.Topic)
+	}
+	return nil
+}
+
+// Build a message with the key and the value of the consumed one
+func producerMessage(topic string, message *sarama.ConsumerMessage, headers []sarama.RecordHeader) *sarama.ProducerMessage {
+	produced := &sarama.ProducerMessage{
+		Topic:   topic,
+		Value:   sarama.ByteEncoder(message.Value),
+		Headers: headers,
+	}
+	if message.Key != nil {
+		produced.Key = sarama.ByteEncoder(message.Key)
+	}
+	return produced
+}
+
+// Save order status message and notify user about it
+func (cg *ConsumerGroupHandler) handleOrderStatus(ctx context.Context, message *sarama.ConsumerMessage) (model.PendingDeliveries, error) {
+	pm := model.OrderStatusMessage{}
+	err := json.Unmarshal(message.Value, &pm)
+	if err != nil {
+		return nil, errors.Wrapf(errMalformedMessage, "failed to unmarshal: %v", err)
+	}
+	if pm.EventID == "" {
+		pm.EventID = originOf(message).eventID()
+	}
+
+	// Save message to storage
+	_, err = cg.service.Save(ctx, pm)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to save message")
+	}
+
+	pending, err := cg.service.NotifyUser(ctx, pm)
+	if err != nil {
+		return pending, errors.Wrapf(err, "failed to send message")
+	}
+	logger.Info(pm)
+	return pending, nil
+}
+
+// Remind user about abandoned cart
+func (cg *ConsumerGroupHandler) handleAbandonedCart(ctx context.Context, message *sarama.ConsumerMessage) (model.PendingDeliveries, error) {
+	cm := model.AbandonedCartMessage{}
+	err := json.Unmarshal(message.Value, &cm)
+	if err != nil {
+		return nil, errors.Wrapf(errMalformedMessage, "failed to unmarshal abandoned cart: %v", err)
+	}
+	if cm.EventID == "" {
+		cm.EventID = originOf(message).eventID()
+	}
+
+	pending, err := cg.service.NotifyAbandonedCart(ctx, cm)
+	if err != nil {
+		return pending, errors.Wrap(err, "failed to send abandoned cart reminder")
+	}
+	logger.Info(cm)
+	return pending, nil
+}
+
+// Keep a message of the dead letter topic for listing and replay
+func (cg *ConsumerGroupHandler) handleDeadLetter(ctx context.Context, message *sarama.ConsumerMessage) error {
+	o := originOf(message)
+	cause, _ := header(message, HeaderError)
+
+	failedAt := message.Timestamp.UTC()
+	if value, ok := header(message, HeaderFailedAt); ok {
+		if parsed, err := time.Parse(time.RFC3339, value); err == nil {
+			failedAt = parsed
+		}
+	}
+
+	return cg.service.SaveDeadLetter(ctx, model.DeadLetter{
+		Topic:     o.topic,
+		Partition: o.partition,
+		Offset:    o.offset,
+		Key:       message.Key,
+		Value:     message.Value,
+		Error:     cause,
+		Attempts:  int32(intHeader(message, HeaderAttempts)),
+		FailedAt:  failedAt,
+	})
+}
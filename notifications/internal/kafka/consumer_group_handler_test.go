@@ -0,0 +1,345 @@
+package kafka
+
+import (
+	"context"
+	"encoding/json"
+	"route256/notifications/internal/model"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/Shopify/sarama"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/require"
+)
+
+// Service failing to notify users with the given error
+type fakeSenderService struct {
+	mu          sync.Mutex
+	notifyErr   error
+	notified    int
+	deadLetters []model.DeadLetter
+}
+
+func (s *fakeSenderService) Save(_ context.Context, _ model.OrderStatusMessage) (model.MessageID, error) {
+	return 1, nil
+}
+
+func (s *fakeSenderService) NotifyUser(_ context.Context, _ model.OrderStatusMessage) (model.PendingDeliveries, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.notified++
+	return nil, s.notifyErr
+}
+
+func (s *fakeSenderService) NotifyAbandonedCart(_ context.Context, _ model.AbandonedCartMessage) (model.PendingDeliveries, error) {
+	return nil, nil
+}
+
+func (s *fakeSenderService) SaveDeadLetter(_ context.Context, letter model.DeadLetter) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.deadLetters = append(s.deadLetters, letter)
+	return nil
+}
+
+// Producer keeping sent messages
+type fakeProducer struct {
+	mu       sync.Mutex
+	messages []*sarama.ProducerMessage
+}
+
+func (p *fakeProducer) SendSyncMessage(message *sarama.ProducerMessage) (int32, int64, error) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.messages = append(p.messages, message)
+	return 0, int64(len(p.messages)), nil
+}
+
+func (p *fakeProducer) sent() []*sarama.ProducerMessage {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return append([]*sarama.ProducerMessage(nil), p.messages...)
+}
+
+// Consumer group keeping paused partitions
+type fakePauser struct {
+	mu     sync.Mutex
+	paused map[string][]int32
+}
+
+func (p *fakePauser) Pause(partitions map[string][]int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.paused = partitions
+}
+
+func (p *fakePauser) Resume(_ map[string][]int32) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	p.paused = nil
+}
+
+func (p *fakePauser) pausedPartitions() map[string][]int32 {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	return p.paused
+}
+
+func testPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    2,
+		InitialBackoff: time.Millisecond,
+		MaxBackoff:     time.Millisecond,
+		RetryTopics: []RetryTopic{
+			{Topic: "retry_1m", Delay: time.Minute},
+			{Topic: "retry_10m", Delay: 10 * time.Minute},
+		},
+		DeadLetterTopic: "dlq",
+	}
+}
+
+func orderMessage(t *testing.T) *sarama.ConsumerMessage {
+	t.Helper()
+
+	value, err := json.Marshal(model.OrderStatusMessage{EventID: "order:1:paid", UserID: 7, OrderID: 1, Status: "paid"})
+	require.NoError(t, err)
+	return &sarama.ConsumerMessage{Topic: OrdersTopic, Partition: 2, Offset: 40, Key: []byte("1"), Value: value}
+}
+
+func producedHeader(message *sarama.ProducerMessage, key string) string {
+	for _, h := range message.Headers {
+		if string(h.Key) == key {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func TestConsumerGroupHandler_Consume_FailedMessage_FirstRetryTopic(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	service := &fakeSenderService{notifyErr: errors.New("telegram is down")}
+	producer := &fakeProducer{}
+	handler := NewConsumerGroupHandler(service, producer, &fakePauser{}, testPolicy())
+	before := time.Now()
+
+	// Act
+	_, err := handler.consume(ctx, orderMessage(t))
+
+	// Assert
+	require.NoError(t, err)
+	require.Equal(t, 2, service.notified)
+	sent := producer.sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, "retry_1m", sent[0].Topic)
+	require.Equal(t, OrdersTopic, producedHeader(sent[0], HeaderOriginalTopic))
+	require.Equal(t, "2", producedHeader(sent[0], HeaderOriginalPartition))
+	require.Equal(t, "40", producedHeader(sent[0], HeaderOriginalOffset))
+	require.Equal(t, "1", producedHeader(sent[0], HeaderRetry))
+	require.Equal(t, "2", producedHeader(sent[0], HeaderAttempts))
+	notBefore, err := strconv.ParseInt(producedHeader(sent[0], HeaderNotBefore), 10, 64)
+	require.NoError(t, err)
+	require.GreaterOrEqual(t, notBefore, before.Add(time.Minute).UnixMilli())
+}
+
+func TestConsumerGroupHandler_Consume_LastRetryTopicFails_DeadLetterTopic(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	service := &fakeSenderService{notifyErr: errors.New("telegram is down")}
+	producer := &fakeProducer{}
+	handler := NewConsumerGroupHandler(service, producer, &fakePauser{}, testPolicy())
+	message := orderMessage(t)
+	message.Topic = "retry_10m"
+	message.Headers = []*sarama.RecordHeader{
+		{Key: []byte(HeaderOriginalTopic), Value: []byte(OrdersTopic)},
+		{Key: []byte(HeaderOriginalPartition), Value: []byte("2")},
+		{Key: []byte(HeaderOriginalOffset), Value: []byte("40")},
+		{Key: []byte(HeaderRetry), Value: []byte("2")},
+		{Key: []byte(HeaderAttempts), Value: []byte("4")},
+		{Key: []byte(HeaderNotBefore), Value: []byte(strconv.FormatInt(time.Now().Add(-time.Second).UnixMilli(), 10))},
+	}
+
+	// Act
+	_, err := handler.consume(ctx, message)
+
+	// Assert
+	require.NoError(t, err)
+	sent := producer.sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, "dlq", sent[0].Topic)
+	require.Equal(t, OrdersTopic, producedHeader(sent[0], HeaderOriginalTopic))
+	require.Equal(t, "6", producedHeader(sent[0], HeaderAttempts))
+	require.Equal(t, "failed to send message: telegram is down", producedHeader(sent[0], HeaderError))
+	require.Empty(t, producedHeader(sent[0], HeaderNotBefore))
+}
+
+func TestConsumerGroupHandler_Consume_MalformedMessage_DeadLetterTopicAtOnce(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	service := &fakeSenderService{}
+	producer := &fakeProducer{}
+	handler := NewConsumerGroupHandler(service, producer, &fakePauser{}, testPolicy())
+	message := orderMessage(t)
+	message.Value = []byte("{")
+
+	// Act
+	_, err := handler.consume(ctx, message)
+
+	// Assert
+	require.NoError(t, err)
+	require.Zero(t, service.notified)
+	sent := producer.sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, "dlq", sent[0].Topic)
+	require.Equal(t, "1", producedHeader(sent[0], HeaderAttempts))
+}
+
+func TestConsumerGroupHandler_Consume_RetryNotDue_PostponedAndPartitionPaused(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	service := &fakeSenderService{}
+	producer := &fakeProducer{}
+	partitions := &fakePauser{}
+	handler := NewConsumerGroupHandler(service, producer, partitions, testPolicy())
+	message := orderMessage(t)
+	message.Topic = "retry_1m"
+	message.Headers = []*sarama.RecordHeader{
+		{Key: []byte(HeaderRetry), Value: []byte("1")},
+		{Key: []byte(HeaderNotBefore), Value: []byte(strconv.FormatInt(time.Now().Add(200*time.Millisecond).UnixMilli(), 10))},
+	}
+
+	// Act
+	_, err := handler.consume(ctx, message)
+
+	// Assert
+	require.NoError(t, err)
+	require.Zero(t, service.notified)
+	require.Equal(t, map[string][]int32{"retry_1m": {2}}, partitions.pausedPartitions())
+	sent := producer.sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, "retry_1m", sent[0].Topic)
+	require.Equal(t, "1", producedHeader(sent[0], HeaderRetry))
+	require.Eventually(t, func() bool { return partitions.pausedPartitions() == nil }, time.Second, 10*time.Millisecond)
+}
+
+func TestConsumerGroupHandler_Consume_DeadLetter_SavedWithOrigin(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	ctx := context.Background()
+	service := &fakeSenderService{}
+	handler := NewConsumerGroupHandler(service, &fakeProducer{}, &fakePauser{}, testPolicy())
+	message := orderMessage(t)
+	message.Topic = "dlq"
+	message.Headers = []*sarama.RecordHeader{
+		{Key: []byte(HeaderOriginalTopic), Value: []byte(OrdersTopic)},
+		{Key: []byte(HeaderOriginalPartition), Value: []byte("2")},
+		{Key: []byte(HeaderOriginalOffset), Value: []byte("40")},
+		{Key: []byte(HeaderAttempts), Value: []byte("6")},
+		{Key: []byte(HeaderError), Value: []byte("telegram is down")},
+		{Key: []byte(HeaderFailedAt), Value: []byte("2023-07-01T10:00:00Z")},
+	}
+
+	// Act
+	_, err := handler.consume(ctx, message)
+
+	// Assert
+	require.NoError(t, err)
+	require.Len(t, service.deadLetters, 1)
+	letter := service.deadLetters[0]
+	require.Equal(t, OrdersTopic, letter.Topic)
+	require.Equal(t, int32(2), letter.Partition)
+	require.Equal(t, int64(40), letter.Offset)
+	require.Equal(t, int32(6), letter.Attempts)
+	require.Equal(t, "telegram is down", letter.Error)
+	require.Equal(t, time.Date(2023, 7, 1, 10, 0, 0, 0, time.UTC), letter.FailedAt)
+}
+
+// Session keeping offsets of marked messages
+type fakeSession struct {
+	sarama.ConsumerGroupSession
+	mu     sync.Mutex
+	marked []int64
+}
+
+func (s *fakeSession) Context() context.Context {
+	return context.Background()
+}
+
+func (s *fakeSession) MarkMessage(message *sarama.ConsumerMessage, _ string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.marked = append(s.marked, message.Offset)
+}
+
+func (s *fakeSession) markedOffsets() []int64 {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return append([]int64(nil), s.marked...)
+}
+
+func TestConsumerGroupHandler_Mark_PendingDigest_LaterMessagesWait(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	session := &fakeSession{}
+	handler := NewConsumerGroupHandler(&fakeSenderService{}, &fakeProducer{}, &fakePauser{}, testPolicy())
+	marks := make(chan consumed, 2)
+	digest := make(chan error, 1)
+	first, second := orderMessage(t), orderMessage(t)
+	second.Offset = first.Offset + 1
+	marks <- consumed{message: first, pending: model.PendingDeliveries{digest}}
+	marks <- consumed{message: second}
+	close(marks)
+	done := make(chan struct{})
+
+	// Act
+	go func() {
+		defer close(done)
+		handler.mark(session, marks, make(chan error, 1))
+	}()
+	time.Sleep(10 * time.Millisecond)
+	markedBeforeDigest := session.markedOffsets()
+	digest <- nil
+	<-done
+
+	// Assert
+	require.Empty(t, markedBeforeDigest)
+	require.Equal(t, []int64{40, 41}, session.markedOffsets())
+}
+
+func TestConsumerGroupHandler_Mark_FailedDigest_FirstRetryTopic(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	session := &fakeSession{}
+	producer := &fakeProducer{}
+	handler := NewConsumerGroupHandler(&fakeSenderService{}, producer, &fakePauser{}, testPolicy())
+	marks := make(chan consumed, 1)
+	digest := make(chan error, 1)
+	digest <- errors.New("chat not found")
+	marks <- consumed{message: orderMessage(t), pending: model.PendingDeliveries{digest}}
+	close(marks)
+
+	// Act
+	handler.mark(session, marks, make(chan error, 1))
+
+	// Assert
+	sent := producer.sent()
+	require.Len(t, sent, 1)
+	require.Equal(t, "retry_1m", sent[0].Topic)
+	require.Contains(t, producedHeader(sent[0], HeaderError), "chat not found")
+	require.Equal(t, []int64{40}, session.markedOffsets())
+}
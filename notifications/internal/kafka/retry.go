@@ -0,0 +1,123 @@
+// Retries of messages that failed to be handled
+package kafka
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/Shopify/sarama"
+)
+
+// Headers of republished messages
+const (
+	HeaderOriginalTopic     = "x-original-topic"
+	HeaderOriginalPartition = "x-original-partition"
+	HeaderOriginalOffset    = "x-original-offset"
+	// Number of times the message has been republished to delay topics
+	HeaderRetry = "x-retry"
+	// Unix time in milliseconds before which a delayed message is not handled
+	HeaderNotBefore = "x-not-before"
+	HeaderError     = "x-error"
+	HeaderAttempts  = "x-attempts"
+	HeaderFailedAt  = "x-failed-at"
+)
+
+// Describe a topic holding messages for a delayed retry
+type RetryTopic struct {
+	Topic string
+	Delay time.Duration
+}
+
+// Describe how failed messages are retried
+type RetryPolicy struct {
+	// Attempts to handle a message in place before it is republished
+	MaxAttempts    int
+	InitialBackoff time.Duration
+	MaxBackoff     time.Duration
+	// Delay topics a message goes through in order, then the dead letter topic
+	RetryTopics     []RetryTopic
+	DeadLetterTopic string
+}
+
+// Get the default policy: three attempts in place, retries after a minute and ten minutes
+func DefaultRetryPolicy() RetryPolicy {
+	return RetryPolicy{
+		MaxAttempts:    3,
+		InitialBackoff: 200 * time.Millisecond,
+		MaxBackoff:     5 * time.Second,
+		RetryTopics: []RetryTopic{
+			{Topic: "notifications_retry_1m", Delay: time.Minute},
+			{Topic: "notifications_retry_10m", Delay: 10 * time.Minute},
+		},
+		DeadLetterTopic: "notifications_dlq",
+	}
+}
+
+// Get topics the consumer reads besides the source topics
+func (p RetryPolicy) Topics() []string {
+	topics := make([]string, 0, len(p.RetryTopics)+1)
+	for _, retry := range p.RetryTopics {
+		topics = append(topics, retry.Topic)
+	}
+	return append(topics, p.DeadLetterTopic)
+}
+
+func (p RetryPolicy) isRetryTopic(topic string) bool {
+	for _, retry := range p.RetryTopics {
+		if retry.Topic == topic {
+			return true
+		}
+	}
+	return false
+}
+
+// Get the delay before the next in-place attempt
+func (p RetryPolicy) backoff(attempt int) time.Duration {
+	backoff := p.InitialBackoff
+	for i := 1; i < attempt && backoff < p.MaxBackoff; i++ {
+		backoff *= 2
+	}
+	if backoff > p.MaxBackoff {
+		return p.MaxBackoff
+	}
+	return backoff
+}
+
+// Wait for the duration unless the context is done first
+func sleep(ctx context.Context, d time.Duration) error {
+	if d <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(d)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+func header(message *sarama.ConsumerMessage, key string) (string, bool) {
+	for _, h := range message.Headers {
+		if h != nil && string(h.Key) == key {
+			return string(h.Value), true
+		}
+	}
+	return "", false
+}
+
+func intHeader(message *sarama.ConsumerMessage, key string) int64 {
+	value, ok := header(message, key)
+	if !ok {
+		return 0
+	}
+	n, err := strconv.ParseInt(value, 10, 64)
+	if err != nil {
+		return 0
+	}
+	return n
+}
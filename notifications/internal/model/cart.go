@@ -0,0 +1,19 @@
+// Define abandoned cart DTO for domain layer
+package model
+
+import "time"
+
+// Describe a reminder about a cart the user has not checked out
+type AbandonedCartMessage struct {
+	EventID   EventID           `json:"event_id"`
+	UserID    UserID            `json:"user_id"`
+	CartID    int64             `json:"cart_id"`
+	UpdatedAt time.Time         `json:"updated_at"`
+	Items     []CartItemMessage `json:"items"`
+}
+
+// Describe an item left in the cart
+type CartItemMessage struct {
+	SKU   uint32 `json:"sku"`
+	Count uint16 `json:"count"`
+}
@@ -0,0 +1,24 @@
+// Define dead letter DTO for domain layer
+package model
+
+import "time"
+
+// Define dead letter identifier
+type DeadLetterID int64
+
+// Describe a consumed message that failed to be handled after all retries
+type DeadLetter struct {
+	ID DeadLetterID
+	// Position of the message in the topic it was first consumed from
+	Topic     string
+	Partition int32
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	// Last handling error and the number of attempts made
+	Error    string
+	Attempts int32
+	FailedAt time.Time
+	// Zero until the message is published back to its topic
+	ReplayedAt time.Time
+}
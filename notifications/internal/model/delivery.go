@@ -0,0 +1,27 @@
+// Define notification delivery DTO for domain layer
+package model
+
+// Define state of sending a notification
+type DeliveryState string
+
+const (
+	// Notification is being sent or the sender crashed
+	DeliveryPending DeliveryState = "pending"
+	DeliverySent    DeliveryState = "sent"
+	// Sending failed and can be retried
+	DeliveryFailed DeliveryState = "failed"
+)
+
+// Results of notifications taken for sending in digests, every one is received once its digest is sent
+type PendingDeliveries []<-chan error
+
+// Wait until the digests of all notifications are sent, the first error is returned
+func (p PendingDeliveries) Wait() error {
+	var first error
+	for _, result := range p {
+		if err := <-result; err != nil && first == nil {
+			first = err
+		}
+	}
+	return first
+}
@@ -0,0 +1,35 @@
+package model
+
+import "time"
+
+// Define a query of a user's notification history
+type HistoryFilter struct {
+	UserID UserID
+	// Inclusive, no lower bound when zero
+	From time.Time
+	// Exclusive, no upper bound when zero
+	To time.Time
+	// Any order when zero
+	OrderID OrderID
+	// Any status when empty
+	Statuses []OrderStatus
+	// Messages with greater ids only, any when zero
+	AfterID MessageID
+	// Messages after the cursor, from the beginning when nil
+	After *HistoryCursor
+	Limit uint64
+	// Order from the newest message instead of the oldest
+	Newest bool
+}
+
+// Define a position in a history ordered by creation time and id
+type HistoryCursor struct {
+	CreatedAt time.Time
+	ID        MessageID
+}
+
+// Define a page of a history, Next is nil on the last page
+type HistoryPage struct {
+	Messages []OrderStatusMessage
+	Next     *HistoryCursor
+}
@@ -0,0 +1,68 @@
+// Define notification content DTO for domain layer
+package model
+
+import (
+	"strings"
+	"time"
+)
+
+// Define language of notifications
+type Locale string
+
+const (
+	LocaleEN Locale = "en"
+	LocaleRU Locale = "ru"
+	// Locale of users who have not chosen one
+	DefaultLocale = LocaleEN
+)
+
+// Get supported locales
+func Locales() []Locale {
+	return []Locale{LocaleEN, LocaleRU}
+}
+
+// Define a kind of notification, a template is chosen by it
+type NotificationKind string
+
+const (
+	KindAbandonedCart NotificationKind = "abandoned_cart"
+)
+
+// Get the kind of notification about the order status, e.g. "awaiting_payment"
+func StatusKind(status OrderStatus) NotificationKind {
+	return NotificationKind(strings.ReplaceAll(string(status), " ", "_"))
+}
+
+// Get kinds every set of templates defines
+func NotificationKinds() []NotificationKind {
+	return []NotificationKind{
+		StatusKind(CreatedStatus),
+		StatusKind(WaitStatus),
+		StatusKind(PaidStatus),
+		StatusKind(FailedStatus),
+		StatusKind(CanceledStatus),
+		KindAbandonedCart,
+	}
+}
+
+// Describe values a notification template is rendered with
+type NotificationData struct {
+	UserID    UserID
+	OrderID   OrderID
+	Status    OrderStatus
+	Message   string
+	ItemCount int
+	UpdatedAt time.Time
+}
+
+// Get data templates are checked and previewed with, values contain characters that need escaping
+func SampleNotificationData() NotificationData {
+	return NotificationData{
+		UserID:    42,
+		OrderID:   1001,
+		Status:    WaitStatus,
+		Message:   "Pay within 10 min. <Thanks> & see_you *soon*!",
+		ItemCount: 3,
+		UpdatedAt: time.Date(2023, time.September, 1, 12, 30, 0, 0, time.UTC),
+	}
+}
@@ -1,6 +1,11 @@
 // Define order's DTO for domain layer
 package model
 
+import (
+	"fmt"
+	"time"
+)
+
 // Define order identifier
 type OrderID int64
 
@@ -11,12 +16,18 @@ type OrderStatus string
 
 type MessageID int64
 
-// Define order status message
+// Define identifier of an event, redelivered events have the same identifier
+type EventID string
+
+// Define order status message, ID and CreatedAt are set once it is saved
 type OrderStatusMessage struct {
-	UserID  UserID
-	OrderID OrderID
-	Status  OrderStatus
-	Message string
+	ID        MessageID
+	EventID   EventID
+	UserID    UserID
+	OrderID   OrderID
+	Status    OrderStatus
+	Message   string
+	CreatedAt time.Time
 }
 
 const (
@@ -26,3 +37,23 @@ const (
 	FailedStatus   OrderStatus = "failed"
 	WaitStatus     OrderStatus = "awaiting payment"
 )
+
+// Define an order as LOMS reports it
+type Order struct {
+	ID        OrderID
+	UserID    UserID
+	Status    OrderStatus
+	ItemCount uint32
+	Total     Money
+}
+
+// Define an amount in minor units of the currency
+type Money struct {
+	Amount   int64
+	Currency string
+}
+
+// Format the amount with two minor digits, e.g. 1500.00 RUB
+func (m Money) String() string {
+	return fmt.Sprintf("%d.%02d %s", m.Amount/100, m.Amount%100, m.Currency)
+}
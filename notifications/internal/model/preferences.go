@@ -0,0 +1,59 @@
+// Define notification preferences DTO for domain layer
+package model
+
+// Define a way to reach the user
+type Channel string
+
+const (
+	ChannelTelegram Channel = "telegram"
+	ChannelEmail    Channel = "email"
+	ChannelWebhook  Channel = "webhook"
+)
+
+// Get supported channels
+func Channels() []Channel {
+	return []Channel{ChannelTelegram, ChannelEmail, ChannelWebhook}
+}
+
+// Describe a channel of the user, the address is a Telegram chat id, an email address or a webhook URL
+type ChannelPreference struct {
+	Channel Channel
+	Address string
+	Enabled bool
+}
+
+// Describe whether the user is notified about the order status
+type StatusPreference struct {
+	Status  OrderStatus
+	Enabled bool
+}
+
+// Describe channels and statuses the user is notified about
+type Preferences struct {
+	UserID UserID
+	// Empty locale is the default one
+	Locale   Locale
+	Channels []ChannelPreference
+	Statuses []StatusPreference
+}
+
+// Check if the user is notified about the status, statuses without a preference are enabled
+func (p Preferences) Wants(status OrderStatus) bool {
+	for _, pref := range p.Statuses {
+		if pref.Status == status {
+			return pref.Enabled
+		}
+	}
+	return true
+}
+
+// Get channels the user is notified through
+func (p Preferences) EnabledChannels() []ChannelPreference {
+	var result []ChannelPreference
+	for _, pref := range p.Channels {
+		if pref.Enabled {
+			result = append(result, pref)
+		}
+	}
+	return result
+}
@@ -0,0 +1,19 @@
+package model
+
+import "time"
+
+// Define Telegram chat identifier
+type ChatID int64
+
+// Define a text message received by the bot
+type ChatMessage struct {
+	ChatID ChatID
+	Text   string
+}
+
+// Define a one-time code linking a Telegram chat to the user
+type LinkCode struct {
+	Code      string
+	UserID    UserID
+	ExpiresAt time.Time
+}
@@ -0,0 +1,115 @@
+// In-memory fan-out of values to subscribers of a key
+package broadcast
+
+import (
+	"sync"
+)
+
+// Fan out published values to subscribers of their key. Every subscriber has
+// a buffer, a subscriber whose buffer is full is dropped instead of blocking publishers
+type Hub[Key comparable, Val any] struct {
+	mu          sync.Mutex
+	subscribers map[Key]map[*Subscription[Key, Val]]struct{}
+	buffer      int
+}
+
+// Describe a subscription to values of a key
+type Subscription[Key comparable, Val any] struct {
+	hub     *Hub[Key, Val]
+	key     Key
+	values  chan Val
+	done    chan struct{}
+	once    sync.Once
+	dropped bool
+}
+
+// Create a hub with buffers of the given size
+func NewHub[Key comparable, Val any](buffer int) *Hub[Key, Val] {
+	return &Hub[Key, Val]{
+		subscribers: make(map[Key]map[*Subscription[Key, Val]]struct{}),
+		buffer:      buffer,
+	}
+}
+
+// Subscribe to values published for the key, the subscription must be closed
+func (h *Hub[Key, Val]) Subscribe(key Key) *Subscription[Key, Val] {
+	sub := &Subscription[Key, Val]{
+		hub:    h,
+		key:    key,
+		values: make(chan Val, h.buffer),
+		done:   make(chan struct{}),
+	}
+
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	if h.subscribers[key] == nil {
+		h.subscribers[key] = make(map[*Subscription[Key, Val]]struct{})
+	}
+	h.subscribers[key][sub] = struct{}{}
+	subscribers.Inc()
+
+	return sub
+}
+
+// Send the value to subscribers of the key without waiting for them
+func (h *Hub[Key, Val]) Publish(key Key, val Val) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for sub := range h.subscribers[key] {
+		select {
+		case sub.values <- val:
+			published.Inc()
+		default:
+			// The subscriber does not keep up
+			sub.dropped = true
+			h.remove(sub)
+			dropped.Inc()
+		}
+	}
+}
+
+// Remove the subscription, the caller holds the lock
+func (h *Hub[Key, Val]) remove(sub *Subscription[Key, Val]) {
+	subs, ok := h.subscribers[sub.key]
+	if !ok {
+		return
+	}
+	if _, ok := subs[sub]; !ok {
+		return
+	}
+
+	delete(subs, sub)
+	if len(subs) == 0 {
+		delete(h.subscribers, sub.key)
+	}
+	subscribers.Dec()
+	sub.once.Do(func() { close(sub.done) })
+}
+
+// Get values in the order they were published
+func (s *Subscription[Key, Val]) Values() <-chan Val {
+	return s.values
+}
+
+// Get a channel closed when the subscription is closed or dropped
+func (s *Subscription[Key, Val]) Done() <-chan struct{} {
+	return s.done
+}
+
+// Check whether the subscription was dropped for a full buffer
+func (s *Subscription[Key, Val]) Dropped() bool {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	return s.dropped
+}
+
+// Stop receiving values
+func (s *Subscription[Key, Val]) Close() {
+	s.hub.mu.Lock()
+	defer s.hub.mu.Unlock()
+
+	s.hub.remove(s)
+}
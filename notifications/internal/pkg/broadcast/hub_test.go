@@ -0,0 +1,75 @@
+package broadcast
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestHub_Publish_Subscriber_ReceivesValue(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	hub := NewHub[int, string](2)
+	sub := hub.Subscribe(1)
+	defer sub.Close()
+
+	// Act
+	hub.Publish(1, "value")
+
+	// Assert
+	require.Equal(t, "value", <-sub.Values())
+}
+
+func TestHub_Publish_OtherKey_NotReceived(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	hub := NewHub[int, string](2)
+	sub := hub.Subscribe(1)
+	defer sub.Close()
+
+	// Act
+	hub.Publish(2, "value")
+
+	// Assert
+	require.Empty(t, sub.Values())
+}
+
+func TestHub_Publish_FullBuffer_DropsSubscriber(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	hub := NewHub[int, string](1)
+	slow := hub.Subscribe(1)
+	fast := hub.Subscribe(1)
+	defer fast.Close()
+
+	// Act
+	hub.Publish(1, "first")
+	<-fast.Values()
+	hub.Publish(1, "second")
+
+	// Assert
+	require.True(t, slow.Dropped())
+	require.Equal(t, "second", <-fast.Values())
+	require.False(t, fast.Dropped())
+	select {
+	case <-slow.Done():
+	default:
+		t.Fatal("dropped subscription is not done")
+	}
+}
+
+func TestSubscription_Close_Twice_OK(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	hub := NewHub[int, string](1)
+	sub := hub.Subscribe(1)
+
+	// Act
+	sub.Close()
+	sub.Close()
+	hub.Publish(1, "value")
+
+	// Assert
+	require.False(t, sub.Dropped())
+	require.Empty(t, hub.subscribers)
+}
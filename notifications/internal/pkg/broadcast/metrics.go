@@ -0,0 +1,34 @@
+// Fan-out metrics
+package broadcast
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	subscribers = promauto.NewGauge(
+		prometheus.GaugeOpts{
+			Namespace: "notifications",
+			Subsystem: "broadcast",
+			Name:      "subscribers",
+			Help:      "Number of active subscriptions",
+		},
+	)
+	published = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "broadcast",
+			Name:      "published_total",
+			Help:      "Number of values put into subscriber buffers",
+		},
+	)
+	dropped = promauto.NewCounter(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "broadcast",
+			Name:      "dropped_subscribers_total",
+			Help:      "Number of subscribers dropped because their buffer was full",
+		},
+	)
+)
@@ -3,12 +3,15 @@ package lru
 import (
 	"container/list"
 	"sync"
+	"time"
 )
 
 // Cache item
 type Item[Key comparable, Val any] struct {
 	key   Key
 	value Val
+	// Zero when the item never expires
+	expiresAt time.Time
 }
 
 // Custom LRU Cacche implementation
@@ -16,28 +19,72 @@ type LRUCache[Key comparable, Val any] struct {
 	items    map[Key]*list.Element
 	queue    *list.List
 	capacity int
-	dataMu   sync.RWMutex
+	ttl      time.Duration
+	name     string
+	now      func() time.Time
+	dataMu   sync.Mutex
+}
+
+// Configure a cache
+type Option func(*options)
+
+type options struct {
+	ttl  time.Duration
+	name string
+}
+
+// Expire items after the ttl, items never expire when it is zero
+func WithTTL(ttl time.Duration) Option {
+	return func(o *options) {
+		o.ttl = ttl
+	}
+}
+
+// Name the cache in its metrics
+func WithName(name string) Option {
+	return func(o *options) {
+		o.name = name
+	}
 }
 
 // Initialize new lru cache
-func NewLRUCache[Key comparable, Val any](capacity int) *LRUCache[Key, Val] {
+func NewLRUCache[Key comparable, Val any](capacity int, opts ...Option) *LRUCache[Key, Val] {
+	o := options{name: "default"}
+	for _, opt := range opts {
+		opt(&o)
+	}
+
 	return &LRUCache[Key, Val]{
 		items:    make(map[Key]*list.Element),
 		queue:    list.New(),
 		capacity: capacity,
+		ttl:      o.ttl,
+		name:     o.name,
+		now:      time.Now,
 	}
 }
 
 // Get element from cache by key
 func (c *LRUCache[Key, Val]) Get(key Key) (Val, bool) {
-	c.dataMu.RLock()
-	defer c.dataMu.RUnlock()
+	// Getting moves the element in the queue, so the lock is exclusive
+	c.dataMu.Lock()
+	defer c.dataMu.Unlock()
 
 	if elem, exists := c.items[key]; exists {
+		item := elem.Value.(*Item[Key, Val])
+		if !item.expiresAt.IsZero() && !c.now().Before(item.expiresAt) {
+			c.remove(elem)
+			evictions.WithLabelValues(c.name, "expired").Inc()
+			misses.WithLabelValues(c.name).Inc()
+			return Item[Key, Val]{}.value, false
+		}
+
 		c.queue.MoveToFront(elem)
-		return elem.Value.(*Item[Key, Val]).value, true
+		hits.WithLabelValues(c.name).Inc()
+		return item.value, true
 	}
 
+	misses.WithLabelValues(c.name).Inc()
 	return Item[Key, Val]{}.value, false
 }
 
@@ -46,15 +93,22 @@ func (c *LRUCache[Key, Val]) Set(key Key, val Val) error {
 	c.dataMu.Lock()
 	defer c.dataMu.Unlock()
 
+	var expiresAt time.Time
+	if c.ttl > 0 {
+		expiresAt = c.now().Add(c.ttl)
+	}
+
 	// Проверяем, существует ли элемент с заданным ключем
 	if elem, exists := c.items[key]; exists {
-		elem.Value.(*Item[Key, Val]).value = val
+		item := elem.Value.(*Item[Key, Val])
+		item.value = val
+		item.expiresAt = expiresAt
 		c.queue.MoveToFront(elem)
 		return nil
 	}
 
 	// Если элемента нет, создаем новый и добавляем в кэш
-	newItem := &Item[Key, Val]{key: key, value: val}
+	newItem := &Item[Key, Val]{key: key, value: val, expiresAt: expiresAt}
 	elem := c.queue.PushFront(newItem)
 	c.items[key] = elem
 
@@ -62,8 +116,8 @@ func (c *LRUCache[Key, Val]) Set(key Key, val Val) error {
 	if c.queue.Len() > c.capacity {
 		oldestElem := c.queue.Back()
 		if oldestElem != nil {
-			oldestElem := c.queue.Remove(oldestElem).(*Item[Key, Val])
-			delete(c.items, oldestElem.key)
+			c.remove(oldestElem)
+			evictions.WithLabelValues(c.name, "capacity").Inc()
 		}
 	}
 
@@ -97,3 +151,9 @@ func (c *LRUCache[Key, Val]) Clear() {
 	c.items = make(map[Key]*list.Element)
 	c.queue.Init()
 }
+
+// Remove the element from the queue and the index, the caller holds the lock
+func (c *LRUCache[Key, Val]) remove(elem *list.Element) {
+	item := c.queue.Remove(elem).(*Item[Key, Val])
+	delete(c.items, item.key)
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"sync"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -175,3 +176,39 @@ func TestLRUCache_ConcurrentSafety_GetSetItems_OK(t *testing.T) {
 	}
 
 }
+
+func TestLRUCache_Get_ExpiredItem_Miss(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	now := time.Now()
+	cache := NewLRUCache[string, string](5, WithTTL(time.Minute))
+	cache.now = func() time.Time { return now }
+	err := cache.Set("test_key", "test_value")
+	require.NoError(t, err)
+	cache.now = func() time.Time { return now.Add(time.Minute) }
+
+	// Act
+	_, ok := cache.Get("test_key")
+
+	// Assert
+	require.False(t, ok)
+	require.Zero(t, cache.Count())
+}
+
+func TestLRUCache_Get_ItemBeforeTTL_OK(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	now := time.Now()
+	cache := NewLRUCache[string, string](5, WithTTL(time.Minute))
+	cache.now = func() time.Time { return now }
+	err := cache.Set("test_key", "test_value")
+	require.NoError(t, err)
+	cache.now = func() time.Time { return now.Add(time.Minute - time.Second) }
+
+	// Act
+	value, ok := cache.Get("test_key")
+
+	// Assert
+	require.True(t, ok)
+	require.Equal(t, "test_value", value)
+}
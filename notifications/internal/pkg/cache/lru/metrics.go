@@ -0,0 +1,37 @@
+// LRU cache metrics
+package lru
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	hits = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "cache",
+			Name:      "hits_total",
+			Help:      "Number of lookups that found a live item",
+		},
+		[]string{"cache"},
+	)
+	misses = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "cache",
+			Name:      "misses_total",
+			Help:      "Number of lookups that found no item or an expired one",
+		},
+		[]string{"cache"},
+	)
+	evictions = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "cache",
+			Name:      "evictions_total",
+			Help:      "Number of items removed because the cache is full or they expired",
+		},
+		[]string{"cache", "reason"},
+	)
+)
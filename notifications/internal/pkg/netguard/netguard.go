@@ -0,0 +1,69 @@
+// Guard against requests to internal addresses on behalf of users
+package netguard
+
+import (
+	"net"
+	"strings"
+	"syscall"
+
+	"github.com/pkg/errors"
+)
+
+var (
+	ErrNotPublic = errors.New("address is not public")
+
+	// Ranges the net.IP methods do not cover
+	sharedAddressSpace = mustParseCIDR("100.64.0.0/10")
+	thisNetwork        = mustParseCIDR("0.0.0.0/8")
+)
+
+// Check if the ip is reachable from the internet: private, loopback, link-local,
+// multicast and unspecified addresses are not
+func PublicIP(ip net.IP) bool {
+	if ip == nil {
+		return false
+	}
+	return !ip.IsPrivate() &&
+		!ip.IsLoopback() &&
+		!ip.IsLinkLocalUnicast() &&
+		!ip.IsLinkLocalMulticast() &&
+		!ip.IsInterfaceLocalMulticast() &&
+		!ip.IsMulticast() &&
+		!ip.IsUnspecified() &&
+		!sharedAddressSpace.Contains(ip) &&
+		!thisNetwork.Contains(ip)
+}
+
+// Check if the host of a URL may be public: an ip literal must be public, localhost names are not.
+// Names are checked again once resolved, see Control
+func PublicHost(host string) bool {
+	host = strings.TrimSuffix(strings.ToLower(host), ".")
+	if host == "" || host == "localhost" || strings.HasSuffix(host, ".localhost") {
+		return false
+	}
+	if ip := net.ParseIP(host); ip != nil {
+		return PublicIP(ip)
+	}
+	return true
+}
+
+// Refuse connections to addresses that are not public, set it as net.Dialer.Control.
+// The check runs on the resolved address, so names resolving to internal addresses are refused too
+func Control(_ string, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return errors.Wrapf(err, "split address %q", address)
+	}
+	if !PublicIP(net.ParseIP(host)) {
+		return errors.Wrapf(ErrNotPublic, "connect to %s", host)
+	}
+	return nil
+}
+
+func mustParseCIDR(cidr string) *net.IPNet {
+	_, network, err := net.ParseCIDR(cidr)
+	if err != nil {
+		panic(err)
+	}
+	return network
+}
@@ -0,0 +1,93 @@
+package netguard
+
+import (
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestPublicHost(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		host   string
+		public bool
+	}{
+		{host: "example.com", public: true},
+		{host: "93.184.216.34", public: true},
+		{host: "2606:2800:220:1:248:1893:25c8:1946", public: true},
+		{host: "localhost", public: false},
+		{host: "api.localhost.", public: false},
+		{host: "127.0.0.1", public: false},
+		{host: "::1", public: false},
+		{host: "10.1.2.3", public: false},
+		{host: "172.16.0.1", public: false},
+		{host: "192.168.1.1", public: false},
+		{host: "169.254.169.254", public: false},
+		{host: "fe80::1", public: false},
+		{host: "fd00::1", public: false},
+		{host: "100.64.0.1", public: false},
+		{host: "0.0.0.0", public: false},
+		{host: "::ffff:127.0.0.1", public: false},
+		{host: "", public: false},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.host, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			public := PublicHost(tt.host)
+
+			// Assert
+			require.Equal(t, tt.public, public)
+		})
+	}
+}
+
+func TestControl(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		name    string
+		address string
+		err     bool
+	}{
+		{name: "public", address: "93.184.216.34:443"},
+		{name: "loopback", address: "127.0.0.1:8080", err: true},
+		{name: "link-local ipv6", address: "[fe80::1]:80", err: true},
+		{name: "private", address: "192.168.0.10:80", err: true},
+	}
+
+	for _, tt := range cases {
+		tt := tt
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			err := Control("tcp", tt.address, nil)
+
+			// Assert
+			if tt.err {
+				require.ErrorIs(t, err, ErrNotPublic)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestControl_Dialer_RefusesLoopback(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	lis, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = lis.Close() })
+	dialer := net.Dialer{Control: Control}
+
+	// Act
+	_, err = dialer.Dial("tcp", lis.Addr().String())
+
+	// Assert
+	require.ErrorIs(t, err, ErrNotPublic)
+}
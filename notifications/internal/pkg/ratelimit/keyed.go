@@ -0,0 +1,94 @@
+// Rate limiter with a separate bucket for each key
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Buckets are looked through for idle ones at most this often
+const sweepInterval = time.Minute
+
+// Keep a separate token bucket for each key,
+// for example for each chat.
+// Buckets that refilled completely are dropped, a later call creates them again
+type KeyedLimiter struct {
+	mu        sync.Mutex
+	name      string
+	rps       float64
+	burst     int
+	limiters  map[string]*Limiter
+	lastSweep time.Time
+	now       func() time.Time
+}
+
+// Create a new keyed rate limiter instance,
+// every key gets its own bucket with the same limits
+func NewKeyed(name string, rps float64, burst int) *KeyedLimiter {
+	k := &KeyedLimiter{
+		name:     name,
+		rps:      rps,
+		burst:    burst,
+		limiters: make(map[string]*Limiter),
+		now:      time.Now,
+	}
+	k.lastSweep = k.now()
+
+	return k
+}
+
+// Get the bucket for the key, create it on the first call
+func (k *KeyedLimiter) Get(key string) *Limiter {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	k.sweep()
+	l, ok := k.limiters[key]
+	if !ok {
+		l = New(k.name, k.rps, k.burst)
+		l.now = k.now
+		l.last = l.now()
+		k.limiters[key] = l
+	}
+
+	return l
+}
+
+// Take a token from the key's bucket if it is available right now
+func (k *KeyedLimiter) Allow(key string) bool {
+	return k.Get(key).Allow()
+}
+
+// Wait until a token is available in the key's bucket
+func (k *KeyedLimiter) Wait(ctx context.Context, key string) error {
+	return k.Get(key).Wait(ctx)
+}
+
+// Hold back requests of the key for the duration
+func (k *KeyedLimiter) Pause(key string, d time.Duration) {
+	k.Get(key).Pause(d)
+}
+
+// Return the number of buckets kept
+func (k *KeyedLimiter) Len() int {
+	k.mu.Lock()
+	defer k.mu.Unlock()
+
+	return len(k.limiters)
+}
+
+// Drop idle buckets if the last sweep was long enough ago, the caller holds the lock
+func (k *KeyedLimiter) sweep() {
+	now := k.now()
+	if now.Sub(k.lastSweep) < sweepInterval {
+		return
+	}
+	k.lastSweep = now
+
+	for key, l := range k.limiters {
+		if l.idle() {
+			delete(k.limiters, key)
+		}
+	}
+}
@@ -0,0 +1,143 @@
+// Rate limiter
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// Token bucket rate limiter
+type Limiter struct {
+	mu     sync.Mutex
+	name   string
+	rate   float64
+	burst  float64
+	tokens float64
+	last   time.Time
+	now    func() time.Time
+}
+
+// Create a new rate limiter instance
+// Takes three parameters as input:
+//
+//	name - limiter name used as a metrics label
+//	rps - limit on the number of requests per second
+//	burst - number of requests that can be made at once
+func New(name string, rps float64, burst int) *Limiter {
+	if burst < 1 {
+		burst = 1
+	}
+
+	l := &Limiter{
+		name:   name,
+		rate:   rps,
+		burst:  float64(burst),
+		tokens: float64(burst),
+		now:    time.Now,
+	}
+	l.last = l.now()
+
+	return l
+}
+
+// Take a token if it is available right now
+func (l *Limiter) Allow() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.now())
+	if l.tokens < 1 {
+		rejected.WithLabelValues(l.name).Inc()
+		return false
+	}
+
+	l.tokens--
+	return true
+}
+
+// Wait until a token is available or the context is done
+func (l *Limiter) Wait(ctx context.Context) error {
+	if err := ctx.Err(); err != nil {
+		rejected.WithLabelValues(l.name).Inc()
+		return err
+	}
+
+	l.mu.Lock()
+	now := l.now()
+	l.advance(now)
+	// Reserve a token in advance, the bucket goes into debt if it is empty
+	l.tokens--
+	delay := l.delay()
+	l.mu.Unlock()
+
+	waitTime.WithLabelValues(l.name).Set(delay.Seconds())
+	if delay == 0 {
+		return nil
+	}
+
+	queueSize.WithLabelValues(l.name).Inc()
+	defer queueSize.WithLabelValues(l.name).Dec()
+
+	timer := time.NewTimer(delay)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		// Return the reserved token so that other requests do not wait for it
+		l.mu.Lock()
+		l.advance(l.now())
+		l.tokens++
+		l.mu.Unlock()
+
+		rejected.WithLabelValues(l.name).Inc()
+		return ctx.Err()
+	}
+}
+
+// Hold back all requests for the duration, e.g. when the upstream asks to retry later.
+// Requests already waiting keep their reserved tokens
+func (l *Limiter) Pause(d time.Duration) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.now())
+	// The next request reserves a token and waits until the bucket is out of debt
+	if tokens := 1 - d.Seconds()*l.rate; l.tokens > tokens {
+		l.tokens = tokens
+	}
+}
+
+// Report whether the bucket is full, such a bucket behaves the same as a new one and can be dropped
+func (l *Limiter) idle() bool {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	l.advance(l.now())
+	return l.tokens >= l.burst
+}
+
+// Refill the bucket with tokens accumulated since the last call
+func (l *Limiter) advance(now time.Time) {
+	elapsed := now.Sub(l.last)
+	if elapsed <= 0 {
+		return
+	}
+	l.last = now
+
+	l.tokens += elapsed.Seconds() * l.rate
+	if l.tokens > l.burst {
+		l.tokens = l.burst
+	}
+}
+
+// Calculate how long to wait until the bucket is out of debt
+func (l *Limiter) delay() time.Duration {
+	if l.tokens >= 0 {
+		return 0
+	}
+
+	return time.Duration(-l.tokens / l.rate * float64(time.Second))
+}
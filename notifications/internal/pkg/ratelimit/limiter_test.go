@@ -0,0 +1,193 @@
+// Rate limiter tests
+package ratelimit
+
+import (
+	"context"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+// Manually driven clock for deterministic tests
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.now
+}
+
+func (c *fakeClock) Add(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.now = c.now.Add(d)
+}
+
+func newTestLimiter(rps float64, burst int) (*Limiter, *fakeClock) {
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	l := New("test", rps, burst)
+	l.now = clock.Now
+	l.last = clock.Now()
+	return l, clock
+}
+
+// Test that the burst is available at once and then requests are rejected
+func TestLimiter_Allow_Burst(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	burst := 5
+	limiter, _ := newTestLimiter(10, burst)
+
+	// Act
+	for i := 0; i < burst; i++ {
+		require.True(t, limiter.Allow(), "request %d", i)
+	}
+
+	// Assert
+	require.False(t, limiter.Allow())
+}
+
+// Test that tokens are refilled according to the rate and never exceed the burst
+func TestLimiter_Allow_Refill(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limiter, clock := newTestLimiter(10, 2)
+	require.True(t, limiter.Allow())
+	require.True(t, limiter.Allow())
+	require.False(t, limiter.Allow())
+
+	// Act
+	clock.Add(100 * time.Millisecond)
+
+	// Assert
+	require.True(t, limiter.Allow())
+	require.False(t, limiter.Allow())
+
+	clock.Add(time.Hour)
+	require.True(t, limiter.Allow())
+	require.True(t, limiter.Allow())
+	require.False(t, limiter.Allow())
+}
+
+// Test if the number of requests per second limit is correctly enforced
+func TestLimiter_Wait_RPSCondition(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	rps := 100
+	burst := 10
+	requestCount := 60
+	limiter := New("test", float64(rps), burst)
+
+	var wg sync.WaitGroup
+	wg.Add(requestCount)
+	start := time.Now()
+
+	// Act
+	for i := 0; i < requestCount; i++ {
+		go func() {
+			defer wg.Done()
+			require.NoError(t, limiter.Wait(context.Background()))
+		}()
+	}
+	wg.Wait()
+
+	// Assert
+	minDuration := time.Duration(requestCount-burst) * time.Second / time.Duration(rps)
+	require.GreaterOrEqual(t, time.Since(start), minDuration-10*time.Millisecond)
+}
+
+// Test that a cancelled wait returns the context error and gives the token back
+func TestLimiter_Wait_ContextCancelled(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limiter, clock := newTestLimiter(1, 1)
+	require.True(t, limiter.Allow())
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Millisecond)
+	defer cancel()
+
+	// Act
+	err := limiter.Wait(ctx)
+
+	// Assert
+	require.ErrorIs(t, err, context.DeadlineExceeded)
+	clock.Add(time.Second)
+	require.True(t, limiter.Allow())
+}
+
+// Test that a wait with a done context fails even when a token is available
+func TestLimiter_Wait_ContextAlreadyCancelled(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limiter, _ := newTestLimiter(1, 1)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	// Act
+	err := limiter.Wait(ctx)
+
+	// Assert
+	require.ErrorIs(t, err, context.Canceled)
+	require.True(t, limiter.Allow())
+}
+
+// Test that a paused bucket gives no tokens until the pause is over
+func TestLimiter_Pause_HoldsRequests(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limiter, clock := newTestLimiter(1, 3)
+
+	// Act
+	limiter.Pause(5 * time.Second)
+
+	// Assert
+	require.False(t, limiter.Allow())
+	clock.Add(4 * time.Second)
+	require.False(t, limiter.Allow())
+	clock.Add(time.Second)
+	require.True(t, limiter.Allow())
+}
+
+// Test that every key has its own bucket
+func TestKeyedLimiter_Allow_SeparateBuckets(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	limiter := NewKeyed("test", 1, 1)
+
+	// Act
+	first := limiter.Allow("first")
+
+	// Assert
+	require.True(t, first)
+	require.False(t, limiter.Allow("first"))
+	require.True(t, limiter.Allow("second"))
+	require.Same(t, limiter.Get("first"), limiter.Get("first"))
+}
+
+// Test that refilled buckets are dropped and buckets still in use are kept
+func TestKeyedLimiter_Get_IdleBucketsEvicted(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	clock := &fakeClock{now: time.Unix(0, 0)}
+	limiter := NewKeyed("test", 0.5, 1)
+	limiter.now = clock.Now
+	limiter.lastSweep = clock.Now()
+	require.True(t, limiter.Allow("idle"))
+	clock.Add(sweepInterval - time.Second)
+	require.True(t, limiter.Allow("busy"))
+	limiter.Pause("paused", 2*sweepInterval)
+
+	// Act
+	clock.Add(time.Second)
+	limiter.Get("other")
+
+	// Assert
+	require.Equal(t, 3, limiter.Len())
+	require.False(t, limiter.Allow("busy"))
+	require.False(t, limiter.Allow("paused"))
+}
@@ -0,0 +1,37 @@
+// Rate limiter metrics
+package ratelimit
+
+import (
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	waitTime = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "notifications",
+			Subsystem: "ratelimit",
+			Name:      "wait_seconds",
+			Help:      "Time the last request had to wait for a token",
+		},
+		[]string{"limiter"},
+	)
+	queueSize = promauto.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Namespace: "notifications",
+			Subsystem: "ratelimit",
+			Name:      "queue_size",
+			Help:      "Number of requests waiting for a token",
+		},
+		[]string{"limiter"},
+	)
+	rejected = promauto.NewCounterVec(
+		prometheus.CounterOpts{
+			Namespace: "notifications",
+			Subsystem: "ratelimit",
+			Name:      "rejected_total",
+			Help:      "Number of requests rejected by the limiter",
+		},
+		[]string{"limiter"},
+	)
+)
@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/tracer"
+	"route256/notifications/internal/repository/schema"
+	"strings"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNameDeadLetter = "dead_letter"
+)
+
+var deadLetterColumns = []string{
+	"id", "topic", "partition", `"offset"`, "key", "value", "error", "attempts", "failed_at", "replayed_at",
+}
+
+// Define repository of messages that failed to be handled
+type DeadLetterRepository struct {
+	db *pgxpool.Pool
+}
+
+// Create a new DeadLetterRepository instance
+func NewDeadLetterRepository(db *pgxpool.Pool) *DeadLetterRepository {
+	return &DeadLetterRepository{db: db}
+}
+
+// Save dead letter, a letter of the same message saved before is kept
+func (r *DeadLetterRepository) SaveDeadLetter(ctx context.Context, letter model.DeadLetter) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/dead_letter/save")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Insert(tableNameDeadLetter).
+		Columns("topic", "partition", `"offset"`, "key", "value", "error", "attempts", "failed_at").
+		Values(letter.Topic, letter.Partition, letter.Offset, letter.Key, letter.Value, letter.Error, letter.Attempts, letter.FailedAt).
+		Suffix(`ON CONFLICT (topic, partition, "offset") DO NOTHING`).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build insert query"))
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "insert dead letter"))
+	}
+
+	return nil
+}
+
+// List dead letters with ids greater than afterID in order of ids
+func (r *DeadLetterRepository) ListDeadLetters(ctx context.Context, afterID model.DeadLetterID, limit uint64, includeReplayed bool) ([]model.DeadLetter, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/dead_letter/list")
+	defer span.Finish()
+
+	query := psql.Select(deadLetterColumns...).
+		From(tableNameDeadLetter).
+		Where(sq.Gt{"id": afterID}).
+		OrderBy("id").
+		Limit(limit)
+	if !includeReplayed {
+		query = query.Where(sq.Eq{"replayed_at": nil})
+	}
+
+	return r.selectDeadLetters(ctx, query)
+}
+
+// Get dead letters by ids, unknown ids are skipped
+func (r *DeadLetterRepository) GetDeadLetters(ctx context.Context, ids []model.DeadLetterID) ([]model.DeadLetter, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/dead_letter/get")
+	defer span.Finish()
+
+	query := psql.Select(deadLetterColumns...).
+		From(tableNameDeadLetter).
+		Where(sq.Eq{"id": ids}).
+		OrderBy("id")
+
+	return r.selectDeadLetters(ctx, query)
+}
+
+// Mark the dead letter as replayed and publish it within one transaction, the row stays locked
+// while it is published, so a letter replayed concurrently is published once. A letter replayed
+// before is not published and false is returned
+func (r *DeadLetterRepository) ReplayDeadLetter(
+	ctx context.Context,
+	id model.DeadLetterID,
+	replayedAt time.Time,
+	publish func(letter model.DeadLetter) error,
+) (bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/dead_letter/replay")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Update(tableNameDeadLetter).
+		Set("replayed_at", replayedAt).
+		Where(sq.Eq{"id": id, "replayed_at": nil}).
+		Suffix("RETURNING " + strings.Join(deadLetterColumns, ", ")).
+		ToSql()
+	if err != nil {
+		return false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build update query"))
+	}
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	var letter schema.DeadLetter
+	err = pgxscan.Get(ctx, tx, &letter, query, args...)
+	if pgxscan.NotFound(err) {
+		return false, nil
+	}
+	if err != nil {
+		return false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "update dead letter"))
+	}
+
+	// A failed publish rolls the mark back, a failed commit after the publish leaves the letter
+	// to be replayed again, handlers skip events that were delivered before
+	err = publish(deadLetterFromSchema(letter))
+	if err != nil {
+		return false, err
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return true, nil
+}
+
+func (r *DeadLetterRepository) selectDeadLetters(ctx context.Context, query sq.SelectBuilder) ([]model.DeadLetter, error) {
+	rawSQL, args, err := query.ToSql()
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build select query"))
+	}
+
+	var letters []schema.DeadLetter
+	err = pgxscan.Select(ctx, r.db, &letters, rawSQL, args...)
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "select dead letters"))
+	}
+
+	result := make([]model.DeadLetter, 0, len(letters))
+	for _, letter := range letters {
+		result = append(result, deadLetterFromSchema(letter))
+	}
+
+	return result, nil
+}
+
+func deadLetterFromSchema(letter schema.DeadLetter) model.DeadLetter {
+	item := model.DeadLetter{
+		ID:        model.DeadLetterID(letter.ID),
+		Topic:     letter.Topic,
+		Partition: letter.Partition,
+		Offset:    letter.Offset,
+		Key:       letter.Key,
+		Value:     letter.Value,
+		Error:     letter.Error,
+		Attempts:  letter.Attempts,
+		FailedAt:  letter.FailedAt.UTC(),
+	}
+	if letter.ReplayedAt != nil {
+		item.ReplayedAt = letter.ReplayedAt.UTC()
+	}
+	return item
+}
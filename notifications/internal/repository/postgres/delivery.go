@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/tracer"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNameDelivery = "delivery"
+)
+
+// Define repository of notification deliveries
+type DeliveryRepository struct {
+	db *pgxpool.Pool
+}
+
+// Create a new DeliveryRepository instance
+func NewDeliveryRepository(db *pgxpool.Pool) *DeliveryRepository {
+	return &DeliveryRepository{db: db}
+}
+
+// Claim sending of the event notification through the channel for the lease.
+// A new, failed or abandoned pending delivery is claimed, otherwise the current state is returned
+func (r *DeliveryRepository) ClaimDelivery(ctx context.Context, eventID model.EventID, channel model.Channel, lease time.Duration) (bool, model.DeliveryState, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/delivery/claim")
+	defer span.Finish()
+
+	lockedUntil := sq.Expr("now() + make_interval(secs => ?)", lease.Seconds())
+	claimQuery, args, err := psql.
+		Insert(tableNameDelivery).
+		Columns("event_id", "channel", "state", "locked_until").
+		Values(eventID, channel, model.DeliveryPending, lockedUntil).
+		Suffix(`ON CONFLICT (event_id, channel) DO UPDATE
+			SET state = EXCLUDED.state, attempts = `+tableNameDelivery+`.attempts + 1,
+				locked_until = EXCLUDED.locked_until, updated_at = now()
+			WHERE `+tableNameDelivery+`.state = ? OR (`+tableNameDelivery+`.state = ? AND `+tableNameDelivery+`.locked_until < now())
+			RETURNING state`, model.DeliveryFailed, model.DeliveryPending).
+		ToSql()
+	if err != nil {
+		return false, "", tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build insert query"))
+	}
+
+	var state string
+	err = r.db.QueryRow(ctx, claimQuery, args...).Scan(&state)
+	if err == nil {
+		return true, model.DeliveryState(state), nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return false, "", tracer.MarkSpanWithError(ctx, errors.Wrap(err, "claim delivery"))
+	}
+
+	// Sent or claimed by another consumer
+	selectQuery, args, err := psql.
+		Select("state").
+		From(tableNameDelivery).
+		Where(sq.Eq{"event_id": eventID, "channel": channel}).
+		ToSql()
+	if err != nil {
+		return false, "", tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build select query"))
+	}
+
+	err = r.db.QueryRow(ctx, selectQuery, args...).Scan(&state)
+	if err != nil {
+		return false, "", tracer.MarkSpanWithError(ctx, errors.Wrap(err, "select delivery state"))
+	}
+
+	return false, model.DeliveryState(state), nil
+}
+
+// Mark the event notification as sent through the channel
+func (r *DeliveryRepository) MarkDeliverySent(ctx context.Context, eventID model.EventID, channel model.Channel) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/delivery/mark_sent")
+	defer span.Finish()
+
+	return r.setState(ctx, eventID, channel, model.DeliverySent, "")
+}
+
+// Mark the event notification as failed through the channel with the error of the sender
+func (r *DeliveryRepository) MarkDeliveryFailed(ctx context.Context, eventID model.EventID, channel model.Channel, reason string) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/delivery/mark_failed")
+	defer span.Finish()
+
+	return r.setState(ctx, eventID, channel, model.DeliveryFailed, reason)
+}
+
+func (r *DeliveryRepository) setState(ctx context.Context, eventID model.EventID, channel model.Channel, state model.DeliveryState, reason string) error {
+	query, args, err := psql.
+		Update(tableNameDelivery).
+		Set("state", state).
+		Set("error", reason).
+		Set("updated_at", sq.Expr("now()")).
+		Where(sq.Eq{"event_id": eventID, "channel": channel}).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build update query"))
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "update delivery"))
+	}
+
+	return nil
+}
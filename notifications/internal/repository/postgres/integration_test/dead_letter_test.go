@@ -0,0 +1,81 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNameDeadLetter = "dead_letter"
+)
+
+// Test replaying a dead letter twice
+func (s *Suite) Test_ReplayDeadLetter_Once() {
+	// Arrange
+	ctx := context.Background()
+	id := s.saveDeadLetter()
+	var published []model.DeadLetter
+	publish := func(letter model.DeadLetter) error {
+		published = append(published, letter)
+		return nil
+	}
+
+	// Act
+	first, err := s.deadLetters.ReplayDeadLetter(ctx, id, time.Now().UTC(), publish)
+	s.Require().NoError(err)
+	second, err := s.deadLetters.ReplayDeadLetter(ctx, id, time.Now().UTC(), publish)
+	s.Require().NoError(err)
+
+	// Assert
+	s.Require().True(first)
+	s.Require().False(second)
+	s.Require().Len(published, 1)
+	s.Require().Equal("orders", published[0].Topic)
+	s.Require().Equal([]byte("paid"), published[0].Value)
+	letters, err := s.deadLetters.GetDeadLetters(ctx, []model.DeadLetterID{id})
+	s.Require().NoError(err)
+	s.Require().False(letters[0].ReplayedAt.IsZero())
+}
+
+// Test replaying a dead letter that fails to be published
+func (s *Suite) Test_ReplayDeadLetter_PublishFailed() {
+	// Arrange
+	ctx := context.Background()
+	id := s.saveDeadLetter()
+
+	// Act
+	replayed, err := s.deadLetters.ReplayDeadLetter(ctx, id, time.Now().UTC(), func(model.DeadLetter) error {
+		return errors.New("kafka is down")
+	})
+
+	// Assert
+	s.Require().Error(err)
+	s.Require().False(replayed)
+	letters, err := s.deadLetters.GetDeadLetters(ctx, []model.DeadLetterID{id})
+	s.Require().NoError(err)
+	s.Require().True(letters[0].ReplayedAt.IsZero())
+}
+
+func (s *Suite) saveDeadLetter() model.DeadLetterID {
+	ctx := context.Background()
+	err := s.deadLetters.SaveDeadLetter(ctx, model.DeadLetter{
+		Topic:     "orders",
+		Partition: 1,
+		Offset:    10,
+		Value:     []byte("paid"),
+		Error:     "telegram is down",
+		Attempts:  3,
+		FailedAt:  time.Now().UTC(),
+	})
+	s.Require().NoError(err)
+
+	letters, err := s.deadLetters.ListDeadLetters(ctx, 0, 1, false)
+	s.Require().NoError(err)
+	s.Require().Len(letters, 1)
+	return letters[0].ID
+}
@@ -0,0 +1,139 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+)
+
+const (
+	tableNameDelivery = "delivery"
+)
+
+var psql = sq.StatementBuilder.PlaceholderFormat(sq.Dollar)
+
+// Test claiming a delivery of a new event
+func (s *Suite) Test_ClaimDelivery_New() {
+	// Act
+	claimed, state, err := s.delivery.ClaimDelivery(context.Background(), "order:1:paid", model.ChannelTelegram, time.Minute)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(claimed)
+	s.Require().Equal(model.DeliveryPending, state)
+	s.Require().Equal(int32(1), s.deliveryAttempts("order:1:paid", model.ChannelTelegram))
+}
+
+// Test claiming a delivery claimed by another consumer within its lease
+func (s *Suite) Test_ClaimDelivery_PendingWithinLease() {
+	// Arrange
+	ctx := context.Background()
+	_, _, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelTelegram, time.Minute)
+	s.Require().NoError(err)
+
+	// Act
+	claimed, state, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelTelegram, time.Minute)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(claimed)
+	s.Require().Equal(model.DeliveryPending, state)
+	s.Require().Equal(int32(1), s.deliveryAttempts("order:1:paid", model.ChannelTelegram))
+}
+
+// Test claiming a pending delivery whose lease expired
+func (s *Suite) Test_ClaimDelivery_PendingLeaseExpired() {
+	// Arrange
+	ctx := context.Background()
+	_, _, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelTelegram, time.Minute)
+	s.Require().NoError(err)
+	query, args, err := psql.Update(tableNameDelivery).
+		Set("locked_until", sq.Expr("now() - interval '1 second'")).
+		Where(sq.Eq{"event_id": "order:1:paid"}).
+		ToSql()
+	s.Require().NoError(err)
+	_, err = s.pg.Exec(ctx, query, args...)
+	s.Require().NoError(err)
+
+	// Act
+	claimed, state, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelTelegram, time.Minute)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(claimed)
+	s.Require().Equal(model.DeliveryPending, state)
+	s.Require().Equal(int32(2), s.deliveryAttempts("order:1:paid", model.ChannelTelegram))
+}
+
+// Test claiming a delivery that failed before
+func (s *Suite) Test_ClaimDelivery_Failed() {
+	// Arrange
+	ctx := context.Background()
+	_, _, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelTelegram, time.Minute)
+	s.Require().NoError(err)
+	err = s.delivery.MarkDeliveryFailed(ctx, "order:1:paid", model.ChannelTelegram, "telegram is down")
+	s.Require().NoError(err)
+
+	// Act
+	claimed, state, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelTelegram, time.Minute)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(claimed)
+	s.Require().Equal(model.DeliveryPending, state)
+	s.Require().Equal(int32(2), s.deliveryAttempts("order:1:paid", model.ChannelTelegram))
+}
+
+// Test claiming a delivery that was sent
+func (s *Suite) Test_ClaimDelivery_Sent() {
+	// Arrange
+	ctx := context.Background()
+	_, _, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelTelegram, time.Minute)
+	s.Require().NoError(err)
+	err = s.delivery.MarkDeliverySent(ctx, "order:1:paid", model.ChannelTelegram)
+	s.Require().NoError(err)
+
+	// Act
+	claimed, state, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelTelegram, time.Minute)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(claimed)
+	s.Require().Equal(model.DeliverySent, state)
+}
+
+// Test claiming deliveries of one event through different channels
+func (s *Suite) Test_ClaimDelivery_OtherChannel() {
+	// Arrange
+	ctx := context.Background()
+	_, _, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelTelegram, time.Minute)
+	s.Require().NoError(err)
+	err = s.delivery.MarkDeliverySent(ctx, "order:1:paid", model.ChannelTelegram)
+	s.Require().NoError(err)
+
+	// Act
+	claimed, state, err := s.delivery.ClaimDelivery(ctx, "order:1:paid", model.ChannelEmail, time.Minute)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().True(claimed)
+	s.Require().Equal(model.DeliveryPending, state)
+}
+
+func (s *Suite) deliveryAttempts(eventID model.EventID, channel model.Channel) int32 {
+	query, args, err := psql.Select("attempts").
+		From(tableNameDelivery).
+		Where(sq.Eq{"event_id": eventID, "channel": channel}).
+		ToSql()
+	s.Require().NoError(err)
+
+	var attempts int32
+	err = pgxscan.Get(context.Background(), s.pg, &attempts, query, args...)
+	s.Require().NoError(err)
+	return attempts
+}
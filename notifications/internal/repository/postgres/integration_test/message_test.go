@@ -0,0 +1,160 @@
+//go:build integration
+
+package integrationtest
+
+import (
+	"context"
+	"fmt"
+	"route256/notifications/internal/model"
+	"time"
+)
+
+const (
+	tableNameMessage = "message"
+)
+
+// Moment all test messages are created around
+var historyStart = time.Date(2023, 9, 1, 10, 0, 0, 0, time.UTC)
+
+// Test saving a redelivered event, the message saved first is returned and not inserted again
+func (s *Suite) Test_Save_RedeliveredEvent() {
+	// Arrange
+	ctx := context.Background()
+	message := model.OrderStatusMessage{EventID: "order:1:payed", UserID: 7, OrderID: 1, Status: model.PaidStatus}
+	first, inserted, err := s.message.Save(ctx, message)
+	s.Require().NoError(err)
+	s.Require().True(inserted)
+
+	// Act
+	again, inserted, err := s.message.Save(ctx, message)
+
+	// Assert
+	s.Require().NoError(err)
+	s.Require().False(inserted)
+	s.Require().Equal(first, again)
+}
+
+// Test paging a history from the oldest message, messages created at the same time are ordered by id
+func (s *Suite) Test_GetHistory_OldestFirstPages() {
+	// Arrange
+	ctx := context.Background()
+	want := s.insertHistory(7, []time.Duration{0, time.Minute, time.Minute, 2 * time.Minute, 3 * time.Minute})
+
+	// Act
+	var got []model.MessageID
+	filter := model.HistoryFilter{UserID: 7, Limit: 2}
+	for page := 0; page < 5; page++ {
+		messages, err := s.message.GetHistory(ctx, filter)
+		s.Require().NoError(err)
+		for _, message := range messages {
+			got = append(got, message.ID)
+		}
+		if uint64(len(messages)) < filter.Limit {
+			break
+		}
+		last := messages[len(messages)-1]
+		filter.After = &model.HistoryCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	// Assert
+	s.Require().Equal(want, got)
+}
+
+// Test paging a history from the newest message
+func (s *Suite) Test_GetHistory_NewestFirstPages() {
+	// Arrange
+	ctx := context.Background()
+	inserted := s.insertHistory(7, []time.Duration{0, time.Minute, time.Minute, 2 * time.Minute})
+	want := []model.MessageID{inserted[3], inserted[2], inserted[1], inserted[0]}
+
+	// Act
+	var got []model.MessageID
+	filter := model.HistoryFilter{UserID: 7, Limit: 3, Newest: true}
+	for page := 0; page < 5; page++ {
+		messages, err := s.message.GetHistory(ctx, filter)
+		s.Require().NoError(err)
+		for _, message := range messages {
+			got = append(got, message.ID)
+		}
+		if uint64(len(messages)) < filter.Limit {
+			break
+		}
+		last := messages[len(messages)-1]
+		filter.After = &model.HistoryCursor{CreatedAt: last.CreatedAt, ID: last.ID}
+	}
+
+	// Assert
+	s.Require().Equal(want, got)
+}
+
+// Test filtering a history by time, order and status
+func (s *Suite) Test_GetHistory_Filters() {
+	// Arrange
+	ctx := context.Background()
+	ids := s.insertHistory(7, []time.Duration{0, time.Minute, 2 * time.Minute, 3 * time.Minute})
+	s.insertHistory(8, []time.Duration{time.Minute})
+
+	cases := []struct {
+		name   string
+		filter model.HistoryFilter
+		want   []model.MessageID
+	}{
+		{
+			name:   "from is inclusive and to is exclusive",
+			filter: model.HistoryFilter{From: historyStart.Add(time.Minute), To: historyStart.Add(3 * time.Minute)},
+			want:   []model.MessageID{ids[1], ids[2]},
+		},
+		{
+			name:   "order",
+			filter: model.HistoryFilter{OrderID: 2},
+			want:   []model.MessageID{ids[2], ids[3]},
+		},
+		{
+			name:   "statuses",
+			filter: model.HistoryFilter{Statuses: []model.OrderStatus{model.CreatedStatus, model.CanceledStatus}},
+			want:   []model.MessageID{ids[0], ids[3]},
+		},
+		{
+			name:   "after id",
+			filter: model.HistoryFilter{AfterID: ids[1]},
+			want:   []model.MessageID{ids[2], ids[3]},
+		},
+	}
+
+	for _, tt := range cases {
+		// Act
+		tt.filter.UserID = 7
+		tt.filter.Limit = 10
+		messages, err := s.message.GetHistory(ctx, tt.filter)
+
+		// Assert
+		s.Require().NoError(err, tt.name)
+		got := make([]model.MessageID, 0, len(messages))
+		for _, message := range messages {
+			s.Require().Equal(model.UserID(7), message.UserID, tt.name)
+			got = append(got, message.ID)
+		}
+		s.Require().Equal(tt.want, got, tt.name)
+	}
+}
+
+// Insert messages of the user created at the offsets from historyStart, in order of ids.
+// Messages go to orders 1, 1, 2, 2 and so on with statuses new, payed, payed, cancelled
+func (s *Suite) insertHistory(userID model.UserID, offsets []time.Duration) []model.MessageID {
+	statuses := []model.OrderStatus{model.CreatedStatus, model.PaidStatus, model.PaidStatus, model.CanceledStatus}
+	ids := make([]model.MessageID, 0, len(offsets))
+	for i, offset := range offsets {
+		query, args, err := psql.Insert(tableNameMessage).
+			Columns("event_id", "user_id", "order_id", "status", "message", "created_date").
+			Values(fmt.Sprintf("test:%d:%d", userID, i), userID, i/2+1, statuses[i%len(statuses)], "status changed", historyStart.Add(offset)).
+			Suffix("RETURNING id").
+			ToSql()
+		s.Require().NoError(err)
+
+		var id model.MessageID
+		err = s.pg.QueryRow(context.Background(), query, args...).Scan(&id)
+		s.Require().NoError(err)
+		ids = append(ids, id)
+	}
+	return ids
+}
@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/tracer"
+	"route256/notifications/internal/repository/schema"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNameUserChannel          = "user_channel"
+	tableNameUserStatusPreference = "user_status_preference"
+	tableNameUserSettings         = "user_settings"
+)
+
+// Define repository of notification preferences
+type PreferencesRepository struct {
+	db *pgxpool.Pool
+}
+
+// Create a new PreferencesRepository instance
+func NewPreferencesRepository(db *pgxpool.Pool) *PreferencesRepository {
+	return &PreferencesRepository{db: db}
+}
+
+// Get preferences of the user, a user without preferences has no channels and statuses
+func (r *PreferencesRepository) GetPreferences(ctx context.Context, userID model.UserID) (model.Preferences, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/preferences/get")
+	defer span.Finish()
+
+	result := model.Preferences{UserID: userID}
+
+	channelQuery, args, err := psql.
+		Select("user_id", "channel", "address", "enabled").
+		From(tableNameUserChannel).
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("channel").
+		ToSql()
+	if err != nil {
+		return result, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build channel query"))
+	}
+
+	var channels []schema.UserChannel
+	err = pgxscan.Select(ctx, r.db, &channels, channelQuery, args...)
+	if err != nil {
+		return result, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "select channels"))
+	}
+
+	statusQuery, args, err := psql.
+		Select("user_id", "status", "enabled").
+		From(tableNameUserStatusPreference).
+		Where(sq.Eq{"user_id": userID}).
+		OrderBy("status").
+		ToSql()
+	if err != nil {
+		return result, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build status query"))
+	}
+
+	var statuses []schema.UserStatusPreference
+	err = pgxscan.Select(ctx, r.db, &statuses, statusQuery, args...)
+	if err != nil {
+		return result, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "select statuses"))
+	}
+
+	localeQuery, args, err := psql.
+		Select("locale").
+		From(tableNameUserSettings).
+		Where(sq.Eq{"user_id": userID}).
+		ToSql()
+	if err != nil {
+		return result, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build locale query"))
+	}
+
+	var locale string
+	err = r.db.QueryRow(ctx, localeQuery, args...).Scan(&locale)
+	if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+		return result, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "select locale"))
+	}
+	result.Locale = model.Locale(locale)
+
+	for _, channel := range channels {
+		result.Channels = append(result.Channels, model.ChannelPreference{
+			Channel: model.Channel(channel.Channel),
+			Address: channel.Address,
+			Enabled: channel.Enabled,
+		})
+	}
+	for _, status := range statuses {
+		result.Statuses = append(result.Statuses, model.StatusPreference{
+			Status:  model.OrderStatus(status.Status),
+			Enabled: status.Enabled,
+		})
+	}
+
+	return result, nil
+}
+
+// Replace preferences of the user in one transaction
+func (r *PreferencesRepository) SetPreferences(ctx context.Context, prefs model.Preferences) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/preferences/set")
+	defer span.Finish()
+
+	tx, err := r.db.BeginTx(ctx, pgx.TxOptions{})
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "begin transaction"))
+	}
+	defer tx.Rollback(ctx)
+
+	for _, table := range []string{tableNameUserChannel, tableNameUserStatusPreference, tableNameUserSettings} {
+		query, args, err := psql.Delete(table).Where(sq.Eq{"user_id": prefs.UserID}).ToSql()
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete query"))
+		}
+		_, err = tx.Exec(ctx, query, args...)
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrapf(err, "delete from %s", table))
+		}
+	}
+
+	if prefs.Locale != "" {
+		query, args, err := psql.
+			Insert(tableNameUserSettings).
+			Columns("user_id", "locale").
+			Values(prefs.UserID, prefs.Locale).
+			ToSql()
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build locale insert query"))
+		}
+		_, err = tx.Exec(ctx, query, args...)
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "insert locale"))
+		}
+	}
+
+	if len(prefs.Channels) > 0 {
+		insert := psql.Insert(tableNameUserChannel).Columns("user_id", "channel", "address", "enabled")
+		for _, channel := range prefs.Channels {
+			insert = insert.Values(prefs.UserID, channel.Channel, channel.Address, channel.Enabled)
+		}
+		query, args, err := insert.ToSql()
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build channel insert query"))
+		}
+		_, err = tx.Exec(ctx, query, args...)
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "insert channels"))
+		}
+	}
+
+	if len(prefs.Statuses) > 0 {
+		insert := psql.Insert(tableNameUserStatusPreference).Columns("user_id", "status", "enabled")
+		for _, status := range prefs.Statuses {
+			insert = insert.Values(prefs.UserID, status.Status, status.Enabled)
+		}
+		query, args, err := insert.ToSql()
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build status insert query"))
+		}
+		_, err = tx.Exec(ctx, query, args...)
+		if err != nil {
+			return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "insert statuses"))
+		}
+	}
+
+	err = tx.Commit(ctx)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "commit transaction"))
+	}
+
+	return nil
+}
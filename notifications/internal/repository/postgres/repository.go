@@ -5,10 +5,10 @@ import (
 	"route256/notifications/internal/model"
 	"route256/notifications/internal/pkg/tracer"
 	"route256/notifications/internal/repository/schema"
-	"time"
 
 	sq "github.com/Masterminds/squirrel"
 	"github.com/georgysavva/scany/pgxscan"
+	"github.com/jackc/pgx/v4"
 	"github.com/jackc/pgx/v4/pgxpool"
 	"github.com/opentracing/opentracing-go"
 	"github.com/pkg/errors"
@@ -30,39 +30,92 @@ func NewMessageRepository(db *pgxpool.Pool) *MessageRepository {
 	return &MessageRepository{db: db}
 }
 
-// Save message
-func (m *MessageRepository) Save(ctx context.Context, message model.OrderStatusMessage) (model.MessageID, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/update_or_add_item")
+// Save message and return it with its id and creation time and whether it was inserted,
+// a message of the same event saved before is kept and returned
+func (m *MessageRepository) Save(ctx context.Context, message model.OrderStatusMessage) (model.OrderStatusMessage, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/message/save")
 	defer span.Finish()
 
-	// If item not exists in cart
 	insertQuery, args, err := psql.
 		Insert(tableNameMessage).
-		Columns("user_id", "order_id", "status", "message").
-		Values(message.UserID, message.OrderID, message.Status, message.Message).
-		Suffix("RETURNING id").
+		Columns("event_id", "user_id", "order_id", "status", "message").
+		Values(message.EventID, message.UserID, message.OrderID, message.Status, message.Message).
+		Suffix("ON CONFLICT (event_id) DO NOTHING RETURNING id, created_date").
 		ToSql()
 
 	if err != nil {
-		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build insert query"))
+		return model.OrderStatusMessage{}, false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build insert query"))
 	}
 
-	var messageID model.MessageID
+	err = m.db.QueryRow(ctx, insertQuery, args...).Scan(&message.ID, &message.CreatedAt)
+	if err == nil {
+		message.CreatedAt = message.CreatedAt.UTC()
+		return message, true, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return model.OrderStatusMessage{}, false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to insert item"))
+	}
+
+	// The event is redelivered
+	selectQuery, args, err := psql.
+		Select("id", "event_id", "user_id", "order_id", "status", "message", "created_date").
+		From(tableNameMessage).
+		Where(sq.Eq{"event_id": message.EventID}).
+		ToSql()
+	if err != nil {
+		return model.OrderStatusMessage{}, false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build select query"))
+	}
 
-	err = m.db.QueryRow(ctx, insertQuery, args...).Scan(&messageID)
+	var saved schema.MessageItem
+	err = pgxscan.Get(ctx, m.db, &saved, selectQuery, args...)
 	if err != nil {
-		return 0, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "failed to insert item"))
+		return model.OrderStatusMessage{}, false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "select saved message"))
 	}
 
-	return messageID, nil
+	return messageFromSchema(saved), false, nil
 }
 
-// Get user messages from period
-func (m *MessageRepository) GetHistoryWithPeriod(ctx context.Context, userID model.UserID, from time.Time, to time.Time) ([]model.OrderStatusMessage, error) {
-	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/cart/update_or_add_item")
+// Get a page of user messages matching the filter, ordered by creation time and id
+func (m *MessageRepository) GetHistory(ctx context.Context, filter model.HistoryFilter) ([]model.OrderStatusMessage, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/message/get_history")
 	defer span.Finish()
 
-	query := psql.Select("*").From(tableNameMessage).Where(sq.Eq{"user_id": userID}).Where(sq.GtOrEq{"created_date": from}).Where(sq.LtOrEq{"created_date": to})
+	query := psql.
+		Select("id", "event_id", "user_id", "order_id", "status", "message", "created_date").
+		From(tableNameMessage).
+		Where(sq.Eq{"user_id": filter.UserID}).
+		Limit(filter.Limit)
+
+	if filter.Newest {
+		query = query.OrderBy("created_date DESC", "id DESC")
+	} else {
+		query = query.OrderBy("created_date", "id")
+	}
+
+	if !filter.From.IsZero() {
+		query = query.Where(sq.GtOrEq{"created_date": filter.From})
+	}
+	if !filter.To.IsZero() {
+		query = query.Where(sq.Lt{"created_date": filter.To})
+	}
+	if filter.OrderID != 0 {
+		query = query.Where(sq.Eq{"order_id": filter.OrderID})
+	}
+	if len(filter.Statuses) > 0 {
+		statuses := make([]string, 0, len(filter.Statuses))
+		for _, status := range filter.Statuses {
+			statuses = append(statuses, string(status))
+		}
+		query = query.Where(sq.Eq{"status": statuses})
+	}
+	if filter.AfterID != 0 {
+		query = query.Where(sq.Gt{"id": filter.AfterID})
+	}
+	if filter.After != nil && filter.Newest {
+		query = query.Where("(created_date, id) < (?, ?)", filter.After.CreatedAt, filter.After.ID)
+	} else if filter.After != nil {
+		query = query.Where("(created_date, id) > (?, ?)", filter.After.CreatedAt, filter.After.ID)
+	}
 
 	rawSQL, args, err := query.ToSql()
 	if err != nil {
@@ -76,16 +129,48 @@ func (m *MessageRepository) GetHistoryWithPeriod(ctx context.Context, userID mod
 		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "exec query for filter"))
 	}
 
-	var result []model.OrderStatusMessage
-
+	result := make([]model.OrderStatusMessage, 0, len(messages))
 	for _, message := range messages {
-		result = append(result, model.OrderStatusMessage{
-			UserID:  model.UserID(message.UserID),
-			OrderID: model.OrderID(message.OrderID),
-			Status:  model.OrderStatus(message.Status),
-			Message: message.Message,
-		})
+		result = append(result, messageFromSchema(message))
 	}
 
 	return result, nil
 }
+
+// Get orders of the user's messages, the order with the latest message first
+func (m *MessageRepository) GetRecentOrders(ctx context.Context, userID model.UserID, limit uint64) ([]model.OrderID, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/message/get_recent_orders")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Select("order_id").
+		From(tableNameMessage).
+		Where(sq.Eq{"user_id": userID}).
+		GroupBy("order_id").
+		OrderBy("max(created_date) DESC", "order_id DESC").
+		Limit(limit).
+		ToSql()
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build query"))
+	}
+
+	var orders []model.OrderID
+	err = pgxscan.Select(ctx, m.db, &orders, query, args...)
+	if err != nil {
+		return nil, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "select orders"))
+	}
+
+	return orders, nil
+}
+
+func messageFromSchema(message schema.MessageItem) model.OrderStatusMessage {
+	return model.OrderStatusMessage{
+		ID:        model.MessageID(message.ID),
+		EventID:   model.EventID(message.EventID),
+		UserID:    model.UserID(message.UserID),
+		OrderID:   model.OrderID(message.OrderID),
+		Status:    model.OrderStatus(message.Status),
+		Message:   message.Message,
+		CreatedAt: message.CreatedDate.UTC(),
+	}
+}
@@ -0,0 +1,158 @@
+package postgres
+
+import (
+	"context"
+	"route256/notifications/internal/model"
+	"route256/notifications/internal/pkg/tracer"
+	"time"
+
+	sq "github.com/Masterminds/squirrel"
+	"github.com/jackc/pgx/v4"
+	"github.com/jackc/pgx/v4/pgxpool"
+	"github.com/opentracing/opentracing-go"
+	"github.com/pkg/errors"
+)
+
+const (
+	tableNameTelegramLinkCode = "telegram_link_code"
+	tableNameTelegramChat     = "telegram_chat"
+)
+
+// Define repository of Telegram chats linked to users
+type TelegramRepository struct {
+	db *pgxpool.Pool
+}
+
+// Create a new TelegramRepository instance
+func NewTelegramRepository(db *pgxpool.Pool) *TelegramRepository {
+	return &TelegramRepository{db: db}
+}
+
+// Save a link code, expired codes are removed
+func (r *TelegramRepository) SaveLinkCode(ctx context.Context, code model.LinkCode) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/telegram/save_link_code")
+	defer span.Finish()
+
+	deleteQuery, args, err := psql.
+		Delete(tableNameTelegramLinkCode).
+		Where(sq.Lt{"expires_at": time.Now().UTC()}).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete query"))
+	}
+	_, err = r.db.Exec(ctx, deleteQuery, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "delete expired link codes"))
+	}
+
+	insertQuery, args, err := psql.
+		Insert(tableNameTelegramLinkCode).
+		Columns("code", "user_id", "expires_at").
+		Values(code.Code, code.UserID, code.ExpiresAt).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build insert query"))
+	}
+	_, err = r.db.Exec(ctx, insertQuery, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "insert link code"))
+	}
+
+	return nil
+}
+
+// Remove the link code and return it, false when there is no such code
+func (r *TelegramRepository) UseLinkCode(ctx context.Context, code string) (model.LinkCode, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/telegram/use_link_code")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Delete(tableNameTelegramLinkCode).
+		Where(sq.Eq{"code": code}).
+		Suffix("RETURNING user_id, expires_at").
+		ToSql()
+	if err != nil {
+		return model.LinkCode{}, false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete query"))
+	}
+
+	result := model.LinkCode{Code: code}
+	err = r.db.QueryRow(ctx, query, args...).Scan(&result.UserID, &result.ExpiresAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return model.LinkCode{}, false, nil
+	}
+	if err != nil {
+		return model.LinkCode{}, false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "delete link code"))
+	}
+
+	return result, true, nil
+}
+
+// Link the chat to the user, a chat linked before is moved to the user
+func (r *TelegramRepository) LinkChat(ctx context.Context, chatID model.ChatID, userID model.UserID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/telegram/link_chat")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Insert(tableNameTelegramChat).
+		Columns("chat_id", "user_id").
+		Values(chatID, userID).
+		Suffix("ON CONFLICT (chat_id) DO UPDATE SET user_id = EXCLUDED.user_id, linked_at = now()").
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build upsert query"))
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "upsert chat"))
+	}
+
+	return nil
+}
+
+// Get the user the chat is linked to, false when it is not linked
+func (r *TelegramRepository) GetChatUser(ctx context.Context, chatID model.ChatID) (model.UserID, bool, error) {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/telegram/get_chat_user")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Select("user_id").
+		From(tableNameTelegramChat).
+		Where(sq.Eq{"chat_id": chatID}).
+		ToSql()
+	if err != nil {
+		return 0, false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build select query"))
+	}
+
+	var userID model.UserID
+	err = r.db.QueryRow(ctx, query, args...).Scan(&userID)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return 0, false, nil
+	}
+	if err != nil {
+		return 0, false, tracer.MarkSpanWithError(ctx, errors.Wrap(err, "select chat"))
+	}
+
+	return userID, true, nil
+}
+
+// Remove the link of the chat
+func (r *TelegramRepository) UnlinkChat(ctx context.Context, chatID model.ChatID) error {
+	span, ctx := opentracing.StartSpanFromContext(ctx, "repository/telegram/unlink_chat")
+	defer span.Finish()
+
+	query, args, err := psql.
+		Delete(tableNameTelegramChat).
+		Where(sq.Eq{"chat_id": chatID}).
+		ToSql()
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "build delete query"))
+	}
+
+	_, err = r.db.Exec(ctx, query, args...)
+	if err != nil {
+		return tracer.MarkSpanWithError(ctx, errors.Wrap(err, "delete chat"))
+	}
+
+	return nil
+}
@@ -0,0 +1,18 @@
+// Dead letter table definition
+package schema
+
+import "time"
+
+// Describe dead letter table in postgres
+type DeadLetter struct {
+	ID         int64      `db:"id"`
+	Topic      string     `db:"topic"`
+	Partition  int32      `db:"partition"`
+	Offset     int64      `db:"offset"`
+	Key        []byte     `db:"key"`
+	Value      []byte     `db:"value"`
+	Error      string     `db:"error"`
+	Attempts   int32      `db:"attempts"`
+	FailedAt   time.Time  `db:"failed_at"`
+	ReplayedAt *time.Time `db:"replayed_at"`
+}
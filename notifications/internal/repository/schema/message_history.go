@@ -6,6 +6,7 @@ import "time"
 // Describe message item table in postgres
 type MessageItem struct {
 	ID          int64     `db:"id"`
+	EventID     string    `db:"event_id"`
 	UserID      int64     `db:"user_id"`
 	OrderID     int64     `db:"order_id"`
 	Status      string    `db:"status"`
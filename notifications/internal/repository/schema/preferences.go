@@ -0,0 +1,17 @@
+// Notification preferences tables definition
+package schema
+
+// Describe user channel table in postgres
+type UserChannel struct {
+	UserID  int64  `db:"user_id"`
+	Channel string `db:"channel"`
+	Address string `db:"address"`
+	Enabled bool   `db:"enabled"`
+}
+
+// Describe user status preference table in postgres
+type UserStatusPreference struct {
+	UserID  int64  `db:"user_id"`
+	Status  string `db:"status"`
+	Enabled bool   `db:"enabled"`
+}
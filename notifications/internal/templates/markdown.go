@@ -0,0 +1,48 @@
+package templates
+
+import (
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// Characters of Telegram MarkdownV2 that are escaped in plain text
+const markdownV2Reserved = "_*[]()~`>#+-=|{}.!\\"
+
+// Characters that are never formatting, except a link URL in parentheses
+const markdownV2Literal = ".!-=+{}#>()"
+
+// Escape reserved characters of Telegram MarkdownV2
+func EscapeMarkdownV2(s string) string {
+	var b strings.Builder
+	b.Grow(len(s))
+	for _, r := range s {
+		if strings.ContainsRune(markdownV2Reserved, r) {
+			b.WriteByte('\\')
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// Check that characters which are never formatting are escaped, Telegram rejects messages with them
+func ValidateMarkdownV2(s string) error {
+	runes := []rune(s)
+	for i := 0; i < len(runes); i++ {
+		switch {
+		case runes[i] == '\\':
+			i++
+		case runes[i] == '(' && i > 0 && runes[i-1] == ']':
+			// Link URL up to the closing parenthesis
+			for i < len(runes) && runes[i] != ')' {
+				if runes[i] == '\\' {
+					i++
+				}
+				i++
+			}
+		case strings.ContainsRune(markdownV2Literal, runes[i]):
+			return errors.Errorf("unescaped %q at %d in MarkdownV2 text", runes[i], i)
+		}
+	}
+	return nil
+}
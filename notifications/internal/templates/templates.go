@@ -0,0 +1,179 @@
+// Notification templates per channel and locale
+package templates
+
+import (
+	"bytes"
+	"embed"
+	htmltemplate "html/template"
+	"io"
+	"io/fs"
+	"os"
+	"path"
+	"route256/notifications/internal/model"
+	"strconv"
+	"strings"
+	texttemplate "text/template"
+
+	"github.com/pkg/errors"
+)
+
+// Built-in templates, files/<locale>/<channel>.tmpl
+//
+//go:embed files
+var builtin embed.FS
+
+var (
+	ErrTemplateNotFound = errors.New("template not found")
+)
+
+// Describe a parsed set of templates of a channel in a locale
+type executor interface {
+	ExecuteTemplate(w io.Writer, name string, data interface{}) error
+	Lookup(name string) bool
+}
+
+type textSet struct{ *texttemplate.Template }
+
+func (s textSet) Lookup(name string) bool { return s.Template.Lookup(name) != nil }
+
+type htmlSet struct{ *htmltemplate.Template }
+
+func (s htmlSet) Lookup(name string) bool { return s.Template.Lookup(name) != nil }
+
+type key struct {
+	channel model.Channel
+	locale  model.Locale
+}
+
+// Define templates of notifications. Every channel and locale has one file with a template per notification kind,
+// emails are HTML and the others are text
+type Templates struct {
+	sets map[key]executor
+}
+
+// Load templates from the directory with the layout of the built-in ones, an empty directory is the built-in templates.
+// Every file must define every notification kind and render sample data, Telegram output must be valid MarkdownV2
+func Load(dir string) (*Templates, error) {
+	var fsys fs.FS
+	if dir == "" {
+		sub, err := fs.Sub(builtin, "files")
+		if err != nil {
+			return nil, errors.Wrap(err, "open built-in templates")
+		}
+		fsys = sub
+	} else {
+		fsys = os.DirFS(dir)
+	}
+
+	t := &Templates{sets: make(map[key]executor)}
+	for _, locale := range model.Locales() {
+		for _, channel := range model.Channels() {
+			set, err := parse(fsys, channel, locale)
+			if err != nil {
+				return nil, err
+			}
+			t.sets[key{channel: channel, locale: locale}] = set
+
+			err = t.validate(channel, locale)
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return t, nil
+}
+
+func parse(fsys fs.FS, channel model.Channel, locale model.Locale) (executor, error) {
+	name := path.Join(string(locale), string(channel)+".tmpl")
+	content, err := fs.ReadFile(fsys, name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "read template %s", name)
+	}
+
+	if channel == model.ChannelEmail {
+		tmpl, err := htmltemplate.New(name).Parse(string(content))
+		if err != nil {
+			return nil, errors.Wrapf(err, "parse template %s", name)
+		}
+		return htmlSet{tmpl}, nil
+	}
+
+	tmpl, err := texttemplate.New(name).Parse(string(content))
+	if err != nil {
+		return nil, errors.Wrapf(err, "parse template %s", name)
+	}
+	return textSet{tmpl}, nil
+}
+
+// Render every kind with sample data
+func (t *Templates) validate(channel model.Channel, locale model.Locale) error {
+	for _, kind := range model.NotificationKinds() {
+		out, err := t.Render(channel, locale, kind, model.SampleNotificationData())
+		if err != nil {
+			return err
+		}
+		if strings.TrimSpace(out) == "" {
+			return errors.Errorf("template %s of %s/%s renders an empty message", kind, locale, channel)
+		}
+		if channel == model.ChannelTelegram {
+			err = ValidateMarkdownV2(out)
+			if err != nil {
+				return errors.Wrapf(err, "template %s of %s/%s", kind, locale, channel)
+			}
+		}
+	}
+	return nil
+}
+
+// Render the notification for the channel in the locale, unknown locales fall back to the default one
+func (t *Templates) Render(channel model.Channel, locale model.Locale, kind model.NotificationKind, data model.NotificationData) (string, error) {
+	set, ok := t.sets[key{channel: channel, locale: locale}]
+	if !ok {
+		set, ok = t.sets[key{channel: channel, locale: model.DefaultLocale}]
+	}
+	if !ok || !set.Lookup(string(kind)) {
+		return "", errors.Wrapf(ErrTemplateNotFound, "%s of %s/%s", kind, locale, channel)
+	}
+
+	var buf bytes.Buffer
+	err := set.ExecuteTemplate(&buf, string(kind), newView(channel, data))
+	if err != nil {
+		return "", errors.Wrapf(err, "render %s of %s/%s", kind, locale, channel)
+	}
+	return strings.TrimSpace(buf.String()), nil
+}
+
+// Describe values of a template formatted for the channel
+type view struct {
+	UserID    string
+	OrderID   string
+	Status    string
+	Message   string
+	ItemCount string
+	UpdatedAt string
+}
+
+// Format the data, values for Telegram are escaped for MarkdownV2 and HTML is escaped by html/template
+func newView(channel model.Channel, data model.NotificationData) view {
+	v := view{
+		UserID:    strconv.FormatInt(int64(data.UserID), 10),
+		OrderID:   strconv.FormatInt(int64(data.OrderID), 10),
+		Status:    string(data.Status),
+		Message:   data.Message,
+		ItemCount: strconv.Itoa(data.ItemCount),
+		UpdatedAt: data.UpdatedAt.Format("2006-01-02 15:04"),
+	}
+	if channel != model.ChannelTelegram {
+		return v
+	}
+
+	return view{
+		UserID:    EscapeMarkdownV2(v.UserID),
+		OrderID:   EscapeMarkdownV2(v.OrderID),
+		Status:    EscapeMarkdownV2(v.Status),
+		Message:   EscapeMarkdownV2(v.Message),
+		ItemCount: EscapeMarkdownV2(v.ItemCount),
+		UpdatedAt: EscapeMarkdownV2(v.UpdatedAt),
+	}
+}
@@ -0,0 +1,83 @@
+package templates
+
+import (
+	"os"
+	"path/filepath"
+	"route256/notifications/internal/model"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestLoad_Builtin_OK(t *testing.T) {
+	t.Parallel()
+
+	_, err := Load("")
+	require.NoError(t, err)
+}
+
+func TestTemplates_Render_EscapedPerChannel(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	tmpl, err := Load("")
+	require.NoError(t, err)
+	data := model.NotificationData{OrderID: 7, Status: model.PaidStatus, Message: "<b>1.5</b> & *bold*"}
+
+	tt := []struct {
+		name    string
+		channel model.Channel
+		locale  model.Locale
+		want    string
+	}{
+		{name: "telegram markdown", channel: model.ChannelTelegram, locale: model.LocaleEN, want: "*Order 7 is paid*\nThank you for your purchase\\!\n<b\\>1\\.5</b\\> & \\*bold\\*"},
+		{name: "email html", channel: model.ChannelEmail, locale: model.LocaleRU, want: "<p>Заказ <b>7</b> оплачен. Спасибо за покупку!</p>\n<p>&lt;b&gt;1.5&lt;/b&gt; &amp; *bold*</p>"},
+		{name: "webhook text", channel: model.ChannelWebhook, locale: model.LocaleEN, want: "Order 7 is paid. <b>1.5</b> & *bold*"},
+		{name: "unknown locale", channel: model.ChannelWebhook, locale: "de", want: "Order 7 is paid. <b>1.5</b> & *bold*"},
+	}
+
+	for _, tc := range tt {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			// Act
+			out, err := tmpl.Render(tc.channel, tc.locale, model.StatusKind(model.PaidStatus), data)
+
+			// Assert
+			require.NoError(t, err)
+			require.Equal(t, tc.want, out)
+		})
+	}
+}
+
+func TestLoad_UnescapedMarkdown_Error(t *testing.T) {
+	t.Parallel()
+	// Arrange
+	dir := t.TempDir()
+	for _, locale := range model.Locales() {
+		for _, channel := range model.Channels() {
+			content, err := builtin.ReadFile("files/" + string(locale) + "/" + string(channel) + ".tmpl")
+			require.NoError(t, err)
+			require.NoError(t, os.MkdirAll(filepath.Join(dir, string(locale)), 0o755))
+			require.NoError(t, os.WriteFile(filepath.Join(dir, string(locale), string(channel)+".tmpl"), content, 0o600))
+		}
+	}
+	broken := `{{define "new"}}Order {{.OrderID}} is created.{{end}}`
+	path := filepath.Join(dir, "en", "telegram.tmpl")
+	content, err := os.ReadFile(path)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, append(content, []byte(broken)...), 0o600))
+
+	// Act
+	_, err = Load(dir)
+
+	// Assert
+	require.Error(t, err)
+}
+
+func TestValidateMarkdownV2(t *testing.T) {
+	t.Parallel()
+
+	require.NoError(t, ValidateMarkdownV2(EscapeMarkdownV2("a.b!c-d(e)")))
+	require.NoError(t, ValidateMarkdownV2("*bold* [link](https://example.com/a.b)"))
+	require.Error(t, ValidateMarkdownV2("a.b"))
+}
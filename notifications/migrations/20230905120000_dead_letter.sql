@@ -0,0 +1,22 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS dead_letter (
+    id BIGSERIAL PRIMARY KEY,
+    topic TEXT NOT NULL,
+    partition INT NOT NULL,
+    "offset" BIGINT NOT NULL,
+    key BYTEA,
+    value BYTEA NOT NULL,
+    error TEXT NOT NULL,
+    attempts INT NOT NULL,
+    failed_at TIMESTAMP NOT NULL,
+    replayed_at TIMESTAMP,
+    -- the dead letter topic is consumed at least once
+    UNIQUE (topic, partition, "offset")
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS dead_letter;
+-- +goose StatementEnd
@@ -0,0 +1,31 @@
+-- +goose Up
+-- +goose StatementBegin
+ALTER TABLE message ADD COLUMN event_id TEXT;
+UPDATE message SET event_id = 'message:' || id;
+ALTER TABLE message ALTER COLUMN event_id SET NOT NULL;
+ALTER TABLE message ADD CONSTRAINT message_event_id_key UNIQUE (event_id);
+
+CREATE TYPE delivery_state AS ENUM (
+    'pending',
+    'sent',
+    'failed'
+);
+
+CREATE TABLE IF NOT EXISTS delivery (
+    event_id TEXT PRIMARY KEY,
+    state delivery_state NOT NULL,
+    attempts INT NOT NULL DEFAULT 1,
+    error TEXT NOT NULL DEFAULT '',
+    -- a pending delivery is not claimed again until the lease expires
+    locked_until TIMESTAMP NOT NULL,
+    created_at TIMESTAMP NOT NULL DEFAULT NOW(),
+    updated_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS delivery;
+DROP TYPE IF EXISTS delivery_state;
+ALTER TABLE message DROP COLUMN IF EXISTS event_id;
+-- +goose StatementEnd
@@ -0,0 +1,41 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TYPE channel AS ENUM (
+    'telegram',
+    'email',
+    'webhook'
+);
+
+CREATE TABLE IF NOT EXISTS user_channel (
+    user_id BIGINT NOT NULL,
+    channel channel NOT NULL,
+    address TEXT NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    PRIMARY KEY (user_id, channel)
+);
+
+-- statuses without a row are enabled
+CREATE TABLE IF NOT EXISTS user_status_preference (
+    user_id BIGINT NOT NULL,
+    status order_status NOT NULL,
+    enabled BOOLEAN NOT NULL,
+    PRIMARY KEY (user_id, status)
+);
+
+-- a notification is sent once per event and channel
+ALTER TABLE delivery ADD COLUMN channel channel NOT NULL DEFAULT 'telegram';
+ALTER TABLE delivery DROP CONSTRAINT delivery_pkey;
+ALTER TABLE delivery ADD PRIMARY KEY (event_id, channel);
+ALTER TABLE delivery ALTER COLUMN channel DROP DEFAULT;
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DELETE FROM delivery WHERE channel <> 'telegram';
+ALTER TABLE delivery DROP CONSTRAINT delivery_pkey;
+ALTER TABLE delivery DROP COLUMN channel;
+ALTER TABLE delivery ADD PRIMARY KEY (event_id);
+DROP TABLE IF EXISTS user_status_preference;
+DROP TABLE IF EXISTS user_channel;
+DROP TYPE IF EXISTS channel;
+-- +goose StatementEnd
@@ -0,0 +1,17 @@
+-- +goose Up
+-- +goose StatementBegin
+-- history is paged by (created_date, id), so both must be set
+UPDATE message SET created_date = NOW() WHERE created_date IS NULL;
+ALTER TABLE message ALTER COLUMN created_date SET NOT NULL;
+-- the cast from CHAR drops the padding spaces
+ALTER TABLE message ALTER COLUMN message TYPE TEXT;
+
+CREATE INDEX IF NOT EXISTS message_user_id_created_date_idx ON message (user_id, created_date, id);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP INDEX IF EXISTS message_user_id_created_date_idx;
+ALTER TABLE message ALTER COLUMN message TYPE CHAR(255) USING message::CHAR(255);
+ALTER TABLE message ALTER COLUMN created_date DROP NOT NULL;
+-- +goose StatementEnd
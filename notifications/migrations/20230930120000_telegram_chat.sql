@@ -0,0 +1,21 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE TABLE IF NOT EXISTS telegram_link_code (
+    code TEXT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    expires_at TIMESTAMP NOT NULL
+);
+
+-- a chat acts for one user, a user can use the bot from several chats
+CREATE TABLE IF NOT EXISTS telegram_chat (
+    chat_id BIGINT PRIMARY KEY,
+    user_id BIGINT NOT NULL,
+    linked_at TIMESTAMP NOT NULL DEFAULT NOW()
+);
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+DROP TABLE IF EXISTS telegram_chat;
+DROP TABLE IF EXISTS telegram_link_code;
+-- +goose StatementEnd
@@ -0,0 +1,28 @@
+-- +goose Up
+-- +goose StatementBegin
+-- times were written in UTC, with time zones they compare right with NOW() in any session zone
+ALTER TABLE message ALTER COLUMN created_date TYPE TIMESTAMPTZ USING created_date AT TIME ZONE 'UTC';
+ALTER TABLE dead_letter
+    ALTER COLUMN failed_at TYPE TIMESTAMPTZ USING failed_at AT TIME ZONE 'UTC',
+    ALTER COLUMN replayed_at TYPE TIMESTAMPTZ USING replayed_at AT TIME ZONE 'UTC';
+ALTER TABLE delivery
+    ALTER COLUMN locked_until TYPE TIMESTAMPTZ USING locked_until AT TIME ZONE 'UTC',
+    ALTER COLUMN created_at TYPE TIMESTAMPTZ USING created_at AT TIME ZONE 'UTC',
+    ALTER COLUMN updated_at TYPE TIMESTAMPTZ USING updated_at AT TIME ZONE 'UTC';
+ALTER TABLE telegram_link_code ALTER COLUMN expires_at TYPE TIMESTAMPTZ USING expires_at AT TIME ZONE 'UTC';
+ALTER TABLE telegram_chat ALTER COLUMN linked_at TYPE TIMESTAMPTZ USING linked_at AT TIME ZONE 'UTC';
+-- +goose StatementEnd
+
+-- +goose Down
+-- +goose StatementBegin
+ALTER TABLE telegram_chat ALTER COLUMN linked_at TYPE TIMESTAMP USING linked_at AT TIME ZONE 'UTC';
+ALTER TABLE telegram_link_code ALTER COLUMN expires_at TYPE TIMESTAMP USING expires_at AT TIME ZONE 'UTC';
+ALTER TABLE delivery
+    ALTER COLUMN locked_until TYPE TIMESTAMP USING locked_until AT TIME ZONE 'UTC',
+    ALTER COLUMN created_at TYPE TIMESTAMP USING created_at AT TIME ZONE 'UTC',
+    ALTER COLUMN updated_at TYPE TIMESTAMP USING updated_at AT TIME ZONE 'UTC';
+ALTER TABLE dead_letter
+    ALTER COLUMN failed_at TYPE TIMESTAMP USING failed_at AT TIME ZONE 'UTC',
+    ALTER COLUMN replayed_at TYPE TIMESTAMP USING replayed_at AT TIME ZONE 'UTC';
+ALTER TABLE message ALTER COLUMN created_date TYPE TIMESTAMP USING created_date AT TIME ZONE 'UTC';
+-- +goose StatementEnd
@@ -0,0 +1,147 @@
+// Code generated by protoc-gen-go-grpc. DO NOT EDIT.
+// versions:
+// - protoc-gen-go-grpc v1.3.0
+// - protoc             v3.21.12
+// source: loms.proto
+
+package loms_v1
+
+import (
+	context "context"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	emptypb "google.golang.org/protobuf/types/known/emptypb"
+)
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+// Requires gRPC-Go v1.32.0 or later.
+const _ = grpc.SupportPackageIsVersion7
+
+const (
+	Loms_ListOrder_FullMethodName   = "/loms.Loms/ListOrder"
+	Loms_CancelOrder_FullMethodName = "/loms.Loms/CancelOrder"
+)
+
+// LomsClient is the client API for Loms service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
+type LomsClient interface {
+	ListOrder(ctx context.Context, in *ListOrderRequest, opts ...grpc.CallOption) (*ListOrderResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error)
+}
+
+type lomsClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewLomsClient(cc grpc.ClientConnInterface) LomsClient {
+	return &lomsClient{cc}
+}
+
+func (c *lomsClient) ListOrder(ctx context.Context, in *ListOrderRequest, opts ...grpc.CallOption) (*ListOrderResponse, error) {
+	out := new(ListOrderResponse)
+	err := c.cc.Invoke(ctx, Loms_ListOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *lomsClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*emptypb.Empty, error) {
+	out := new(emptypb.Empty)
+	err := c.cc.Invoke(ctx, Loms_CancelOrder_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// LomsServer is the server API for Loms service.
+// All implementations must embed UnimplementedLomsServer
+// for forward compatibility
+type LomsServer interface {
+	ListOrder(context.Context, *ListOrderRequest) (*ListOrderResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*emptypb.Empty, error)
+	mustEmbedUnimplementedLomsServer()
+}
+
+// UnimplementedLomsServer must be embedded to have forward compatible implementations.
+type UnimplementedLomsServer struct {
+}
+
+func (UnimplementedLomsServer) ListOrder(context.Context, *ListOrderRequest) (*ListOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListOrder not implemented")
+}
+func (UnimplementedLomsServer) CancelOrder(context.Context, *CancelOrderRequest) (*emptypb.Empty, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedLomsServer) mustEmbedUnimplementedLomsServer() {}
+
+// UnsafeLomsServer may be embedded to opt out of forward compatibility for this service.
+// Use of this interface is not recommended, as added methods to LomsServer will
+// result in compilation errors.
+type UnsafeLomsServer interface {
+	mustEmbedUnimplementedLomsServer()
+}
+
+func RegisterLomsServer(s grpc.ServiceRegistrar, srv LomsServer) {
+	s.RegisterService(&Loms_ServiceDesc, srv)
+}
+
+func _Loms_ListOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).ListOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loms_ListOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).ListOrder(ctx, req.(*ListOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Loms_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(LomsServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Loms_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(LomsServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+// Loms_ServiceDesc is the grpc.ServiceDesc for Loms service.
+// It's only intended for direct use with grpc.RegisterService,
+// and not to be introspected or modified (even as a copy)
+var Loms_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "loms.Loms",
+	HandlerType: (*LomsServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ListOrder",
+			Handler:    _Loms_ListOrder_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _Loms_CancelOrder_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "loms.proto",
+}
@@ -186,6 +186,37 @@ func (m *Message) validate(all bool) error {
 
 	// no validation rules for Message
 
+	if all {
+		switch v := interface{}(m.GetCreatedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, MessageValidationError{
+					field:  "CreatedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetCreatedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return MessageValidationError{
+				field:  "CreatedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	// no validation rules for Id
+
 	if len(errors) > 0 {
 		return MessageMultiError(errors)
 	}
@@ -354,6 +385,139 @@ func (m *GetHistoryWithPeriodRequest) validate(all bool) error {
 		}
 	}
 
+	if all {
+		switch v := interface{}(m.GetFromTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryWithPeriodRequestValidationError{
+					field:  "FromTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryWithPeriodRequestValidationError{
+					field:  "FromTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFromTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryWithPeriodRequestValidationError{
+				field:  "FromTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetToTime()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, GetHistoryWithPeriodRequestValidationError{
+					field:  "ToTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, GetHistoryWithPeriodRequestValidationError{
+					field:  "ToTime",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetToTime()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return GetHistoryWithPeriodRequestValidationError{
+				field:  "ToTime",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if m.GetOrderId() < 0 {
+		err := GetHistoryWithPeriodRequestValidationError{
+			field:  "OrderId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetStatuses()) > 5 {
+		err := GetHistoryWithPeriodRequestValidationError{
+			field:  "Statuses",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	_GetHistoryWithPeriodRequest_Statuses_Unique := make(map[string]struct{}, len(m.GetStatuses()))
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if _, exists := _GetHistoryWithPeriodRequest_Statuses_Unique[item]; exists {
+			err := GetHistoryWithPeriodRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "repeated value must contain unique items",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		} else {
+			_GetHistoryWithPeriodRequest_Statuses_Unique[item] = struct{}{}
+		}
+
+		if _, ok := _GetHistoryWithPeriodRequest_Statuses_InLookup[item]; !ok {
+			err := GetHistoryWithPeriodRequestValidationError{
+				field:  fmt.Sprintf("Statuses[%v]", idx),
+				reason: "value must be in list [new awaiting payment payed failed cancelled]",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if m.GetPageSize() > 100 {
+		err := GetHistoryWithPeriodRequestValidationError{
+			field:  "PageSize",
+			reason: "value must be less than or equal to 100",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetPageToken()) > 128 {
+		err := GetHistoryWithPeriodRequestValidationError{
+			field:  "PageToken",
+			reason: "value length must be at most 128 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
 	if len(errors) > 0 {
 		return GetHistoryWithPeriodRequestMultiError(errors)
 	}
@@ -435,6 +599,14 @@ var _ interface {
 	ErrorName() string
 } = GetHistoryWithPeriodRequestValidationError{}
 
+var _GetHistoryWithPeriodRequest_Statuses_InLookup = map[string]struct{}{
+	"new":              {},
+	"awaiting payment": {},
+	"payed":            {},
+	"failed":           {},
+	"cancelled":        {},
+}
+
 // Validate checks the field values on GetHistoryWithPeriodResponse with the
 // rules defined in the proto definition for this message. If any rules are
 // violated, the first error encountered is returned, or nil if there are no violations.
@@ -491,6 +663,8 @@ func (m *GetHistoryWithPeriodResponse) validate(all bool) error {
 
 	}
 
+	// no validation rules for NextPageToken
+
 	if len(errors) > 0 {
 		return GetHistoryWithPeriodResponseMultiError(errors)
 	}
@@ -571,3 +745,2052 @@ var _ interface {
 	Cause() error
 	ErrorName() string
 } = GetHistoryWithPeriodResponseValidationError{}
+
+// Validate checks the field values on SubscribeRequest with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *SubscribeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SubscribeRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SubscribeRequestMultiError, or nil if none found.
+func (m *SubscribeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SubscribeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := SubscribeRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if m.GetLastEventId() < 0 {
+		err := SubscribeRequestValidationError{
+			field:  "LastEventId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SubscribeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SubscribeRequestMultiError is an error wrapping multiple validation errors
+// returned by SubscribeRequest.ValidateAll() if the designated constraints
+// aren't met.
+type SubscribeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SubscribeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SubscribeRequestMultiError) AllErrors() []error { return m }
+
+// SubscribeRequestValidationError is the validation error returned by
+// SubscribeRequest.Validate if the designated constraints aren't met.
+type SubscribeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SubscribeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SubscribeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SubscribeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SubscribeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SubscribeRequestValidationError) ErrorName() string { return "SubscribeRequestValidationError" }
+
+// Error satisfies the builtin error interface
+func (e SubscribeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSubscribeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SubscribeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SubscribeRequestValidationError{}
+
+// Validate checks the field values on ChannelPreference with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *ChannelPreference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ChannelPreference with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ChannelPreferenceMultiError, or nil if none found.
+func (m *ChannelPreference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ChannelPreference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _ChannelPreference_Channel_InLookup[m.GetChannel()]; !ok {
+		err := ChannelPreferenceValidationError{
+			field:  "Channel",
+			reason: "value must be in list [telegram email webhook]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if utf8.RuneCountInString(m.GetAddress()) > 2048 {
+		err := ChannelPreferenceValidationError{
+			field:  "Address",
+			reason: "value length must be at most 2048 runes",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return ChannelPreferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// ChannelPreferenceMultiError is an error wrapping multiple validation errors
+// returned by ChannelPreference.ValidateAll() if the designated constraints
+// aren't met.
+type ChannelPreferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ChannelPreferenceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ChannelPreferenceMultiError) AllErrors() []error { return m }
+
+// ChannelPreferenceValidationError is the validation error returned by
+// ChannelPreference.Validate if the designated constraints aren't met.
+type ChannelPreferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ChannelPreferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ChannelPreferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ChannelPreferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ChannelPreferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ChannelPreferenceValidationError) ErrorName() string {
+	return "ChannelPreferenceValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ChannelPreferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sChannelPreference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ChannelPreferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ChannelPreferenceValidationError{}
+
+var _ChannelPreference_Channel_InLookup = map[string]struct{}{
+	"telegram": {},
+	"email":    {},
+	"webhook":  {},
+}
+
+// Validate checks the field values on StatusPreference with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *StatusPreference) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on StatusPreference with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// StatusPreferenceMultiError, or nil if none found.
+func (m *StatusPreference) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *StatusPreference) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _StatusPreference_Status_InLookup[m.GetStatus()]; !ok {
+		err := StatusPreferenceValidationError{
+			field:  "Status",
+			reason: "value must be in list [new awaiting payment payed failed cancelled]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for Enabled
+
+	if len(errors) > 0 {
+		return StatusPreferenceMultiError(errors)
+	}
+
+	return nil
+}
+
+// StatusPreferenceMultiError is an error wrapping multiple validation errors
+// returned by StatusPreference.ValidateAll() if the designated constraints
+// aren't met.
+type StatusPreferenceMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m StatusPreferenceMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m StatusPreferenceMultiError) AllErrors() []error { return m }
+
+// StatusPreferenceValidationError is the validation error returned by
+// StatusPreference.Validate if the designated constraints aren't met.
+type StatusPreferenceValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e StatusPreferenceValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e StatusPreferenceValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e StatusPreferenceValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e StatusPreferenceValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e StatusPreferenceValidationError) ErrorName() string { return "StatusPreferenceValidationError" }
+
+// Error satisfies the builtin error interface
+func (e StatusPreferenceValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sStatusPreference.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = StatusPreferenceValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = StatusPreferenceValidationError{}
+
+var _StatusPreference_Status_InLookup = map[string]struct{}{
+	"new":              {},
+	"awaiting payment": {},
+	"payed":            {},
+	"failed":           {},
+	"cancelled":        {},
+}
+
+// Validate checks the field values on Preferences with the rules defined in
+// the proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *Preferences) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on Preferences with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in PreferencesMultiError, or
+// nil if none found.
+func (m *Preferences) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *Preferences) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for User
+
+	for idx, item := range m.GetChannels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreferencesValidationError{
+						field:  fmt.Sprintf("Channels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreferencesValidationError{
+						field:  fmt.Sprintf("Channels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreferencesValidationError{
+					field:  fmt.Sprintf("Channels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, PreferencesValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, PreferencesValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return PreferencesValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	// no validation rules for Locale
+
+	if len(errors) > 0 {
+		return PreferencesMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreferencesMultiError is an error wrapping multiple validation errors
+// returned by Preferences.ValidateAll() if the designated constraints aren't met.
+type PreferencesMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreferencesMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreferencesMultiError) AllErrors() []error { return m }
+
+// PreferencesValidationError is the validation error returned by
+// Preferences.Validate if the designated constraints aren't met.
+type PreferencesValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreferencesValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreferencesValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreferencesValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreferencesValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreferencesValidationError) ErrorName() string { return "PreferencesValidationError" }
+
+// Error satisfies the builtin error interface
+func (e PreferencesValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreferences.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreferencesValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreferencesValidationError{}
+
+// Validate checks the field values on SetPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *SetPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on SetPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// SetPreferencesRequestMultiError, or nil if none found.
+func (m *SetPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *SetPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := SetPreferencesRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(m.GetChannels()) > 3 {
+		err := SetPreferencesRequestValidationError{
+			field:  "Channels",
+			reason: "value must contain no more than 3 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetChannels() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetPreferencesRequestValidationError{
+						field:  fmt.Sprintf("Channels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetPreferencesRequestValidationError{
+						field:  fmt.Sprintf("Channels[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetPreferencesRequestValidationError{
+					field:  fmt.Sprintf("Channels[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(m.GetStatuses()) > 5 {
+		err := SetPreferencesRequestValidationError{
+			field:  "Statuses",
+			reason: "value must contain no more than 5 item(s)",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetStatuses() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, SetPreferencesRequestValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, SetPreferencesRequestValidationError{
+						field:  fmt.Sprintf("Statuses[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return SetPreferencesRequestValidationError{
+					field:  fmt.Sprintf("Statuses[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if _, ok := _SetPreferencesRequest_Locale_InLookup[m.GetLocale()]; !ok {
+		err := SetPreferencesRequestValidationError{
+			field:  "Locale",
+			reason: "value must be in list [ en ru]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return SetPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// SetPreferencesRequestMultiError is an error wrapping multiple validation
+// errors returned by SetPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type SetPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m SetPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m SetPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// SetPreferencesRequestValidationError is the validation error returned by
+// SetPreferencesRequest.Validate if the designated constraints aren't met.
+type SetPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e SetPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e SetPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e SetPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e SetPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e SetPreferencesRequestValidationError) ErrorName() string {
+	return "SetPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e SetPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sSetPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = SetPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = SetPreferencesRequestValidationError{}
+
+var _SetPreferencesRequest_Locale_InLookup = map[string]struct{}{
+	"":   {},
+	"en": {},
+	"ru": {},
+}
+
+// Validate checks the field values on GetPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *GetPreferencesRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on GetPreferencesRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// GetPreferencesRequestMultiError, or nil if none found.
+func (m *GetPreferencesRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *GetPreferencesRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := GetPreferencesRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return GetPreferencesRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// GetPreferencesRequestMultiError is an error wrapping multiple validation
+// errors returned by GetPreferencesRequest.ValidateAll() if the designated
+// constraints aren't met.
+type GetPreferencesRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m GetPreferencesRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m GetPreferencesRequestMultiError) AllErrors() []error { return m }
+
+// GetPreferencesRequestValidationError is the validation error returned by
+// GetPreferencesRequest.Validate if the designated constraints aren't met.
+type GetPreferencesRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e GetPreferencesRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e GetPreferencesRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e GetPreferencesRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e GetPreferencesRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e GetPreferencesRequestValidationError) ErrorName() string {
+	return "GetPreferencesRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e GetPreferencesRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sGetPreferencesRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = GetPreferencesRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = GetPreferencesRequestValidationError{}
+
+// Validate checks the field values on CreateTelegramLinkCodeRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *CreateTelegramLinkCodeRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on CreateTelegramLinkCodeRequest with
+// the rules defined in the proto definition for this message. If any rules
+// are violated, the result is a list of violation errors wrapped in
+// CreateTelegramLinkCodeRequestMultiError, or nil if none found.
+func (m *CreateTelegramLinkCodeRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *CreateTelegramLinkCodeRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetUser() <= 0 {
+		err := CreateTelegramLinkCodeRequestValidationError{
+			field:  "User",
+			reason: "value must be greater than 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return CreateTelegramLinkCodeRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// CreateTelegramLinkCodeRequestMultiError is an error wrapping multiple
+// validation errors returned by CreateTelegramLinkCodeRequest.ValidateAll()
+// if the designated constraints aren't met.
+type CreateTelegramLinkCodeRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m CreateTelegramLinkCodeRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m CreateTelegramLinkCodeRequestMultiError) AllErrors() []error { return m }
+
+// CreateTelegramLinkCodeRequestValidationError is the validation error
+// returned by CreateTelegramLinkCodeRequest.Validate if the designated
+// constraints aren't met.
+type CreateTelegramLinkCodeRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e CreateTelegramLinkCodeRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e CreateTelegramLinkCodeRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e CreateTelegramLinkCodeRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e CreateTelegramLinkCodeRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e CreateTelegramLinkCodeRequestValidationError) ErrorName() string {
+	return "CreateTelegramLinkCodeRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e CreateTelegramLinkCodeRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sCreateTelegramLinkCodeRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = CreateTelegramLinkCodeRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = CreateTelegramLinkCodeRequestValidationError{}
+
+// Validate checks the field values on TelegramLinkCode with the rules defined
+// in the proto definition for this message. If any rules are violated, the
+// first error encountered is returned, or nil if there are no violations.
+func (m *TelegramLinkCode) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on TelegramLinkCode with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// TelegramLinkCodeMultiError, or nil if none found.
+func (m *TelegramLinkCode) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *TelegramLinkCode) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Code
+
+	if all {
+		switch v := interface{}(m.GetExpiresAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, TelegramLinkCodeValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, TelegramLinkCodeValidationError{
+					field:  "ExpiresAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetExpiresAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return TelegramLinkCodeValidationError{
+				field:  "ExpiresAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return TelegramLinkCodeMultiError(errors)
+	}
+
+	return nil
+}
+
+// TelegramLinkCodeMultiError is an error wrapping multiple validation errors
+// returned by TelegramLinkCode.ValidateAll() if the designated constraints
+// aren't met.
+type TelegramLinkCodeMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m TelegramLinkCodeMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m TelegramLinkCodeMultiError) AllErrors() []error { return m }
+
+// TelegramLinkCodeValidationError is the validation error returned by
+// TelegramLinkCode.Validate if the designated constraints aren't met.
+type TelegramLinkCodeValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e TelegramLinkCodeValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e TelegramLinkCodeValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e TelegramLinkCodeValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e TelegramLinkCodeValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e TelegramLinkCodeValidationError) ErrorName() string { return "TelegramLinkCodeValidationError" }
+
+// Error satisfies the builtin error interface
+func (e TelegramLinkCodeValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sTelegramLinkCode.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = TelegramLinkCodeValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = TelegramLinkCodeValidationError{}
+
+// Validate checks the field values on PreviewTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewTemplateRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewTemplateRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewTemplateRequestMultiError, or nil if none found.
+func (m *PreviewTemplateRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewTemplateRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if _, ok := _PreviewTemplateRequest_Channel_InLookup[m.GetChannel()]; !ok {
+		err := PreviewTemplateRequestValidationError{
+			field:  "Channel",
+			reason: "value must be in list [telegram email webhook]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _PreviewTemplateRequest_Locale_InLookup[m.GetLocale()]; !ok {
+		err := PreviewTemplateRequestValidationError{
+			field:  "Locale",
+			reason: "value must be in list [en ru]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if _, ok := _PreviewTemplateRequest_Kind_InLookup[m.GetKind()]; !ok {
+		err := PreviewTemplateRequestValidationError{
+			field:  "Kind",
+			reason: "value must be in list [new awaiting_payment payed failed cancelled abandoned_cart]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if len(errors) > 0 {
+		return PreviewTemplateRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewTemplateRequestMultiError is an error wrapping multiple validation
+// errors returned by PreviewTemplateRequest.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewTemplateRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewTemplateRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewTemplateRequestMultiError) AllErrors() []error { return m }
+
+// PreviewTemplateRequestValidationError is the validation error returned by
+// PreviewTemplateRequest.Validate if the designated constraints aren't met.
+type PreviewTemplateRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewTemplateRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewTemplateRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewTemplateRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewTemplateRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewTemplateRequestValidationError) ErrorName() string {
+	return "PreviewTemplateRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewTemplateRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewTemplateRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewTemplateRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewTemplateRequestValidationError{}
+
+var _PreviewTemplateRequest_Channel_InLookup = map[string]struct{}{
+	"telegram": {},
+	"email":    {},
+	"webhook":  {},
+}
+
+var _PreviewTemplateRequest_Locale_InLookup = map[string]struct{}{
+	"en": {},
+	"ru": {},
+}
+
+var _PreviewTemplateRequest_Kind_InLookup = map[string]struct{}{
+	"new":              {},
+	"awaiting_payment": {},
+	"payed":            {},
+	"failed":           {},
+	"cancelled":        {},
+	"abandoned_cart":   {},
+}
+
+// Validate checks the field values on PreviewTemplateResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *PreviewTemplateResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on PreviewTemplateResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// PreviewTemplateResponseMultiError, or nil if none found.
+func (m *PreviewTemplateResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *PreviewTemplateResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Text
+
+	if len(errors) > 0 {
+		return PreviewTemplateResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// PreviewTemplateResponseMultiError is an error wrapping multiple validation
+// errors returned by PreviewTemplateResponse.ValidateAll() if the designated
+// constraints aren't met.
+type PreviewTemplateResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m PreviewTemplateResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m PreviewTemplateResponseMultiError) AllErrors() []error { return m }
+
+// PreviewTemplateResponseValidationError is the validation error returned by
+// PreviewTemplateResponse.Validate if the designated constraints aren't met.
+type PreviewTemplateResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e PreviewTemplateResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e PreviewTemplateResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e PreviewTemplateResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e PreviewTemplateResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e PreviewTemplateResponseValidationError) ErrorName() string {
+	return "PreviewTemplateResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e PreviewTemplateResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sPreviewTemplateResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = PreviewTemplateResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = PreviewTemplateResponseValidationError{}
+
+// Validate checks the field values on DeadLetter with the rules defined in the
+// proto definition for this message. If any rules are violated, the first
+// error encountered is returned, or nil if there are no violations.
+func (m *DeadLetter) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on DeadLetter with the rules defined in
+// the proto definition for this message. If any rules are violated, the
+// result is a list of violation errors wrapped in DeadLetterMultiError, or
+// nil if none found.
+func (m *DeadLetter) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *DeadLetter) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	// no validation rules for Id
+
+	// no validation rules for Topic
+
+	// no validation rules for Partition
+
+	// no validation rules for Offset
+
+	// no validation rules for Key
+
+	// no validation rules for Value
+
+	// no validation rules for Error
+
+	// no validation rules for Attempts
+
+	if all {
+		switch v := interface{}(m.GetFailedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "FailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "FailedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetFailedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterValidationError{
+				field:  "FailedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if all {
+		switch v := interface{}(m.GetReplayedAt()).(type) {
+		case interface{ ValidateAll() error }:
+			if err := v.ValidateAll(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "ReplayedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		case interface{ Validate() error }:
+			if err := v.Validate(); err != nil {
+				errors = append(errors, DeadLetterValidationError{
+					field:  "ReplayedAt",
+					reason: "embedded message failed validation",
+					cause:  err,
+				})
+			}
+		}
+	} else if v, ok := interface{}(m.GetReplayedAt()).(interface{ Validate() error }); ok {
+		if err := v.Validate(); err != nil {
+			return DeadLetterValidationError{
+				field:  "ReplayedAt",
+				reason: "embedded message failed validation",
+				cause:  err,
+			}
+		}
+	}
+
+	if len(errors) > 0 {
+		return DeadLetterMultiError(errors)
+	}
+
+	return nil
+}
+
+// DeadLetterMultiError is an error wrapping multiple validation errors
+// returned by DeadLetter.ValidateAll() if the designated constraints aren't met.
+type DeadLetterMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m DeadLetterMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m DeadLetterMultiError) AllErrors() []error { return m }
+
+// DeadLetterValidationError is the validation error returned by
+// DeadLetter.Validate if the designated constraints aren't met.
+type DeadLetterValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e DeadLetterValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e DeadLetterValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e DeadLetterValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e DeadLetterValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e DeadLetterValidationError) ErrorName() string { return "DeadLetterValidationError" }
+
+// Error satisfies the builtin error interface
+func (e DeadLetterValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sDeadLetter.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = DeadLetterValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = DeadLetterValidationError{}
+
+// Validate checks the field values on ListDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLettersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeadLettersRequestMultiError, or nil if none found.
+func (m *ListDeadLettersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLettersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if m.GetAfterId() < 0 {
+		err := ListDeadLettersRequestValidationError{
+			field:  "AfterId",
+			reason: "value must be greater than or equal to 0",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	if val := m.GetLimit(); val <= 0 || val > 100 {
+		err := ListDeadLettersRequestValidationError{
+			field:  "Limit",
+			reason: "value must be inside range (0, 100]",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	// no validation rules for IncludeReplayed
+
+	if len(errors) > 0 {
+		return ListDeadLettersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLettersRequestMultiError is an error wrapping multiple validation
+// errors returned by ListDeadLettersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeadLettersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLettersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLettersRequestMultiError) AllErrors() []error { return m }
+
+// ListDeadLettersRequestValidationError is the validation error returned by
+// ListDeadLettersRequest.Validate if the designated constraints aren't met.
+type ListDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersRequestValidationError) ErrorName() string {
+	return "ListDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersRequestValidationError{}
+
+// Validate checks the field values on ListDeadLettersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ListDeadLettersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ListDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ListDeadLettersResponseMultiError, or nil if none found.
+func (m *ListDeadLettersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ListDeadLettersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	for idx, item := range m.GetDeadLetters() {
+		_, _ = idx, item
+
+		if all {
+			switch v := interface{}(item).(type) {
+			case interface{ ValidateAll() error }:
+				if err := v.ValidateAll(); err != nil {
+					errors = append(errors, ListDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("DeadLetters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			case interface{ Validate() error }:
+				if err := v.Validate(); err != nil {
+					errors = append(errors, ListDeadLettersResponseValidationError{
+						field:  fmt.Sprintf("DeadLetters[%v]", idx),
+						reason: "embedded message failed validation",
+						cause:  err,
+					})
+				}
+			}
+		} else if v, ok := interface{}(item).(interface{ Validate() error }); ok {
+			if err := v.Validate(); err != nil {
+				return ListDeadLettersResponseValidationError{
+					field:  fmt.Sprintf("DeadLetters[%v]", idx),
+					reason: "embedded message failed validation",
+					cause:  err,
+				}
+			}
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ListDeadLettersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ListDeadLettersResponseMultiError is an error wrapping multiple validation
+// errors returned by ListDeadLettersResponse.ValidateAll() if the designated
+// constraints aren't met.
+type ListDeadLettersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ListDeadLettersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ListDeadLettersResponseMultiError) AllErrors() []error { return m }
+
+// ListDeadLettersResponseValidationError is the validation error returned by
+// ListDeadLettersResponse.Validate if the designated constraints aren't met.
+type ListDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ListDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ListDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ListDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ListDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ListDeadLettersResponseValidationError) ErrorName() string {
+	return "ListDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ListDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sListDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ListDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ListDeadLettersResponseValidationError{}
+
+// Validate checks the field values on ReplayDeadLettersRequest with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLettersRequest) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLettersRequest with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeadLettersRequestMultiError, or nil if none found.
+func (m *ReplayDeadLettersRequest) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLettersRequest) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if l := len(m.GetIds()); l < 1 || l > 100 {
+		err := ReplayDeadLettersRequestValidationError{
+			field:  "Ids",
+			reason: "value must contain between 1 and 100 items, inclusive",
+		}
+		if !all {
+			return err
+		}
+		errors = append(errors, err)
+	}
+
+	for idx, item := range m.GetIds() {
+		_, _ = idx, item
+
+		if item <= 0 {
+			err := ReplayDeadLettersRequestValidationError{
+				field:  fmt.Sprintf("Ids[%v]", idx),
+				reason: "value must be greater than 0",
+			}
+			if !all {
+				return err
+			}
+			errors = append(errors, err)
+		}
+
+	}
+
+	if len(errors) > 0 {
+		return ReplayDeadLettersRequestMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLettersRequestMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeadLettersRequest.ValidateAll() if the designated
+// constraints aren't met.
+type ReplayDeadLettersRequestMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLettersRequestMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLettersRequestMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLettersRequestValidationError is the validation error returned by
+// ReplayDeadLettersRequest.Validate if the designated constraints aren't met.
+type ReplayDeadLettersRequestValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLettersRequestValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLettersRequestValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLettersRequestValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLettersRequestValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLettersRequestValidationError) ErrorName() string {
+	return "ReplayDeadLettersRequestValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLettersRequestValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLettersRequest.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLettersRequestValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLettersRequestValidationError{}
+
+// Validate checks the field values on ReplayDeadLettersResponse with the rules
+// defined in the proto definition for this message. If any rules are
+// violated, the first error encountered is returned, or nil if there are no violations.
+func (m *ReplayDeadLettersResponse) Validate() error {
+	return m.validate(false)
+}
+
+// ValidateAll checks the field values on ReplayDeadLettersResponse with the
+// rules defined in the proto definition for this message. If any rules are
+// violated, the result is a list of violation errors wrapped in
+// ReplayDeadLettersResponseMultiError, or nil if none found.
+func (m *ReplayDeadLettersResponse) ValidateAll() error {
+	return m.validate(true)
+}
+
+func (m *ReplayDeadLettersResponse) validate(all bool) error {
+	if m == nil {
+		return nil
+	}
+
+	var errors []error
+
+	if len(errors) > 0 {
+		return ReplayDeadLettersResponseMultiError(errors)
+	}
+
+	return nil
+}
+
+// ReplayDeadLettersResponseMultiError is an error wrapping multiple validation
+// errors returned by ReplayDeadLettersResponse.ValidateAll() if the
+// designated constraints aren't met.
+type ReplayDeadLettersResponseMultiError []error
+
+// Error returns a concatenation of all the error messages it wraps.
+func (m ReplayDeadLettersResponseMultiError) Error() string {
+	var msgs []string
+	for _, err := range m {
+		msgs = append(msgs, err.Error())
+	}
+	return strings.Join(msgs, "; ")
+}
+
+// AllErrors returns a list of validation violation errors.
+func (m ReplayDeadLettersResponseMultiError) AllErrors() []error { return m }
+
+// ReplayDeadLettersResponseValidationError is the validation error returned by
+// ReplayDeadLettersResponse.Validate if the designated constraints aren't met.
+type ReplayDeadLettersResponseValidationError struct {
+	field  string
+	reason string
+	cause  error
+	key    bool
+}
+
+// Field function returns field value.
+func (e ReplayDeadLettersResponseValidationError) Field() string { return e.field }
+
+// Reason function returns reason value.
+func (e ReplayDeadLettersResponseValidationError) Reason() string { return e.reason }
+
+// Cause function returns cause value.
+func (e ReplayDeadLettersResponseValidationError) Cause() error { return e.cause }
+
+// Key function returns key value.
+func (e ReplayDeadLettersResponseValidationError) Key() bool { return e.key }
+
+// ErrorName returns error name.
+func (e ReplayDeadLettersResponseValidationError) ErrorName() string {
+	return "ReplayDeadLettersResponseValidationError"
+}
+
+// Error satisfies the builtin error interface
+func (e ReplayDeadLettersResponseValidationError) Error() string {
+	cause := ""
+	if e.cause != nil {
+		cause = fmt.Sprintf(" | caused by: %v", e.cause)
+	}
+
+	key := ""
+	if e.key {
+		key = "key for "
+	}
+
+	return fmt.Sprintf(
+		"invalid %sReplayDeadLettersResponse.%s: %s%s",
+		key,
+		e.field,
+		e.reason,
+		cause)
+}
+
+var _ error = ReplayDeadLettersResponseValidationError{}
+
+var _ interface {
+	Field() string
+	Reason() string
+	Key() bool
+	Cause() error
+	ErrorName() string
+} = ReplayDeadLettersResponseValidationError{}
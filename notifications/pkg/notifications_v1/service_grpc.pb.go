@@ -19,14 +19,37 @@ import (
 const _ = grpc.SupportPackageIsVersion7
 
 const (
-	Notifications_GetHistoryWithPeriod_FullMethodName = "/notifications.Notifications/GetHistoryWithPeriod"
+	Notifications_GetHistoryWithPeriod_FullMethodName   = "/notifications.Notifications/GetHistoryWithPeriod"
+	Notifications_Subscribe_FullMethodName              = "/notifications.Notifications/Subscribe"
+	Notifications_SetPreferences_FullMethodName         = "/notifications.Notifications/SetPreferences"
+	Notifications_GetPreferences_FullMethodName         = "/notifications.Notifications/GetPreferences"
+	Notifications_CreateTelegramLinkCode_FullMethodName = "/notifications.Notifications/CreateTelegramLinkCode"
+	Notifications_PreviewTemplate_FullMethodName        = "/notifications.Notifications/PreviewTemplate"
+	Notifications_ListDeadLetters_FullMethodName        = "/notifications.Notifications/ListDeadLetters"
+	Notifications_ReplayDeadLetters_FullMethodName      = "/notifications.Notifications/ReplayDeadLetters"
 )
 
 // NotificationsClient is the client API for Notifications service.
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://pkg.go.dev/google.golang.org/grpc/?tab=doc#ClientConn.NewStream.
 type NotificationsClient interface {
+	// Page of the user's notifications, oldest first
 	GetHistoryWithPeriod(ctx context.Context, in *GetHistoryWithPeriodRequest, opts ...grpc.CallOption) (*GetHistoryWithPeriodResponse, error)
+	// Stream the user's new notifications as they are saved. With last_event_id
+	// the notifications saved after that one are sent first.
+	// Served over HTTP as Server-Sent Events at GET /notifications/stream
+	Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Notifications_SubscribeClient, error)
+	// Replace channels and statuses the user is notified about
+	SetPreferences(ctx context.Context, in *SetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error)
+	GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error)
+	// One-time code to send to the Telegram bot as /link <code>, links the chat to the user
+	CreateTelegramLinkCode(ctx context.Context, in *CreateTelegramLinkCodeRequest, opts ...grpc.CallOption) (*TelegramLinkCode, error)
+	// Admin only: render a template with sample data
+	PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*PreviewTemplateResponse, error)
+	// Admin only: messages that failed to be handled after all retries
+	ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error)
+	// Admin only: publish dead letters back to their original topics
+	ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error)
 }
 
 type notificationsClient struct {
@@ -46,11 +69,113 @@ func (c *notificationsClient) GetHistoryWithPeriod(ctx context.Context, in *GetH
 	return out, nil
 }
 
+func (c *notificationsClient) Subscribe(ctx context.Context, in *SubscribeRequest, opts ...grpc.CallOption) (Notifications_SubscribeClient, error) {
+	stream, err := c.cc.NewStream(ctx, &Notifications_ServiceDesc.Streams[0], Notifications_Subscribe_FullMethodName, opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &notificationsSubscribeClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type Notifications_SubscribeClient interface {
+	Recv() (*Message, error)
+	grpc.ClientStream
+}
+
+type notificationsSubscribeClient struct {
+	grpc.ClientStream
+}
+
+func (x *notificationsSubscribeClient) Recv() (*Message, error) {
+	m := new(Message)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func (c *notificationsClient) SetPreferences(ctx context.Context, in *SetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error) {
+	out := new(Preferences)
+	err := c.cc.Invoke(ctx, Notifications_SetPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsClient) GetPreferences(ctx context.Context, in *GetPreferencesRequest, opts ...grpc.CallOption) (*Preferences, error) {
+	out := new(Preferences)
+	err := c.cc.Invoke(ctx, Notifications_GetPreferences_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsClient) CreateTelegramLinkCode(ctx context.Context, in *CreateTelegramLinkCodeRequest, opts ...grpc.CallOption) (*TelegramLinkCode, error) {
+	out := new(TelegramLinkCode)
+	err := c.cc.Invoke(ctx, Notifications_CreateTelegramLinkCode_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsClient) PreviewTemplate(ctx context.Context, in *PreviewTemplateRequest, opts ...grpc.CallOption) (*PreviewTemplateResponse, error) {
+	out := new(PreviewTemplateResponse)
+	err := c.cc.Invoke(ctx, Notifications_PreviewTemplate_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsClient) ListDeadLetters(ctx context.Context, in *ListDeadLettersRequest, opts ...grpc.CallOption) (*ListDeadLettersResponse, error) {
+	out := new(ListDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Notifications_ListDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *notificationsClient) ReplayDeadLetters(ctx context.Context, in *ReplayDeadLettersRequest, opts ...grpc.CallOption) (*ReplayDeadLettersResponse, error) {
+	out := new(ReplayDeadLettersResponse)
+	err := c.cc.Invoke(ctx, Notifications_ReplayDeadLetters_FullMethodName, in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // NotificationsServer is the server API for Notifications service.
 // All implementations must embed UnimplementedNotificationsServer
 // for forward compatibility
 type NotificationsServer interface {
+	// Page of the user's notifications, oldest first
 	GetHistoryWithPeriod(context.Context, *GetHistoryWithPeriodRequest) (*GetHistoryWithPeriodResponse, error)
+	// Stream the user's new notifications as they are saved. With last_event_id
+	// the notifications saved after that one are sent first.
+	// Served over HTTP as Server-Sent Events at GET /notifications/stream
+	Subscribe(*SubscribeRequest, Notifications_SubscribeServer) error
+	// Replace channels and statuses the user is notified about
+	SetPreferences(context.Context, *SetPreferencesRequest) (*Preferences, error)
+	GetPreferences(context.Context, *GetPreferencesRequest) (*Preferences, error)
+	// One-time code to send to the Telegram bot as /link <code>, links the chat to the user
+	CreateTelegramLinkCode(context.Context, *CreateTelegramLinkCodeRequest) (*TelegramLinkCode, error)
+	// Admin only: render a template with sample data
+	PreviewTemplate(context.Context, *PreviewTemplateRequest) (*PreviewTemplateResponse, error)
+	// Admin only: messages that failed to be handled after all retries
+	ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error)
+	// Admin only: publish dead letters back to their original topics
+	ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error)
 	mustEmbedUnimplementedNotificationsServer()
 }
 
@@ -61,6 +186,27 @@ type UnimplementedNotificationsServer struct {
 func (UnimplementedNotificationsServer) GetHistoryWithPeriod(context.Context, *GetHistoryWithPeriodRequest) (*GetHistoryWithPeriodResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetHistoryWithPeriod not implemented")
 }
+func (UnimplementedNotificationsServer) Subscribe(*SubscribeRequest, Notifications_SubscribeServer) error {
+	return status.Errorf(codes.Unimplemented, "method Subscribe not implemented")
+}
+func (UnimplementedNotificationsServer) SetPreferences(context.Context, *SetPreferencesRequest) (*Preferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetPreferences not implemented")
+}
+func (UnimplementedNotificationsServer) GetPreferences(context.Context, *GetPreferencesRequest) (*Preferences, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetPreferences not implemented")
+}
+func (UnimplementedNotificationsServer) CreateTelegramLinkCode(context.Context, *CreateTelegramLinkCodeRequest) (*TelegramLinkCode, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateTelegramLinkCode not implemented")
+}
+func (UnimplementedNotificationsServer) PreviewTemplate(context.Context, *PreviewTemplateRequest) (*PreviewTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PreviewTemplate not implemented")
+}
+func (UnimplementedNotificationsServer) ListDeadLetters(context.Context, *ListDeadLettersRequest) (*ListDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDeadLetters not implemented")
+}
+func (UnimplementedNotificationsServer) ReplayDeadLetters(context.Context, *ReplayDeadLettersRequest) (*ReplayDeadLettersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReplayDeadLetters not implemented")
+}
 func (UnimplementedNotificationsServer) mustEmbedUnimplementedNotificationsServer() {}
 
 // UnsafeNotificationsServer may be embedded to opt out of forward compatibility for this service.
@@ -92,6 +238,135 @@ func _Notifications_GetHistoryWithPeriod_Handler(srv interface{}, ctx context.Co
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Notifications_Subscribe_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(SubscribeRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(NotificationsServer).Subscribe(m, &notificationsSubscribeServer{stream})
+}
+
+type Notifications_SubscribeServer interface {
+	Send(*Message) error
+	grpc.ServerStream
+}
+
+type notificationsSubscribeServer struct {
+	grpc.ServerStream
+}
+
+func (x *notificationsSubscribeServer) Send(m *Message) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func _Notifications_SetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServer).SetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notifications_SetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServer).SetPreferences(ctx, req.(*SetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notifications_GetPreferences_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetPreferencesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServer).GetPreferences(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notifications_GetPreferences_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServer).GetPreferences(ctx, req.(*GetPreferencesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notifications_CreateTelegramLinkCode_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateTelegramLinkCodeRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServer).CreateTelegramLinkCode(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notifications_CreateTelegramLinkCode_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServer).CreateTelegramLinkCode(ctx, req.(*CreateTelegramLinkCodeRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notifications_PreviewTemplate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(PreviewTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServer).PreviewTemplate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notifications_PreviewTemplate_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServer).PreviewTemplate(ctx, req.(*PreviewTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notifications_ListDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServer).ListDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notifications_ListDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServer).ListDeadLetters(ctx, req.(*ListDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Notifications_ReplayDeadLetters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReplayDeadLettersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NotificationsServer).ReplayDeadLetters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: Notifications_ReplayDeadLetters_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NotificationsServer).ReplayDeadLetters(ctx, req.(*ReplayDeadLettersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // Notifications_ServiceDesc is the grpc.ServiceDesc for Notifications service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -103,7 +378,37 @@ var Notifications_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetHistoryWithPeriod",
 			Handler:    _Notifications_GetHistoryWithPeriod_Handler,
 		},
+		{
+			MethodName: "SetPreferences",
+			Handler:    _Notifications_SetPreferences_Handler,
+		},
+		{
+			MethodName: "GetPreferences",
+			Handler:    _Notifications_GetPreferences_Handler,
+		},
+		{
+			MethodName: "CreateTelegramLinkCode",
+			Handler:    _Notifications_CreateTelegramLinkCode_Handler,
+		},
+		{
+			MethodName: "PreviewTemplate",
+			Handler:    _Notifications_PreviewTemplate_Handler,
+		},
+		{
+			MethodName: "ListDeadLetters",
+			Handler:    _Notifications_ListDeadLetters_Handler,
+		},
+		{
+			MethodName: "ReplayDeadLetters",
+			Handler:    _Notifications_ReplayDeadLetters_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Subscribe",
+			Handler:       _Notifications_Subscribe_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "service.proto",
 }